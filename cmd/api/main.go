@@ -1,35 +1,311 @@
 package main
 
 import (
+	"context"
+	"desafio-itens-app/internal/adapters/armazenamento"
 	"desafio-itens-app/internal/adapters/http/auth"
 	"desafio-itens-app/internal/adapters/http/handler"
 	"desafio-itens-app/internal/adapters/http/middlewares"
+	"desafio-itens-app/internal/adapters/logging"
+	"desafio-itens-app/internal/adapters/memory"
+	"desafio-itens-app/internal/adapters/migrate"
 	"desafio-itens-app/internal/adapters/mysql"
+	"desafio-itens-app/internal/adapters/notificacao"
+	"desafio-itens-app/internal/adapters/postgres"
+	"desafio-itens-app/internal/adapters/prometheus"
+	"desafio-itens-app/internal/adapters/tracing"
+	"desafio-itens-app/internal/application/ports/repositories"
 	"desafio-itens-app/internal/application/service"
+	"desafio-itens-app/internal/config"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// Preenchidos no build: go build -ldflags "-X main.versao=1.2.0 -X main.commit=abc123"
+var (
+	versao = "dev"
+	commit = "desconhecido"
 )
 
 func main() {
-	db, err := mysql.ConectarGORM()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(executarMigrate(os.Args[2:]))
+	}
+
+	cfg := config.Carregar()
+	logger := logging.New(cfg.Log, os.Stdout)
+	slog.SetDefault(logger)
+
+	if cfg.Producao() {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	// SIGTERM (orquestrador) ou Ctrl+C cancelam ctx e iniciam o encerramento
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	encerrarTracing, err := tracing.Configurar(ctx, cfg.Tracing)
+	if err != nil {
+		fatal(logger, "Erro ao configurar tracing", err)
+	}
+
+	banco, err := abrirBanco(cfg, logger)
+	if err != nil {
+		fatal(logger, "Erro ao conectar com o banco", err)
+	}
+
+	metricas := prometheus.New()
+	if err := metricas.InstrumentarGORM(banco.db, cfg.Database.Driver); err != nil {
+		fatal(logger, "Erro ao instrumentar o banco", err)
+	}
+	if err := tracing.InstrumentarGORM(banco.db, cfg.Database.Driver); err != nil {
+		fatal(logger, "Erro ao instrumentar o banco", err)
+	}
+
+	// 🛑 Não sobe com schema atrasado: rode `app migrate up` antes do deploy
+	if err := banco.migrator.VerificarAtualizado(ctx); err != nil {
+		fatal(logger, "Erro ao verificar migrations", err)
+	}
+
+	itemService := service.NewItemService(banco.itemRepo, logger.With("componente", "item_service"), metricas)
+	userService := service.NewUserService(banco.userRepo, logger.With("componente", "user_service"), metricas)
+	importacaoService := service.NewImportacaoService(banco.itemRepo, itemService, banco.importacaoJobRepo, logger.With("componente", "importacao_service"))
+	loteService := service.NewLoteService(banco.itemRepo, itemService, banco.transacoes, logger.With("componente", "lote_service"))
+	cambioService := service.NewCambioService(banco.cambioRepo, logger.With("componente", "cambio_service"))
+	precoService := service.NewPrecoService(banco.itemRepo, itemService, banco.precoRepo, banco.transacoes, logger.With("componente", "preco_service"))
+	promocaoService := service.NewPromocaoService(banco.promocaoRepo, logger.With("componente", "promocao_service"))
+	estoqueService := service.NewEstoqueService(banco.itemRepo, itemService, banco.estoqueRepo, banco.transacoes, logger.With("componente", "estoque_service"))
+	reservaService := service.NewReservaService(banco.itemRepo, itemService, banco.reservaRepo, banco.transacoes, logger.With("componente", "reserva_service"))
+	fornecedorService := service.NewFornecedorService(banco.itemRepo, banco.fornecedorRepo, logger.With("componente", "fornecedor_service"))
+	varianteService := service.NewVarianteService(banco.itemRepo, itemService, logger.With("componente", "variante_service"))
+	vendaService := service.NewVendaService(banco.itemRepo, itemService, banco.pedidoVendaRepo, promocaoService, banco.reservaRepo, banco.transacoes, logger.With("componente", "venda_service"))
+	armazenamentoImagens, err := armazenamento.NovoBlobStore(cfg.Blobs)
 	if err != nil {
-		log.Fatal("Erro ao conectar com o banco:", err)
+		fatal(logger, "Erro ao configurar o armazenamento de arquivos", err)
+	}
+	urlImagens := cfg.Blobs.URLPublica
+	if urlImagens == "" {
+		urlImagens = "/v1/imagens" // Servidas pela própria API
 	}
+	imagemService := service.NewImagemService(banco.imagemRepo, armazenamentoImagens, urlImagens, banco.transacoes, logger.With("componente", "imagem_service"))
+	compraService := service.NewCompraService(banco.itemRepo, itemService, banco.pedidoCompraRepo, banco.fornecedorRepo, banco.transacoes, logger.With("componente", "compra_service"))
 
-	itemRepo := mysql.NewMySQLItemRepository(db)
-	userRepo := mysql.NewMySQLUserRepository(db)
+	tarefas := &workers{logger: logger}
 
-	itemService := service.NewItemService(itemRepo)
-	userService := service.NewUserService(userRepo)
+	// 🔔 Alertas de estoque baixo saem em segundo plano (log, webhook, e-mail)
+	notificador, err := notificacao.NovoNotificador(cfg.Notificacoes, logger.With("componente", "notificacoes"))
+	if err != nil {
+		fatal(logger, "Erro ao configurar notificações", err)
+	}
+	filaNotificacoes := notificacao.NovaFila(notificador, cfg.Notificacoes.Fila, logger.With("componente", "notificacoes"))
+	itemService.UsarNotificador(filaNotificacoes)
+	itemService.UsarTransacoes(banco.transacoes)
+	tarefas.rodar(ctx, "notificacoes", filaNotificacoes.Rodar)
+
+	// 📊 Indicadores de estoque (itens por status, valor total) recalculados em segundo plano
+	tarefas.aCada(ctx, "resumo_estoque", cfg.Metricas.IntervaloResumo, itemService.PublicarResumoEstoque)
 
-	jwtService := auth.NewJWTService("minha-secret-key-super-secreta")
+	// ⏰ Preços agendados entram em vigor (com atraso de até 30s)
+	tarefas.aCada(ctx, "precos_agendados", 30*time.Second, precoService.AplicarAgendados)
+
+	// 🛒 Reservas vencidas já não seguram estoque; aqui só viram "expirada"
+	tarefas.aCada(ctx, "expirar_reservas", time.Minute, reservaService.ExpirarVencidas)
+
+	jwtService := auth.NewJWTService(cfg.Auth.JWTSecret)
 	authMiddleware := middlewares.NewAuthMiddleware(jwtService)
 
-	itemHandler := handler.NewItemHandler(itemService)
-	userHandler := handler.NewUserHandler(userService, jwtService)
+	// 🚦 Rate limit: baldes na memória (por réplica) ou no banco (cota global)
+	var rateLimiter *middlewares.RateLimiter
+	if cfg.RateLimit.Ativo {
+		var store repositories.RateLimitRepository = memory.NewRateLimitRepository()
+		if cfg.RateLimit.Store == "db" {
+			store = banco.rateLimitRepo
+		}
+		rateLimiter = middlewares.NewRateLimiter(store, logger.With("componente", "rate_limit"))
+
+		// Balde parado por mais que a maior janela já está cheio: pode sair do store
+		tarefas.aCada(ctx, "limpar_rate_limit", time.Minute, func(ctx context.Context) error {
+			_, err := store.Limpar(ctx, time.Now().Add(-maiorJanela()))
+			return err
+		})
+	}
+
+	// 🔑 Idempotency-Key: respostas guardadas por IDEMPOTENCY_TTL
+	idempotenciaRepo := banco.idempotenciaRepo
+	if cfg.Idempotencia.Store == "memory" {
+		idempotenciaRepo = memory.NewIdempotenciaRepository()
+	}
+	idempotenciaMiddleware := middlewares.NewIdempotencia(idempotenciaRepo, cfg.Idempotencia.TTL, logger.With("componente", "idempotencia"))
+	tarefas.aCada(ctx, "limpar_idempotencia", time.Hour, func(ctx context.Context) error {
+		_, err := idempotenciaRepo.LimparExpirados(ctx, time.Now())
+		return err
+	})
+
+	sqlDB, err := banco.db.DB()
+	if err != nil {
+		fatal(logger, "Erro ao acessar o pool de conexões", err)
+	}
+
+	itemHandler := handler.NewItemHandler(itemService, cambioService, promocaoService, reservaService, varianteService, imagemService, logger)
+	userHandler := handler.NewUserHandler(userService, jwtService, logger)
+	importacaoHandler := handler.NewImportacaoHandler(importacaoService, logger)
+	loteHandler := handler.NewLoteHandler(loteService, logger)
+	cambioHandler := handler.NewCambioHandler(cambioService, logger)
+	precoHandler := handler.NewPrecoHandler(precoService, itemService, logger)
+	promocaoHandler := handler.NewPromocaoHandler(promocaoService, logger)
+	estoqueHandler := handler.NewEstoqueHandler(estoqueService, itemService, logger)
+	reservaHandler := handler.NewReservaHandler(reservaService, itemService, logger)
+	fornecedorHandler := handler.NewFornecedorHandler(fornecedorService, itemService, logger)
+	compraHandler := handler.NewCompraHandler(compraService, logger)
+	vendaHandler := handler.NewVendaHandler(vendaService, logger)
+	imagemHandler := handler.NewImagemHandler(imagemService, itemService, logger)
+	healthHandler := handler.NewHealthHandler(
+		handler.Build{Versao: versao, Commit: commit},
+		sqlDB.Stats,
+		logger.With("componente", "health"),
+		handler.Verificacao{Nome: "banco", Checar: sqlDB.PingContext},
+		handler.Verificacao{Nome: "migrations", Checar: banco.migrator.Verificar}, // Só leitura
+		handler.Verificacao{Nome: "jwt", Checar: func(context.Context) error {
+			if !jwtService.ChaveCarregada() {
+				return errors.New("chave JWT não carregada (defina JWT_SECRET)")
+			}
+			return nil
+		}},
+	)
+
+	router := RegistrarRotas(itemHandler, userHandler, importacaoHandler, loteHandler, cambioHandler, precoHandler, promocaoHandler, estoqueHandler, reservaHandler, fornecedorHandler, compraHandler, vendaHandler, imagemHandler, healthHandler, authMiddleware, cfg.RateLimit.ChavesAPI, rateLimiter, idempotenciaMiddleware, logger, metricas)
+	// 🌐 Sem TRUSTED_PROXIES o IP do cliente é o da conexão: X-Forwarded-For
+	// de qualquer um não escolhe a cota
+	if err := router.SetTrustedProxies(cfg.HTTP.ProxiesConfiaveis); err != nil {
+		fatal(logger, "TRUSTED_PROXIES inválido", err)
+	}
+
+	codigoSaida := 0
+	if err := servir(ctx, router, cfg.HTTP, logger); err != nil {
+		logger.Error("Erro no servidor", "erro", err)
+		codigoSaida = 1
+	}
+
+	// 🧹 Encerramento: workers e importações → pool de conexões → tracing (flush dos spans)
+	stop()
+	tarefas.Esperar()
+	importacaoService.Encerrar()
+
+	if err := sqlDB.Close(); err != nil {
+		logger.Warn("erro ao fechar o pool de conexões", "erro", err)
+	}
+
+	ctxTracing, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := encerrarTracing(ctxTracing); err != nil {
+		logger.Warn("erro ao enviar os últimos spans", "erro", err)
+	}
+	cancel()
+
+	logger.Info("servidor encerrado")
+	os.Exit(codigoSaida)
+}
+
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, "erro", err)
+	os.Exit(1)
+}
+
+// banco agrupa o que cada adapter de persistência entrega para o main
+type banco struct {
+	db       *gorm.DB
+	itemRepo repositories.ItemRepository
+	userRepo repositories.UserRepository
+	migrator *migrate.Migrator
+
+	rateLimitRepo     repositories.RateLimitRepository
+	idempotenciaRepo  repositories.IdempotenciaRepository
+	importacaoJobRepo repositories.ImportacaoJobRepository
+	transacoes        repositories.Transacionador
+	cambioRepo        repositories.CambioRepository
+	precoRepo         repositories.PrecoRepository
+	promocaoRepo      repositories.PromocaoRepository
+	estoqueRepo       repositories.EstoqueRepository
+	reservaRepo       repositories.ReservaRepository
+	fornecedorRepo    repositories.FornecedorRepository
+	pedidoCompraRepo  repositories.PedidoCompraRepository
+	pedidoVendaRepo   repositories.PedidoVendaRepository
+	imagemRepo        repositories.ImagemRepository
+}
+
+// abrirBanco escolhe o adapter de persistência pelo DB_DRIVER
+// ("mysql" por padrão, ou "postgres").
+func abrirBanco(cfg config.Config, logger *slog.Logger) (*banco, error) {
+	gormLogger := logging.NewGormLogger(logger, cfg.Log)
+	repoLogger := logger.With("componente", "repositorio")
+
+	switch cfg.Database.Driver {
+	case "postgres":
+		db, err := postgres.ConectarGORM(cfg.Database, logger, gormLogger)
+		if err != nil {
+			return nil, err
+		}
+		migrator, err := postgres.NovoMigrator(db)
+		if err != nil {
+			return nil, err
+		}
+		return &banco{
+			db:       db,
+			itemRepo: postgres.NewPostgresItemRepository(db, repoLogger),
+			userRepo: postgres.NewPostgresUserRepository(db, repoLogger),
+			migrator: migrator,
+
+			rateLimitRepo:     postgres.NewPostgresRateLimitRepository(db),
+			idempotenciaRepo:  postgres.NewPostgresIdempotenciaRepository(db),
+			importacaoJobRepo: postgres.NewPostgresImportacaoJobRepository(db),
+			transacoes:        postgres.NewPostgresTransacionador(db),
+			cambioRepo:        postgres.NewPostgresCambioRepository(db),
+			precoRepo:         postgres.NewPostgresPrecoRepository(db),
+			promocaoRepo:      postgres.NewPostgresPromocaoRepository(db),
+			estoqueRepo:       postgres.NewPostgresEstoqueRepository(db),
+			reservaRepo:       postgres.NewPostgresReservaRepository(db),
+			fornecedorRepo:    postgres.NewPostgresFornecedorRepository(db),
+			pedidoCompraRepo:  postgres.NewPostgresPedidoCompraRepository(db),
+			pedidoVendaRepo:   postgres.NewPostgresPedidoVendaRepository(db),
+			imagemRepo:        postgres.NewPostgresImagemRepository(db),
+		}, nil
+	case "mysql":
+		db, err := mysql.ConectarGORM(cfg.Database, logger, gormLogger)
+		if err != nil {
+			return nil, err
+		}
+		migrator, err := mysql.NovoMigrator(db)
+		if err != nil {
+			return nil, err
+		}
+		return &banco{
+			db:       db,
+			itemRepo: mysql.NewMySQLItemRepository(db, repoLogger),
+			userRepo: mysql.NewMySQLUserRepository(db, repoLogger),
+			migrator: migrator,
 
-	router := RegistrarRotas(itemHandler, userHandler, authMiddleware)
-	if err := router.Run(":8080"); err != nil {
-		log.Fatal("Erro ao subir o servidor:", err)
+			rateLimitRepo:     mysql.NewMySQLRateLimitRepository(db),
+			idempotenciaRepo:  mysql.NewMySQLIdempotenciaRepository(db),
+			importacaoJobRepo: mysql.NewMySQLImportacaoJobRepository(db),
+			transacoes:        mysql.NewMySQLTransacionador(db),
+			cambioRepo:        mysql.NewMySQLCambioRepository(db),
+			precoRepo:         mysql.NewMySQLPrecoRepository(db),
+			promocaoRepo:      mysql.NewMySQLPromocaoRepository(db),
+			estoqueRepo:       mysql.NewMySQLEstoqueRepository(db),
+			reservaRepo:       mysql.NewMySQLReservaRepository(db),
+			fornecedorRepo:    mysql.NewMySQLFornecedorRepository(db),
+			pedidoCompraRepo:  mysql.NewMySQLPedidoCompraRepository(db),
+			pedidoVendaRepo:   mysql.NewMySQLPedidoVendaRepository(db),
+			imagemRepo:        mysql.NewMySQLImagemRepository(db),
+		}, nil
+	default:
+		return nil, fmt.Errorf("DB_DRIVER desconhecido: %q", cfg.Database.Driver)
 	}
 }
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"desafio-itens-app/internal/adapters/logging"
+	"desafio-itens-app/internal/adapters/migrate"
+	"desafio-itens-app/internal/adapters/mysql"
+	"desafio-itens-app/internal/adapters/postgres"
+	"desafio-itens-app/internal/config"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+const usoMigrate = `uso: app migrate <comando>
+
+comandos:
+  up                 aplica todas as migrations pendentes
+  down [-n passos]   desfaz as últimas migrations (padrão: 1)
+  status             lista as migrations e se já foram aplicadas
+  create <nome>      cria os arquivos up/down da próxima migration
+
+O banco é escolhido por DB_DRIVER (mysql ou postgres).`
+
+// executarMigrate implementa o subcomando `migrate` e devolve o exit code
+func executarMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usoMigrate)
+		return 2
+	}
+
+	cfg := config.Carregar()
+
+	// create não precisa de conexão: só escreve os arquivos no repo
+	if args[0] == "create" {
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, "informe o nome: app migrate create <nome>")
+			return 2
+		}
+		dir := mysql.DirMigracoes
+		if cfg.Database.Driver == "postgres" {
+			dir = postgres.DirMigracoes
+		}
+		up, down, err := migrate.Criar(dir, args[1])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "erro:", err)
+			return 1
+		}
+		fmt.Println("criado:", up)
+		fmt.Println("criado:", down)
+		return 0
+	}
+
+	banco, err := abrirBanco(cfg, logging.New(cfg.Log, os.Stderr))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "erro ao conectar com o banco:", err)
+		return 1
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		aplicadas, err := banco.migrator.Up(ctx)
+		for _, m := range aplicadas {
+			fmt.Printf("✅ %04d_%s\n", m.Versao, m.Nome)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "erro:", err)
+			return 1
+		}
+		if len(aplicadas) == 0 {
+			fmt.Println("nenhuma migration pendente")
+		}
+
+	case "down":
+		flags := flag.NewFlagSet("down", flag.ContinueOnError)
+		passos := flags.Int("n", 1, "quantas migrations desfazer")
+		if err := flags.Parse(args[1:]); err != nil {
+			return 2
+		}
+
+		desfeitas, err := banco.migrator.Down(ctx, *passos)
+		for _, m := range desfeitas {
+			fmt.Printf("↩️  %04d_%s\n", m.Versao, m.Nome)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "erro:", err)
+			return 1
+		}
+
+	case "status":
+		status, err := banco.migrator.Status(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "erro:", err)
+			return 1
+		}
+		for _, s := range status {
+			situacao := "pendente"
+			if s.Aplicada {
+				situacao = "aplicada em " + s.AplicadaEm.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Versao, s.Nome, situacao)
+		}
+
+	default:
+		fmt.Fprintln(os.Stderr, usoMigrate)
+		return 2
+	}
+
+	return 0
+}
@@ -3,45 +3,155 @@ package main // se ele estiver sendo usado só no main.go, o pacote deve ser mai
 import (
 	"desafio-itens-app/internal/adapters/http/handler"
 	"desafio-itens-app/internal/adapters/http/middlewares"
+	"desafio-itens-app/internal/adapters/prometheus"
+	"desafio-itens-app/internal/adapters/tracing"
+	"desafio-itens-app/internal/domain/ratelimit"
 	"github.com/gin-gonic/gin"
+	"log/slog"
+	"time"
 )
 
-func RegistrarRotas(itemHandler *handler.ItemHandler, userHandler *handler.UserHandler, authMiddleware *middlewares.AuthMiddleware) *gin.Engine {
-	router := gin.Default()
+// 🚦 Cotas por grupo de rotas (token bucket: até Limite de uma vez,
+// reabastecendo o Limite inteiro a cada Janela)
+var (
+	politicaAcesso  = ratelimit.Politica{Nome: "acesso", Limite: 10, Janela: time.Minute}   // login/registro, por IP
+	politicaLeitura = ratelimit.Politica{Nome: "leitura", Limite: 300, Janela: time.Minute} // por usuário
+	politicaEscrita = ratelimit.Politica{Nome: "escrita", Limite: 60, Janela: time.Minute}  // por usuário
+	politicaAdmin   = ratelimit.Politica{Nome: "admin", Limite: 120, Janela: time.Minute}   // por usuário
+)
+
+// maiorJanela diz por quanto tempo um balde parado ainda importa
+func maiorJanela() time.Duration {
+	maior := time.Duration(0)
+	for _, p := range []ratelimit.Politica{politicaAcesso, politicaLeitura, politicaEscrita, politicaAdmin} {
+		maior = max(maior, p.Janela)
+	}
+	return maior
+}
+
+func RegistrarRotas(itemHandler *handler.ItemHandler, userHandler *handler.UserHandler, importacaoHandler *handler.ImportacaoHandler, loteHandler *handler.LoteHandler, cambioHandler *handler.CambioHandler, precoHandler *handler.PrecoHandler, promocaoHandler *handler.PromocaoHandler, estoqueHandler *handler.EstoqueHandler, reservaHandler *handler.ReservaHandler, fornecedorHandler *handler.FornecedorHandler, compraHandler *handler.CompraHandler, vendaHandler *handler.VendaHandler, imagemHandler *handler.ImagemHandler, healthHandler *handler.HealthHandler, authMiddleware *middlewares.AuthMiddleware, chavesAPI []string, rateLimiter *middlewares.RateLimiter, idempotencia *middlewares.Idempotencia, logger *slog.Logger, metricas *prometheus.Metricas) *gin.Engine {
+	router := gin.New()
+	router.Use(middlewares.RequestID())       // X-Request-ID recebido ou gerado
+	router.Use(tracing.Middleware())          // Span de servidor (W3C traceparent)
+	router.Use(middlewares.AccessLog(logger)) // Uma linha de log por requisição
+	router.Use(metricas.Middleware())         // Contagem e latência por rota
+	router.Use(gin.Recovery())
+	router.Use(middlewares.ReadYourWrites())   // X-Read-Your-Writes: true → lê do primário
+	router.Use(middlewares.APIKeys(chavesAPI)) // X-API-Key conhecida → cota própria
+
+	// 🩺 SONDAS DO ORQUESTRADOR (liveness / readiness)
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+
+	// 📊 MÉTRICAS (Prometheus)
+	router.GET("/metrics", gin.WrapH(metricas.Handler()))
+
+	// 🖼️ ARQUIVOS DAS IMAGENS (públicos: vão direto em <img src>; sem BLOB_PUBLIC_URL)
+	router.GET("/v1/imagens/*chave", imagemHandler.Servir)
 
 	// 🌍 ROTAS PÚBLICAS (sem autenticação)
 	public := router.Group("v1")
+	public.Use(rateLimiter.Limitar(politicaAcesso))
 	{
-		public.POST("/register", userHandler.Register)
-		public.POST("/login", userHandler.Login)
+		public.POST("/register", idempotencia.Middleware(), userHandler.Register)
+		public.POST("/login", userHandler.Login) // Sem Idempotency-Key: não guardamos tokens
 	}
 
 	// 🔐 ROTAS PARA USUÁRIOS LOGADOS (qualquer role)
 	authenticated := router.Group("v1")
 	authenticated.Use(authMiddleware.RequireAuth()) // ← 1º segurança
+	authenticated.Use(rateLimiter.Limitar(politicaLeitura))
 	{
 		// Qualquer usuário logado pode VER itens
 		authenticated.GET("/itens", itemHandler.GetItens)
+		authenticated.GET("/itens/exportar", itemHandler.ExportarItens)           // CSV, JSON Lines ou XLSX
+		authenticated.GET("/itens/estoque-baixo", itemHandler.ListarEstoqueBaixo) // Relatório de reposição
+		authenticated.GET("/itens/etiquetas", itemHandler.Etiquetas)              // PDF para folha A4 de etiquetas (?ids=1,2,3)
 		authenticated.GET("/itens/:id", itemHandler.GetItem)
+		authenticated.GET("/itens/:id/precos", precoHandler.ListarPrecos) // Histórico e agendamentos
+		authenticated.GET("/itens/:id/estoque", estoqueHandler.Posicao)   // Estoque por depósito
+		authenticated.GET("/itens/:id/fornecedores", fornecedorHandler.Vinculos)
+		authenticated.GET("/itens/:id/variantes", itemHandler.ListarVariantes)
+		authenticated.GET("/itens/:id/imagens", imagemHandler.Listar)
+		authenticated.GET("/itens/:id/codigo-barras", itemHandler.CodigoBarras) // PNG ou SVG (code128, ean13, qr)
+		authenticated.GET("/produtos", itemHandler.ListarProdutos)              // Itens de topo com as variantes aninhadas
+		authenticated.GET("/cambio/taxas", cambioHandler.ListarTaxas)
+		authenticated.GET("/depositos", estoqueHandler.ListarDepositos)
+		authenticated.GET("/fornecedores", fornecedorHandler.Listar)
+		authenticated.GET("/fornecedores/:id", fornecedorHandler.Buscar)
 	}
 
 	// 👤 ROTAS PARA USUÁRIOS (user ou admin)
 	userRoutes := router.Group("v1")
 	userRoutes.Use(authMiddleware.RequireAuth())                // ← 1º segurança
 	userRoutes.Use(authMiddleware.RequireRole("user", "admin")) // ← 2º segurança
+	userRoutes.Use(rateLimiter.Limitar(politicaEscrita))
+	userRoutes.Use(idempotencia.Middleware()) // Idempotency-Key em POST/PUT
+	idempotencia.LimitarCorpo("/v1/itens/importar", handler.TamanhoMaximoImportacao)
+	idempotencia.LimitarCorpo("/v1/itens/:id/imagens", handler.TamanhoMaximoEnvioImagens)
 	{
 		userRoutes.POST("/itens", itemHandler.AddItem)       // Criar item
 		userRoutes.PUT("/itens/:id", itemHandler.UpdateItem) // Editar item
+
+		userRoutes.POST("/itens/importar", importacaoHandler.Importar)  // Importação em massa (CSV/NDJSON)
+		userRoutes.GET("/itens/importar/:id", importacaoHandler.GetJob) // Andamento da importação assíncrona
+		userRoutes.PATCH("/itens/lote", loteHandler.AtualizarItens)     // Alteração em massa (mesma regra do PUT)
+
+		userRoutes.POST("/itens/:id/precos/agendados", precoHandler.AgendarPreco) // Preço futuro (mesma regra do PUT)
+		userRoutes.DELETE("/itens/:id/precos/agendados/:agendamento", precoHandler.CancelarAgendamento)
+		userRoutes.PUT("/itens/:id/estoque/:deposito", estoqueHandler.DefinirSaldo) // Saldo num depósito (mesma regra do PUT)
+		userRoutes.POST("/itens/:id/estoque/transferencias", estoqueHandler.Transferir)
+
+		userRoutes.PUT("/itens/:id/eixos", itemHandler.DefinirEixos)              // Tamanho, cor... (mesma regra do PUT)
+		userRoutes.POST("/itens/:id/variantes", itemHandler.CriarVariante)        // Uma combinação dos eixos
+		userRoutes.POST("/itens/:id/variantes/gerar", itemHandler.GerarVariantes) // Todas as combinações que faltam
+		userRoutes.POST("/itens/:id/reservas", reservaHandler.Reservar)           // Segura estoque durante o checkout
+		userRoutes.POST("/itens/:id/imagens", imagemHandler.Enviar)               // Multipart, campo "arquivo" (mesma regra do PUT)
+		userRoutes.PUT("/itens/:id/imagens/ordem", imagemHandler.Reordenar)
+		userRoutes.PUT("/itens/:id/imagens/:imagem/principal", imagemHandler.DefinirPrincipal)
+		userRoutes.DELETE("/itens/:id/imagens/:imagem", imagemHandler.Remover)
+		userRoutes.GET("/reservas/:id", reservaHandler.Buscar)
+		userRoutes.POST("/reservas/:id/confirmar", reservaHandler.Confirmar) // Baixa o estoque
+		userRoutes.POST("/reservas/:id/cancelar", reservaHandler.Cancelar)
+
+		userRoutes.POST("/pedidos", vendaHandler.Criar)
+		userRoutes.GET("/pedidos", vendaHandler.Listar) // Só os pedidos de quem está logado
+		userRoutes.GET("/pedidos/:id", vendaHandler.Buscar)
+		userRoutes.POST("/pedidos/:id/confirmar", vendaHandler.Confirmar) // Baixa o estoque
+		userRoutes.POST("/pedidos/:id/cancelar", vendaHandler.Cancelar)   // Devolve, se já confirmado
 	}
 
 	// 👑 ROTAS SÓ PARA ADMIN
 	adminRoutes := router.Group("v1")
 	adminRoutes.Use(authMiddleware.RequireAuth())        // ← 1º segurança
 	adminRoutes.Use(authMiddleware.RequireRole("admin")) // ← 2º segurança
+	adminRoutes.Use(rateLimiter.Limitar(politicaAdmin))
+	adminRoutes.Use(idempotencia.Middleware())
 	{
 		adminRoutes.DELETE("/itens/:id", itemHandler.DeleteItem) // Só admin deleta
-		adminRoutes.GET("/users", userHandler.ListUsers)         // Gerenciar usuários
-		adminRoutes.POST("/users", userHandler.CreateUser)       // Criar usuários
+		adminRoutes.DELETE("/itens/lote", loteHandler.RemoverItens)
+		adminRoutes.PUT("/cambio/taxas/:de/:para", cambioHandler.DefinirTaxa) // Cria ou substitui a taxa
+		adminRoutes.DELETE("/cambio/taxas/:de/:para", cambioHandler.RemoverTaxa)
+		adminRoutes.POST("/depositos", estoqueHandler.CriarDeposito)
+		adminRoutes.GET("/promocoes", promocaoHandler.Listar)
+		adminRoutes.GET("/promocoes/:id", promocaoHandler.Buscar)
+		adminRoutes.POST("/promocoes", promocaoHandler.Criar)
+		adminRoutes.PUT("/promocoes/:id", promocaoHandler.Atualizar) // Substitui a promoção inteira
+		adminRoutes.DELETE("/promocoes/:id", promocaoHandler.Remover)
+		adminRoutes.POST("/fornecedores", fornecedorHandler.Criar)
+		adminRoutes.PUT("/fornecedores/:id", fornecedorHandler.Atualizar)
+		adminRoutes.PUT("/itens/:id/fornecedores/:fornecedor", fornecedorHandler.Vincular) // SKU e custo no fornecedor
+		adminRoutes.DELETE("/itens/:id/fornecedores/:fornecedor", fornecedorHandler.Desvincular)
+		adminRoutes.GET("/compras", compraHandler.Listar) // ?status= e ?fornecedor=
+		adminRoutes.GET("/compras/:id", compraHandler.Buscar)
+		adminRoutes.POST("/compras", compraHandler.Criar)
+		adminRoutes.PUT("/compras/:id", compraHandler.Atualizar) // Só rascunho
+		adminRoutes.POST("/compras/:id/enviar", compraHandler.Enviar)
+		adminRoutes.POST("/compras/:id/receber", compraHandler.Receber) // Entrada no estoque
+		adminRoutes.POST("/compras/:id/cancelar", compraHandler.Cancelar)
+		adminRoutes.GET("/users", userHandler.ListUsers)       // Gerenciar usuários
+		adminRoutes.POST("/users", userHandler.CreateUser)     // Criar usuários
+		adminRoutes.GET("/admin/status", healthHandler.Status) // Versão, uptime, pool e dependências
 	}
 
 	return router
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"desafio-itens-app/internal/adapters/http/certificado"
+	"desafio-itens-app/internal/config"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// servir sobe o servidor HTTP e bloqueia até ctx ser cancelado (SIGTERM/SIGINT)
+// ou o servidor falhar. No cancelamento para de aceitar conexões e espera as
+// requisições em andamento terminarem, até cfg.ShutdownTimeout.
+func servir(ctx context.Context, handler http.Handler, cfg config.HTTP, logger *slog.Logger) error {
+	srv := &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
+	}
+
+	if cfg.TLS() {
+		cert, err := certificado.New(cfg.TLSCertFile, cfg.TLSKeyFile, 30*time.Second, logger)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: cert.GetCertificate,
+		}
+	}
+
+	erros := make(chan error, 1)
+	go func() {
+		var err error
+		if cfg.TLS() {
+			err = srv.ListenAndServeTLS("", "") // certificado vem do GetCertificate
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			erros <- err
+		}
+		close(erros)
+	}()
+
+	logger.Info("servidor iniciado", "addr", cfg.Addr, "tls", cfg.TLS(), "versao", versao)
+
+	select {
+	case err := <-erros:
+		return err
+	case <-ctx.Done():
+	}
+
+	// 🛑 Para de aceitar conexões e drena as requisições em andamento
+	logger.Info("encerrando servidor", "prazo", cfg.ShutdownTimeout)
+	ctxShutdown, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctxShutdown); err != nil {
+		srv.Close()
+		return fmt.Errorf("requisições não terminaram no prazo: %w", err)
+	}
+	return nil
+}
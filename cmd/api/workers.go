@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// workers roda tarefas periódicas em segundo plano. Todas param quando o
+// ctx é cancelado e Esperar bloqueia até a última terminar (encerramento).
+type workers struct {
+	wg     sync.WaitGroup
+	logger *slog.Logger
+}
+
+// aCada executa tarefa logo de início e depois a cada intervalo
+func (w *workers) aCada(ctx context.Context, nome string, intervalo time.Duration, tarefa func(ctx context.Context) error) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(intervalo)
+		defer ticker.Stop()
+
+		for {
+			if err := tarefa(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Warn("falha na tarefa em segundo plano", "tarefa", nome, "erro", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// rodar executa tarefa uma vez só, até ela retornar (ao cancelar o ctx)
+func (w *workers) rodar(ctx context.Context, nome string, tarefa func(ctx context.Context) error) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		if err := tarefa(ctx); err != nil {
+			w.logger.Warn("falha na tarefa em segundo plano", "tarefa", nome, "erro", err)
+		}
+	}()
+}
+
+func (w *workers) Esperar() {
+	w.wg.Wait()
+}
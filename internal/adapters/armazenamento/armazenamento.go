@@ -0,0 +1,25 @@
+package armazenamento
+
+import (
+	"desafio-itens-app/internal/application/ports/blobs"
+	"desafio-itens-app/internal/config"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NovoBlobStore monta o store de BLOB_STORE. Configuração faltando é erro
+// de boot, não upload falhando em produção.
+func NovoBlobStore(cfg config.Blobs) (blobs.BlobStore, error) {
+	switch strings.ToLower(cfg.Store) {
+	case "local":
+		return NovoLocal(cfg.Diretorio)
+	case "s3":
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" || cfg.S3AccessKey == "" || cfg.S3SecretKey == "" {
+			return nil, errors.New("S3_ENDPOINT, S3_BUCKET, S3_ACCESS_KEY_ID e S3_SECRET_ACCESS_KEY são obrigatórios para BLOB_STORE=s3")
+		}
+		return NovoS3(cfg.S3Endpoint, cfg.S3Bucket, cfg.S3Regiao, cfg.S3AccessKey, cfg.S3SecretKey, cfg.S3PathStyle, cfg.S3Timeout)
+	default:
+		return nil, fmt.Errorf("BLOB_STORE: store desconhecido %q", cfg.Store)
+	}
+}
@@ -0,0 +1,101 @@
+package armazenamento
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/blobs"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Local guarda os arquivos num diretório; a chave vira o caminho relativo
+type Local struct {
+	raiz string
+}
+
+func NovoLocal(raiz string) (*Local, error) {
+	raiz, err := filepath.Abs(raiz)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(raiz, 0o755); err != nil {
+		return nil, fmt.Errorf("erro ao criar o diretório de arquivos: %w", err)
+	}
+	return &Local{raiz: raiz}, nil
+}
+
+func (l *Local) Salvar(ctx context.Context, chave, contentType string, dados []byte) error {
+	destino, err := l.caminho(chave)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(destino), 0o755); err != nil {
+		return err
+	}
+
+	// ✍️ Grava num temporário e renomeia: quem lê nunca vê arquivo pela metade
+	tmp, err := os.CreateTemp(filepath.Dir(destino), ".envio-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // Depois do rename não existe mais
+
+	if _, err := tmp.Write(dados); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), destino)
+}
+
+func (l *Local) Abrir(ctx context.Context, chave string) (io.ReadCloser, string, error) {
+	origem, err := l.caminho(chave)
+	if err != nil {
+		return nil, "", err
+	}
+
+	f, err := os.Open(origem)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, "", blobs.ErrBlobNaoEncontrado
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return f, mime.TypeByExtension(path.Ext(chave)), nil
+}
+
+func (l *Local) Remover(ctx context.Context, chave string) error {
+	alvo, err := l.caminho(chave)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(alvo); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// caminho resolve a chave dentro da raiz; "..", caminho absoluto e afins
+// são recusados
+func (l *Local) caminho(chave string) (string, error) {
+	if !chaveValida(chave) {
+		return "", fmt.Errorf("chave inválida: %q", chave)
+	}
+	return filepath.Join(l.raiz, filepath.FromSlash(chave)), nil
+}
+
+// chaveValida: caminho relativo, limpo e sem subir de diretório
+func chaveValida(chave string) bool {
+	return chave != "" &&
+		!strings.HasPrefix(chave, "/") &&
+		!strings.Contains(chave, "\\") &&
+		path.Clean(chave) == chave &&
+		chave != ".." && !strings.HasPrefix(chave, "../")
+}
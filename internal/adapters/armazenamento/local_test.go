@@ -0,0 +1,42 @@
+package armazenamento
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/blobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+)
+
+func TestLocal_SalvarAbrirRemover(t *testing.T) {
+	//ARRANGE
+	ctx := context.Background()
+	store, err := NovoLocal(t.TempDir())
+	require.NoError(t, err)
+
+	//ACT
+	require.NoError(t, store.Salvar(ctx, "itens/7/ab12.png", "image/png", []byte("conteudo")))
+	arquivo, tipo, err := store.Abrir(ctx, "itens/7/ab12.png")
+
+	//ASSERT
+	require.NoError(t, err)
+	dados, _ := io.ReadAll(arquivo)
+	arquivo.Close()
+	assert.Equal(t, "conteudo", string(dados))
+	assert.Equal(t, "image/png", tipo)
+
+	require.NoError(t, store.Remover(ctx, "itens/7/ab12.png"))
+	require.NoError(t, store.Remover(ctx, "itens/7/ab12.png")) // Já removido não é erro
+	_, _, err = store.Abrir(ctx, "itens/7/ab12.png")
+	assert.ErrorIs(t, err, blobs.ErrBlobNaoEncontrado)
+}
+
+func TestLocal_RecusaChaveForaDaRaiz(t *testing.T) {
+	store, err := NovoLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, chave := range []string{"../fora.txt", "/etc/passwd", "itens/../../fora", "itens//7", "", "itens\\7"} {
+		assert.Error(t, store.Salvar(context.Background(), chave, "text/plain", []byte("x")), chave)
+	}
+}
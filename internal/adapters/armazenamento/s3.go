@@ -0,0 +1,224 @@
+package armazenamento
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"desafio-itens-app/internal/application/ports/blobs"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3 fala a API REST do S3 (AWS, MinIO, R2, ...) assinando as requisições
+// com AWS Signature V4. Só PUT, GET e DELETE de objeto, que é o que o
+// BlobStore precisa.
+type S3 struct {
+	endpoint    *url.URL // https://s3.amazonaws.com, http://localhost:9000...
+	bucket      string
+	regiao      string
+	chaveAcesso string
+	segredo     string
+	pathStyle   bool // endpoint/bucket/chave em vez de bucket.endpoint/chave (MinIO)
+	cliente     *http.Client
+	agora       func() time.Time
+}
+
+func NovoS3(endpoint, bucket, regiao, chaveAcesso, segredo string, pathStyle bool, timeout time.Duration) (*S3, error) {
+	u, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("endpoint S3 inválido: %q", endpoint)
+	}
+	return &S3{
+		endpoint:    u,
+		bucket:      bucket,
+		regiao:      regiao,
+		chaveAcesso: chaveAcesso,
+		segredo:     segredo,
+		pathStyle:   pathStyle,
+		cliente:     &http.Client{Timeout: timeout},
+		agora:       time.Now,
+	}, nil
+}
+
+func (s *S3) Salvar(ctx context.Context, chave, contentType string, dados []byte) error {
+	req, err := s.requisicao(ctx, http.MethodPut, chave, dados)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.assinar(req, hashHex(dados))
+
+	resp, err := s.cliente.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+	defer resp.Body.Close()
+	return erroS3(resp)
+}
+
+func (s *S3) Abrir(ctx context.Context, chave string) (io.ReadCloser, string, error) {
+	req, err := s.requisicao(ctx, http.MethodGet, chave, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	s.assinar(req, hashHex(nil))
+
+	resp, err := s.cliente.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("s3: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, "", blobs.ErrBlobNaoEncontrado
+	}
+	if err := erroS3(resp); err != nil {
+		resp.Body.Close()
+		return nil, "", err
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+func (s *S3) Remover(ctx context.Context, chave string) error {
+	req, err := s.requisicao(ctx, http.MethodDelete, chave, nil)
+	if err != nil {
+		return err
+	}
+	s.assinar(req, hashHex(nil))
+
+	resp, err := s.cliente.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return erroS3(resp)
+}
+
+func (s *S3) requisicao(ctx context.Context, metodo, chave string, corpo []byte) (*http.Request, error) {
+	if !chaveValida(chave) {
+		return nil, fmt.Errorf("chave inválida: %q", chave)
+	}
+
+	u := *s.endpoint
+	caminho := "/" + codificarURI(chave, true)
+	if s.pathStyle {
+		caminho = "/" + codificarURI(s.bucket, false) + caminho
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	alvo, err := url.Parse(u.Scheme + "://" + u.Host + strings.TrimRight(u.EscapedPath(), "/") + caminho)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.NewRequestWithContext(ctx, metodo, alvo.String(), bytes.NewReader(corpo))
+}
+
+// assinar aplica a AWS Signature V4 (serviço "s3"). Entram na assinatura o
+// host, Content-Type, Range e os cabeçalhos x-amz-*.
+func (s *S3) assinar(req *http.Request, hashCorpo string) {
+	agora := s.agora().UTC()
+	dataHora := agora.Format("20060102T150405Z")
+	data := agora.Format("20060102")
+	req.Header.Set("X-Amz-Date", dataHora)
+	req.Header.Set("X-Amz-Content-Sha256", hashCorpo)
+
+	// PASSO 1: Requisição canônica
+	cabecalhos := map[string]string{"host": req.URL.Host}
+	for nome, valores := range req.Header {
+		nome = strings.ToLower(nome)
+		if nome == "content-type" || nome == "range" || strings.HasPrefix(nome, "x-amz-") {
+			cabecalhos[nome] = strings.TrimSpace(strings.Join(valores, ","))
+		}
+	}
+	nomes := make([]string, 0, len(cabecalhos))
+	for nome := range cabecalhos {
+		nomes = append(nomes, nome)
+	}
+	sort.Strings(nomes)
+
+	var canonicos strings.Builder
+	for _, nome := range nomes {
+		canonicos.WriteString(nome + ":" + cabecalhos[nome] + "\n")
+	}
+	assinados := strings.Join(nomes, ";")
+
+	requisicaoCanonica := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		consultaCanonica(req.URL.Query()),
+		canonicos.String(),
+		assinados,
+		hashCorpo,
+	}, "\n")
+
+	// PASSO 2: Texto a assinar e chave derivada da data/região
+	escopo := data + "/" + s.regiao + "/s3/aws4_request"
+	textoAssinar := "AWS4-HMAC-SHA256\n" + dataHora + "\n" + escopo + "\n" + hashHex([]byte(requisicaoCanonica))
+
+	chave := hmacSHA256([]byte("AWS4"+s.segredo), data)
+	chave = hmacSHA256(chave, s.regiao)
+	chave = hmacSHA256(chave, "s3")
+	chave = hmacSHA256(chave, "aws4_request")
+	assinatura := hex.EncodeToString(hmacSHA256(chave, textoAssinar))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.chaveAcesso, escopo, assinados, assinatura))
+}
+
+func consultaCanonica(valores url.Values) string {
+	pares := make([]string, 0, len(valores))
+	for chave, lista := range valores {
+		for _, v := range lista {
+			pares = append(pares, codificarURI(chave, false)+"="+codificarURI(v, false))
+		}
+	}
+	sort.Strings(pares)
+	return strings.Join(pares, "&")
+}
+
+// codificarURI usa a codificação da SigV4: só A-Z, a-z, 0-9, '-', '.', '_'
+// e '~' passam sem %XX (e '/' quando é separador de caminho)
+func codificarURI(texto string, manterBarra bool) string {
+	var b strings.Builder
+	for _, c := range []byte(texto) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~', c == '/' && manterBarra:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hashHex(dados []byte) string {
+	soma := sha256.Sum256(dados)
+	return hex.EncodeToString(soma[:])
+}
+
+func hmacSHA256(chave []byte, texto string) []byte {
+	mac := hmac.New(sha256.New, chave)
+	mac.Write([]byte(texto))
+	return mac.Sum(nil)
+}
+
+// erroS3 transforma resposta fora de 2xx em erro com o começo do XML
+// que o S3 devolve (Code/Message)
+func erroS3(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+	corpo, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("s3 respondeu %d: %s", resp.StatusCode, strings.TrimSpace(string(corpo)))
+}
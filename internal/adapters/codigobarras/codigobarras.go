@@ -0,0 +1,108 @@
+package codigobarras
+
+import (
+	entity "desafio-itens-app/internal/domain/item"
+	"fmt"
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/qr"
+)
+
+type Formato string
+
+const (
+	FormatoCode128 Formato = "code128" // Código do item (MO12345678)
+	FormatoEAN13   Formato = "ean13"   // EAN cadastrado ou o interno
+	FormatoQR      Formato = "qr"      // Código do item
+)
+
+// ParseFormato lê ?formato= (vazio = code128)
+func ParseFormato(texto string) (Formato, error) {
+	switch f := Formato(texto); f {
+	case "":
+		return FormatoCode128, nil
+	case FormatoCode128, FormatoEAN13, FormatoQR:
+		return f, nil
+	}
+	return "", fmt.Errorf("formato deve ser '%s', '%s' ou '%s'", FormatoCode128, FormatoEAN13, FormatoQR)
+}
+
+type Saida string
+
+const (
+	SaidaPNG Saida = "png"
+	SaidaSVG Saida = "svg"
+)
+
+// ParseSaida lê ?saida= (vazio = png)
+func ParseSaida(texto string) (Saida, error) {
+	switch s := Saida(texto); s {
+	case "":
+		return SaidaPNG, nil
+	case SaidaPNG, SaidaSVG:
+		return s, nil
+	}
+	return "", fmt.Errorf("saida deve ser '%s' ou '%s'", SaidaPNG, SaidaSVG)
+}
+
+func (s Saida) ContentType() string {
+	if s == SaidaSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+// Codigo é o código já codificado, como matriz de módulos escuros: uma
+// linha nos códigos de barras, um quadrado no QR
+type Codigo struct {
+	Conteudo string
+	Modulos  [][]bool
+}
+
+// DuasDimensoes diz se é um QR (módulos quadrados) ou barras
+func (c Codigo) DuasDimensoes() bool {
+	return len(c.Modulos) > 1
+}
+
+func (c Codigo) largura() int {
+	return len(c.Modulos[0])
+}
+
+// Gerar codifica o item no formato pedido
+func Gerar(item entity.Item, formato Formato) (Codigo, error) {
+	var bc barcode.Barcode
+	var err error
+	switch formato {
+	case FormatoCode128:
+		bc, err = code128.Encode(item.Code)
+	case FormatoEAN13:
+		var codigo string
+		if codigo, err = item.CodigoEAN(); err == nil {
+			bc, err = ean.Encode(codigo)
+		}
+	case FormatoQR:
+		bc, err = qr.Encode(item.Code, qr.M, qr.Auto)
+	default:
+		return Codigo{}, fmt.Errorf("formato desconhecido: %q", formato)
+	}
+	if err != nil {
+		return Codigo{}, fmt.Errorf("erro ao gerar %s do item %d: %w", formato, item.ID, err)
+	}
+	return Codigo{Conteudo: bc.Content(), Modulos: modulos(bc)}, nil
+}
+
+// modulos lê o código sem escala: cada pixel é um módulo
+func modulos(bc barcode.Barcode) [][]bool {
+	b := bc.Bounds()
+	linhas := make([][]bool, 0, b.Dy())
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		linha := make([]bool, 0, b.Dx())
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, _, _, _ := bc.At(x, y).RGBA()
+			linha = append(linha, r < 0x8000)
+		}
+		linhas = append(linhas, linha)
+	}
+	return linhas
+}
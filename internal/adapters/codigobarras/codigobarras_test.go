@@ -0,0 +1,120 @@
+package codigobarras
+
+import (
+	"bytes"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func itemEtiqueta() entity.Item {
+	return entity.Item{ID: 42, Code: "MO12345678", Nome: "Monitor"}
+}
+
+func TestParseFormatoESaida(t *testing.T) {
+	//ACT
+	formato, err := ParseFormato("")
+	_, errFormato := ParseFormato("pdf417")
+	saida, errSaida := ParseSaida("svg")
+	_, errSaidaInvalida := ParseSaida("jpg")
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, FormatoCode128, formato)
+	assert.Error(t, errFormato)
+	assert.NoError(t, errSaida)
+	assert.Equal(t, "image/svg+xml", saida.ContentType())
+	assert.Error(t, errSaidaInvalida)
+}
+
+func TestGerar_EAN13SemCadastroUsaOInterno(t *testing.T) {
+	//ACT
+	codigo, err := Gerar(itemEtiqueta(), FormatoEAN13)
+
+	//ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, "2000000000428", codigo.Conteudo)
+	assert.False(t, codigo.DuasDimensoes())
+	assert.Len(t, codigo.Modulos[0], 95)                              // 3 + 42 + 5 + 42 + 3
+	assert.Equal(t, []bool{true, false, true}, codigo.Modulos[0][:3]) // Guarda inicial
+}
+
+func TestGerar_EAN13Cadastrado(t *testing.T) {
+	item := itemEtiqueta()
+	ean := "7891000315507"
+	item.EAN = &ean
+
+	//ACT
+	codigo, err := Gerar(item, FormatoEAN13)
+
+	//ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, ean, codigo.Conteudo)
+}
+
+func TestGerar_QRQuadrado(t *testing.T) {
+	//ACT
+	codigo, err := Gerar(itemEtiqueta(), FormatoQR)
+
+	//ASSERT
+	require.NoError(t, err)
+	assert.True(t, codigo.DuasDimensoes())
+	assert.Len(t, codigo.Modulos, len(codigo.Modulos[0]))
+	assert.Equal(t, "MO12345678", codigo.Conteudo)
+}
+
+func TestEscreverPNG_ComZonaDeSilencio(t *testing.T) {
+	codigo, err := Gerar(itemEtiqueta(), FormatoCode128)
+	require.NoError(t, err)
+	var saida bytes.Buffer
+
+	//ACT
+	require.NoError(t, EscreverPNG(&saida, codigo))
+
+	//ASSERT
+	img, err := png.Decode(&saida)
+	require.NoError(t, err)
+	b := img.Bounds()
+	assert.Equal(t, (len(codigo.Modulos[0])+2*margemBarras)*pixelsModulo, b.Dx())
+	assert.Equal(t, alturaBarras, b.Dy())
+	r, _, _, _ := img.At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xffff), r) // Margem branca
+	r, _, _, _ = img.At(margemBarras*pixelsModulo, b.Dy()/2).RGBA()
+	assert.Equal(t, uint32(0), r) // Code 128 começa com barra
+}
+
+func TestEscreverSVG(t *testing.T) {
+	codigo, err := Gerar(itemEtiqueta(), FormatoQR)
+	require.NoError(t, err)
+	var saida bytes.Buffer
+
+	//ACT
+	require.NoError(t, EscreverSVG(&saida, codigo))
+
+	//ASSERT
+	svg := saida.String()
+	assert.True(t, strings.HasPrefix(svg, "<svg "))
+	assert.True(t, strings.HasSuffix(svg, "</svg>"))
+	assert.Contains(t, svg, `<path fill="#000" d="M32 32h`) // Canto do QR escuro, depois da margem
+}
+
+func TestFolhaEtiquetas_QuebraEmFolhas(t *testing.T) {
+	codigo, err := Gerar(itemEtiqueta(), FormatoCode128)
+	require.NoError(t, err)
+	etiquetas := make([]Etiqueta, EtiquetasPorFolha+1)
+	for i := range etiquetas {
+		etiquetas[i] = Etiqueta{Nome: "Monitor de 27 polegadas com ajuste de altura e pivô", Preco: "BRL 1234.50", Codigo: codigo}
+	}
+	var saida bytes.Buffer
+
+	//ACT
+	require.NoError(t, FolhaEtiquetas(&saida, etiquetas))
+
+	//ASSERT
+	pdf := saida.String()
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-"))
+	assert.Contains(t, pdf, "/Count 2")
+}
@@ -0,0 +1,119 @@
+package codigobarras
+
+import (
+	"github.com/go-pdf/fpdf"
+	"io"
+)
+
+// Folha A4 de 3×8 etiquetas de 70×37 mm (o modelo mais comum de etiqueta
+// adesiva), medidas em milímetros
+const (
+	EtiquetasPorFolha = colunasFolha * linhasFolha
+
+	colunasFolha    = 3
+	linhasFolha     = 8
+	larguraEtiqueta = 70.0
+	alturaEtiqueta  = 37.0
+	topoFolha       = (297 - linhasFolha*alturaEtiqueta) / 2
+	respiro         = 3.0  // Margem interna da etiqueta
+	alturaBarrasMM  = 15.0 // Código de barras
+	ladoQRMM        = 18.0
+	moduloMaximoMM  = 0.5 // Barras mais largas que isso não ajudam o leitor
+)
+
+// Etiqueta é o que vai impresso: nome, preço já formatado e o código
+type Etiqueta struct {
+	Nome   string
+	Preco  string
+	Codigo Codigo
+}
+
+// FolhaEtiquetas monta o PDF com uma etiqueta por item, na ordem,
+// completando quantas folhas forem precisas
+func FolhaEtiquetas(w io.Writer, etiquetas []Etiqueta) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetCreator("desafio-itens-app", true)
+	pdf.SetFillColor(0, 0, 0)
+	traduzir := pdf.UnicodeTranslatorFromDescriptor("") // UTF-8 → cp1252 das fontes padrão
+
+	for i, etiqueta := range etiquetas {
+		posicao := i % EtiquetasPorFolha
+		if posicao == 0 {
+			pdf.AddPage()
+		}
+		x := float64(posicao%colunasFolha) * larguraEtiqueta
+		y := topoFolha + float64(posicao/colunasFolha)*alturaEtiqueta
+		desenharEtiqueta(pdf, traduzir, x, y, etiqueta)
+	}
+	if len(etiquetas) == 0 {
+		pdf.AddPage() // PDF sem página não abre em alguns leitores
+	}
+	return pdf.Output(w)
+}
+
+func desenharEtiqueta(pdf *fpdf.Fpdf, traduzir func(string) string, x, y float64, e Etiqueta) {
+	util := larguraEtiqueta - 2*respiro
+
+	// 🏷️ Nome no topo, cortado se não couber
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetXY(x+respiro, y+respiro)
+	pdf.CellFormat(util, 4, caber(pdf, traduzir(e.Nome), util), "", 0, "L", false, 0, "")
+
+	// ▮ Código centralizado
+	topo := y + respiro + 5
+	desenharCodigo(pdf, e.Codigo, x+respiro, topo, util)
+
+	// 🔢 Conteúdo do código à esquerda e preço à direita, no rodapé
+	base := y + alturaEtiqueta - respiro - 4
+	pdf.SetFont("Helvetica", "", 7)
+	pdf.SetXY(x+respiro, base)
+	pdf.CellFormat(util/2, 4, traduzir(e.Codigo.Conteudo), "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.SetXY(x+respiro+util/2, base)
+	pdf.CellFormat(util/2, 4, traduzir(e.Preco), "", 0, "R", false, 0, "")
+}
+
+// desenharCodigo desenha os módulos como retângulos: vetor puro, nítido
+// em qualquer impressora
+func desenharCodigo(pdf *fpdf.Fpdf, c Codigo, x, y, largura float64) {
+	if c.DuasDimensoes() {
+		modulo := ladoQRMM / float64(len(c.Modulos))
+		esquerda := x + (largura-ladoQRMM)/2
+		for my, linha := range c.Modulos {
+			desenharLinha(pdf, linha, esquerda, y+float64(my)*modulo, modulo, modulo)
+		}
+		return
+	}
+
+	// Barras: a zona de silêncio cabe dentro da largura útil
+	modulo := min(moduloMaximoMM, largura/float64(c.largura()+2*margemBarras))
+	esquerda := x + (largura-float64(c.largura())*modulo)/2
+	desenharLinha(pdf, c.Modulos[0], esquerda, y, modulo, alturaBarrasMM)
+}
+
+func desenharLinha(pdf *fpdf.Fpdf, linha []bool, x, y, modulo, altura float64) {
+	for i := 0; i < len(linha); {
+		if !linha[i] {
+			i++
+			continue
+		}
+		inicio := i
+		for i < len(linha) && linha[i] {
+			i++
+		}
+		pdf.Rect(x+float64(inicio)*modulo, y, float64(i-inicio)*modulo, altura, "F")
+	}
+}
+
+// caber corta o texto com "..." até caber na largura, com a fonte atual
+func caber(pdf *fpdf.Fpdf, texto string, largura float64) string {
+	if pdf.GetStringWidth(texto) <= largura {
+		return texto
+	}
+	for len(texto) > 0 && pdf.GetStringWidth(texto+"...") > largura {
+		texto = texto[:len(texto)-1] // Já em cp1252: um byte por caractere
+	}
+	return texto + "..."
+}
@@ -0,0 +1,102 @@
+package codigobarras
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// Tamanhos em módulos e pixels. A zona de silêncio (margem branca) é a que
+// os leitores exigem: 10 módulos nas barras, 4 no QR.
+const (
+	margemBarras = 10
+	margemQR     = 4
+	pixelsModulo = 3  // Barras: 3px por módulo lê bem em impressora térmica
+	pixelsQR     = 8  // QR: 8px por módulo
+	alturaBarras = 80 // Pixels (PNG) ou módulos×pixelsModulo (SVG)
+)
+
+func (c Codigo) margem() int {
+	if c.DuasDimensoes() {
+		return margemQR
+	}
+	return margemBarras
+}
+
+func (c Codigo) escala() int {
+	if c.DuasDimensoes() {
+		return pixelsQR
+	}
+	return pixelsModulo
+}
+
+// dimensoes em pixels, com a margem
+func (c Codigo) dimensoes() (int, int) {
+	escala := c.escala()
+	largura := (c.largura() + 2*c.margem()) * escala
+	if c.DuasDimensoes() {
+		return largura, (len(c.Modulos) + 2*c.margem()) * escala
+	}
+	return largura, alturaBarras
+}
+
+// EscreverPNG desenha o código em preto e branco, sem suavização
+func EscreverPNG(w io.Writer, c Codigo) error {
+	largura, altura := c.dimensoes()
+	img := image.NewGray(image.Rect(0, 0, largura, altura))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	escala, margem := c.escala(), c.margem()
+	for y := 0; y < altura; y++ {
+		linha := c.Modulos[0] // Barras: a mesma linha de cima a baixo
+		if c.DuasDimensoes() {
+			my := y/escala - margem
+			if my < 0 || my >= len(c.Modulos) {
+				continue
+			}
+			linha = c.Modulos[my]
+		}
+		for x := 0; x < largura; x++ {
+			mx := x/escala - margem
+			if mx >= 0 && mx < len(linha) && linha[mx] {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+// EscreverSVG desenha o código como um path só, um retângulo por
+// sequência de módulos escuros
+func EscreverSVG(w io.Writer, c Codigo) error {
+	largura, altura := c.dimensoes()
+	escala, margem := c.escala(), c.margem()
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, largura, altura, largura, altura)
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, largura, altura)
+	for y, linha := range c.Modulos {
+		topo, alturaLinha := (y+margem)*escala, escala
+		if !c.DuasDimensoes() {
+			topo, alturaLinha = 0, altura
+		}
+		for x := 0; x < len(linha); {
+			if !linha[x] {
+				x++
+				continue
+			}
+			inicio := x
+			for x < len(linha) && linha[x] {
+				x++
+			}
+			fmt.Fprintf(bw, "M%d %dh%dv%dh-%dz", (inicio+margem)*escala, topo, (x-inicio)*escala, alturaLinha, (x-inicio)*escala)
+		}
+	}
+	fmt.Fprint(bw, `"/></svg>`)
+	return bw.Flush()
+}
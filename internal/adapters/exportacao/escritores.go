@@ -0,0 +1,167 @@
+package exportacao
+
+import (
+	"bufio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/language"
+	"io"
+	"time"
+)
+
+// 📄 CSV: preço formatado no locale; em locales com vírgula decimal o
+// separador vira ";" para o Excel abrir direto
+type escritorCSV struct {
+	csv     *csv.Writer
+	colunas []Coluna
+	fmt     formatador
+	linha   []string
+}
+
+func novoEscritorCSV(w io.Writer, colunas []Coluna, locale language.Tag) (*escritorCSV, error) {
+	e := &escritorCSV{
+		csv:     csv.NewWriter(w),
+		colunas: colunas,
+		fmt:     novoFormatador(locale),
+		linha:   make([]string, len(colunas)),
+	}
+	if e.fmt.separadorDecimalVirgula() {
+		e.csv.Comma = ';'
+	}
+
+	for i, c := range colunas {
+		e.linha[i] = string(c)
+	}
+	if err := e.csv.Write(e.linha); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *escritorCSV) Escrever(item entity.Item) error {
+	for i, c := range e.colunas {
+		e.linha[i] = e.fmt.texto(c, item)
+	}
+	return e.csv.Write(e.linha)
+}
+
+func (e *escritorCSV) Fechar() error {
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+// 🧾 JSON Lines: um objeto por linha, só com as colunas pedidas e o preço
+// como número (quem consome JSON formata como quiser)
+type escritorJSONL struct {
+	w       *bufio.Writer
+	encoder *json.Encoder
+	colunas []Coluna
+}
+
+func novoEscritorJSONL(w io.Writer, colunas []Coluna) *escritorJSONL {
+	buffer := bufio.NewWriter(w)
+	return &escritorJSONL{w: buffer, encoder: json.NewEncoder(buffer), colunas: colunas}
+}
+
+func (e *escritorJSONL) Escrever(item entity.Item) error {
+	objeto := make(map[string]any, len(e.colunas))
+	for _, c := range e.colunas {
+		v := valor(c, item)
+		if c == ColunaPreco {
+			v = json.Number(item.Preco.String()) // Decimal exato
+		}
+		objeto[string(c)] = v
+	}
+	return e.encoder.Encode(objeto)
+}
+
+func (e *escritorJSONL) Fechar() error {
+	return e.w.Flush()
+}
+
+// 📊 XLSX: StreamWriter do excelize grava linha a linha em arquivo
+// temporário (não guarda tudo na memória); o .xlsx sai inteiro no Fechar.
+// O preço vai como número com formato "#,##0.00", que o Excel mostra no
+// locale de quem abre.
+type escritorXLSX struct {
+	w       io.Writer
+	arquivo *excelize.File
+	stream  *excelize.StreamWriter
+	colunas []Coluna
+	linha   int
+
+	estiloPreco int
+	estiloData  int
+}
+
+const abaItens = "Itens"
+
+func novoEscritorXLSX(w io.Writer, colunas []Coluna) (*escritorXLSX, error) {
+	arquivo := excelize.NewFile()
+	if err := arquivo.SetSheetName("Sheet1", abaItens); err != nil {
+		return nil, err
+	}
+
+	stream, err := arquivo.NewStreamWriter(abaItens)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &escritorXLSX{w: w, arquivo: arquivo, stream: stream, colunas: colunas, linha: 1}
+
+	estiloCabecalho, err := arquivo.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, err
+	}
+	if e.estiloPreco, err = arquivo.NewStyle(&excelize.Style{NumFmt: 4}); err != nil { // #,##0.00
+		return nil, err
+	}
+	if e.estiloData, err = arquivo.NewStyle(&excelize.Style{NumFmt: 22}); err != nil { // m/d/yy h:mm
+		return nil, err
+	}
+
+	cabecalho := make([]any, len(colunas))
+	for i, c := range colunas {
+		cabecalho[i] = excelize.Cell{StyleID: estiloCabecalho, Value: string(c)}
+	}
+	if err := e.escreverLinha(cabecalho); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *escritorXLSX) Escrever(item entity.Item) error {
+	celulas := make([]any, len(e.colunas))
+	for i, c := range e.colunas {
+		v := valor(c, item)
+		switch valorTipado := v.(type) {
+		case dinheiro.Dinheiro:
+			celulas[i] = excelize.Cell{StyleID: e.estiloPreco, Value: valorTipado.Float64()}
+		case time.Time:
+			celulas[i] = excelize.Cell{StyleID: e.estiloData, Value: valorTipado}
+		default:
+			celulas[i] = v
+		}
+	}
+	return e.escreverLinha(celulas)
+}
+
+func (e *escritorXLSX) escreverLinha(celulas []any) error {
+	celula, err := excelize.CoordinatesToCellName(1, e.linha)
+	if err != nil {
+		return err
+	}
+	e.linha++
+	return e.stream.SetRow(celula, celulas)
+}
+
+func (e *escritorXLSX) Fechar() error {
+	defer e.arquivo.Close() // Remove os temporários do StreamWriter
+	if err := e.stream.Flush(); err != nil {
+		return err
+	}
+	return e.arquivo.Write(e.w)
+}
@@ -0,0 +1,204 @@
+package exportacao
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"fmt"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Formato string
+
+const (
+	FormatoCSV   Formato = "csv"
+	FormatoJSONL Formato = "jsonl"
+	FormatoXLSX  Formato = "xlsx"
+)
+
+// ContentType e Extensao montam os headers do download
+func (f Formato) ContentType() string {
+	switch f {
+	case FormatoCSV:
+		return "text/csv; charset=utf-8"
+	case FormatoJSONL:
+		return "application/jsonl; charset=utf-8"
+	case FormatoXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/octet-stream"
+}
+
+func (f Formato) Extensao() string {
+	return string(f)
+}
+
+type Coluna string
+
+const (
+	ColunaID        Coluna = "id"
+	ColunaCode      Coluna = "code"
+	ColunaNome      Coluna = "nome"
+	ColunaDescricao Coluna = "descricao"
+	ColunaPreco     Coluna = "preco"
+	ColunaMoeda     Coluna = "moeda"
+	ColunaEstoque   Coluna = "estoque"
+	ColunaStatus    Coluna = "status"
+	ColunaCreatedAt Coluna = "created_at"
+	ColunaUpdatedAt Coluna = "updated_at"
+)
+
+// ColunasPadrao é a ordem usada quando o cliente não escolhe colunas
+var ColunasPadrao = []Coluna{
+	ColunaID, ColunaCode, ColunaNome, ColunaDescricao, ColunaPreco, ColunaMoeda,
+	ColunaEstoque, ColunaStatus, ColunaCreatedAt, ColunaUpdatedAt,
+}
+
+// ParseColunas lê "code,nome,preco" mantendo a ordem pedida
+func ParseColunas(texto string) ([]Coluna, error) {
+	if strings.TrimSpace(texto) == "" {
+		return ColunasPadrao, nil
+	}
+
+	validas := make(map[Coluna]bool, len(ColunasPadrao))
+	for _, c := range ColunasPadrao {
+		validas[c] = true
+	}
+
+	var colunas []Coluna
+	vistas := make(map[Coluna]bool)
+	for _, parte := range strings.Split(texto, ",") {
+		c := Coluna(strings.ToLower(strings.TrimSpace(parte)))
+		if !validas[c] {
+			return nil, fmt.Errorf("coluna desconhecida: %q", parte)
+		}
+		if !vistas[c] {
+			vistas[c] = true
+			colunas = append(colunas, c)
+		}
+	}
+	return colunas, nil
+}
+
+// Locales com formatação de preço suportada; o primeiro é o padrão
+var locales = []language.Tag{language.BrazilianPortuguese, language.AmericanEnglish, language.Spanish}
+
+var matcherLocale = language.NewMatcher(locales)
+
+// EscolherLocale usa o ?locale= explícito ou, sem ele, o Accept-Language
+func EscolherLocale(explicito, acceptLanguage string) language.Tag {
+	preferencias, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	if explicito != "" {
+		if tag, err := language.Parse(explicito); err == nil {
+			preferencias = []language.Tag{tag}
+		}
+	}
+	_, indice, _ := matcherLocale.Match(preferencias...)
+	return locales[indice]
+}
+
+// Escritor grava os itens um a um no formato escolhido. Fechar finaliza o
+// arquivo (obrigatório: o XLSX só é escrito no final).
+type Escritor interface {
+	Escrever(item entity.Item) error
+	Fechar() error
+}
+
+func NovoEscritor(formato Formato, w io.Writer, colunas []Coluna, locale language.Tag) (Escritor, error) {
+	switch formato {
+	case FormatoCSV:
+		return novoEscritorCSV(w, colunas, locale)
+	case FormatoJSONL:
+		return novoEscritorJSONL(w, colunas), nil
+	case FormatoXLSX:
+		return novoEscritorXLSX(w, colunas)
+	default:
+		return nil, fmt.Errorf("formato não suportado: %q (use csv, jsonl ou xlsx)", formato)
+	}
+}
+
+// formatador escreve preços e números no padrão do locale (1.234,56 em pt-BR)
+type formatador struct {
+	printer *message.Printer
+}
+
+func novoFormatador(locale language.Tag) formatador {
+	return formatador{printer: message.NewPrinter(locale)}
+}
+
+func (f formatador) preco(valor dinheiro.Dinheiro) string {
+	return f.printer.Sprintf("%.2f", valor.Float64())
+}
+
+// separadorDecimalVirgula indica locales em que o Excel espera ";" no CSV
+func (f formatador) separadorDecimalVirgula() bool {
+	return strings.Contains(f.printer.Sprintf("%.1f", 1.5), ",")
+}
+
+// texto devolve o valor da coluna como string (CSV)
+func (f formatador) texto(c Coluna, item entity.Item) string {
+	switch c {
+	case ColunaID:
+		return strconv.Itoa(item.ID)
+	case ColunaCode:
+		return semFormula(item.Code)
+	case ColunaNome:
+		return semFormula(item.Nome)
+	case ColunaDescricao:
+		return semFormula(item.Descricao)
+	case ColunaPreco:
+		return f.preco(item.Preco)
+	case ColunaMoeda:
+		return string(item.Preco.Moeda)
+	case ColunaEstoque:
+		return strconv.Itoa(item.Estoque)
+	case ColunaStatus:
+		return string(item.Status)
+	case ColunaCreatedAt:
+		return item.CreatedAt.Format(time.RFC3339)
+	case ColunaUpdatedAt:
+		return item.UpdatedAt.Format(time.RFC3339)
+	}
+	return ""
+}
+
+// semFormula evita injeção de fórmula no CSV: o Excel executa células que
+// começam com =, +, -, @, tab ou CR. O apóstrofo na frente faz a planilha
+// mostrar o texto como texto.
+func semFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// valor devolve o valor da coluna com o tipo nativo (JSON e XLSX)
+func valor(c Coluna, item entity.Item) any {
+	switch c {
+	case ColunaID:
+		return item.ID
+	case ColunaCode:
+		return item.Code
+	case ColunaNome:
+		return item.Nome
+	case ColunaDescricao:
+		return item.Descricao
+	case ColunaPreco:
+		return item.Preco
+	case ColunaMoeda:
+		return string(item.Preco.Moeda)
+	case ColunaEstoque:
+		return item.Estoque
+	case ColunaStatus:
+		return string(item.Status)
+	case ColunaCreatedAt:
+		return item.CreatedAt
+	case ColunaUpdatedAt:
+		return item.UpdatedAt
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package exportacao
+
+import (
+	"bytes"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/language"
+	"testing"
+	"time"
+)
+
+func itemExportacao() entity.Item {
+	data := time.Date(2025, 3, 10, 14, 30, 0, 0, time.UTC)
+	return entity.Item{
+		ID: 7, Code: "MO12345678", Nome: "Monitor", Descricao: "27 polegadas",
+		Preco: dinheiro.Novo(123450, dinheiro.BRL), Estoque: 3, Status: entity.StatusAtivo,
+		CreatedAt: data, UpdatedAt: data,
+	}
+}
+
+func exportar(t *testing.T, formato Formato, colunas []Coluna, locale language.Tag) []byte {
+	t.Helper()
+	var saida bytes.Buffer
+	escritor, err := NovoEscritor(formato, &saida, colunas, locale)
+	require.NoError(t, err)
+	require.NoError(t, escritor.Escrever(itemExportacao()))
+	require.NoError(t, escritor.Fechar())
+	return saida.Bytes()
+}
+
+func TestParseColunas(t *testing.T) {
+	//ACT
+	colunas, err := ParseColunas(" Code,preco,code ")
+	padrao, errPadrao := ParseColunas("")
+	_, errInvalida := ParseColunas("code,senha")
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, []Coluna{ColunaCode, ColunaPreco}, colunas)
+	assert.NoError(t, errPadrao)
+	assert.Equal(t, ColunasPadrao, padrao)
+	assert.EqualError(t, errInvalida, `coluna desconhecida: "senha"`)
+}
+
+func TestEscolherLocale(t *testing.T) {
+	assert.Equal(t, language.BrazilianPortuguese, EscolherLocale("", ""))
+	assert.Equal(t, language.AmericanEnglish, EscolherLocale("", "en-US,en;q=0.9"))
+	assert.Equal(t, language.AmericanEnglish, EscolherLocale("en-US", "pt-BR"))
+	assert.Equal(t, language.BrazilianPortuguese, EscolherLocale("", "ja-JP"))
+}
+
+func TestEscritorCSV_PtBR(t *testing.T) {
+	//ACT
+	saida := exportar(t, FormatoCSV, []Coluna{ColunaCode, ColunaPreco, ColunaCreatedAt}, language.BrazilianPortuguese)
+
+	//ASSERT
+	assert.Equal(t, "code;preco;created_at\nMO12345678;1.234,50;2025-03-10T14:30:00Z\n", string(saida))
+}
+
+func TestEscritorCSV_EnUS(t *testing.T) {
+	//ACT
+	saida := exportar(t, FormatoCSV, []Coluna{ColunaNome, ColunaPreco}, language.AmericanEnglish)
+
+	//ASSERT
+	assert.Equal(t, "nome,preco\nMonitor,\"1,234.50\"\n", string(saida))
+}
+
+func TestEscritorCSV_NeutralizaFormulas(t *testing.T) {
+	//ARRANGE
+	var saida bytes.Buffer
+	escritor, err := NovoEscritor(FormatoCSV, &saida, []Coluna{ColunaNome, ColunaDescricao}, language.AmericanEnglish)
+	require.NoError(t, err)
+	nomes := []string{"=HYPERLINK(\"http://x\")", "+1", "-1", "@SUM(A1)", "\tcmd", "\rcmd", "Mouse-pad"}
+
+	//ACT
+	for _, nome := range nomes {
+		require.NoError(t, escritor.Escrever(entity.Item{Nome: nome}))
+	}
+	require.NoError(t, escritor.Fechar())
+
+	//ASSERT
+	assert.Equal(t, "nome,descricao\n"+
+		"\"'=HYPERLINK(\"\"http://x\"\")\",\n"+
+		"'+1,\n"+
+		"'-1,\n"+
+		"'@SUM(A1),\n"+
+		"'\tcmd,\n"+
+		"\"'\rcmd\",\n"+
+		"Mouse-pad,\n", saida.String())
+}
+
+func TestEscritorJSONL_SoColunasEscolhidas(t *testing.T) {
+	//ACT
+	saida := exportar(t, FormatoJSONL, []Coluna{ColunaID, ColunaPreco, ColunaStatus}, language.BrazilianPortuguese)
+
+	//ASSERT
+	assert.Equal(t, `{"id":7,"preco":1234.50,"status":"active"}`+"\n", string(saida))
+}
+
+func TestEscritorXLSX(t *testing.T) {
+	//ACT
+	saida := exportar(t, FormatoXLSX, []Coluna{ColunaCode, ColunaPreco, ColunaEstoque}, language.BrazilianPortuguese)
+
+	//ASSERT
+	arquivo, err := excelize.OpenReader(bytes.NewReader(saida))
+	require.NoError(t, err)
+	defer arquivo.Close()
+
+	linhas, err := arquivo.GetRows(abaItens, excelize.Options{RawCellValue: true})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"code", "preco", "estoque"},
+		{"MO12345678", "1234.5", "3"},
+	}, linhas)
+}
+
+func TestNovoEscritor_FormatoInvalido(t *testing.T) {
+	//ACT
+	_, err := NovoEscritor("pdf", &bytes.Buffer{}, ColunasPadrao, language.BrazilianPortuguese)
+
+	//ASSERT
+	assert.Error(t, err)
+}
@@ -22,6 +22,11 @@ func NewJWTService(secretKey string) *JWTService {
 	}
 }
 
+// ChaveCarregada indica se há uma chave para assinar/validar tokens (usado no /readyz)
+func (j *JWTService) ChaveCarregada() bool {
+	return len(j.secretKey) > 0
+}
+
 func (j *JWTService) GenerateToken(userID int, username string, role string) (string, error) {
 
 	claims := Claims{
@@ -0,0 +1,101 @@
+package certificado
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Recarregavel entrega o certificado TLS lido do disco e o relê quando os
+// arquivos mudam (renovação do Let's Encrypt, cert-manager etc.), sem
+// precisar reiniciar o servidor. Use GetCertificate no tls.Config.
+type Recarregavel struct {
+	certFile  string
+	keyFile   string
+	intervalo time.Duration // Frequência máxima de checagem dos arquivos
+	logger    *slog.Logger
+
+	mu           sync.Mutex
+	cert         *tls.Certificate
+	modificadoEm time.Time // mtime mais recente entre cert e chave
+	verificadoEm time.Time
+}
+
+func New(certFile, keyFile string, intervalo time.Duration, logger *slog.Logger) (*Recarregavel, error) {
+	r := &Recarregavel{
+		certFile:  certFile,
+		keyFile:   keyFile,
+		intervalo: intervalo,
+		logger:    logger,
+	}
+
+	modificadoEm, err := r.ultimaModificacao()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.carregar(modificadoEm); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate segue a assinatura de tls.Config.GetCertificate
+func (r *Recarregavel) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recarregarSeMudou()
+	return r.cert, nil
+}
+
+// recarregarSeMudou relê o par cert/chave se algum arquivo foi alterado.
+// Se a nova versão for inválida (ex.: pega no meio da escrita), mantém a
+// anterior e tenta de novo na próxima checagem. Chamar com mu travado.
+func (r *Recarregavel) recarregarSeMudou() {
+	if time.Since(r.verificadoEm) < r.intervalo {
+		return
+	}
+	r.verificadoEm = time.Now()
+
+	modificadoEm, err := r.ultimaModificacao()
+	if err != nil {
+		r.logger.Warn("não foi possível verificar o certificado TLS", "erro", err)
+		return
+	}
+	if !modificadoEm.After(r.modificadoEm) {
+		return
+	}
+
+	if err := r.carregar(modificadoEm); err != nil {
+		r.logger.Warn("certificado TLS novo inválido, mantendo o anterior", "erro", err)
+		return
+	}
+	r.logger.Info("certificado TLS recarregado", "arquivo", r.certFile)
+}
+
+func (r *Recarregavel) carregar(modificadoEm time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("erro ao carregar certificado TLS: %w", err)
+	}
+	r.cert = &cert
+	r.modificadoEm = modificadoEm
+	return nil
+}
+
+func (r *Recarregavel) ultimaModificacao() (time.Time, error) {
+	var maisRecente time.Time
+	for _, arquivo := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(arquivo)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(maisRecente) {
+			maisRecente = info.ModTime()
+		}
+	}
+	return maisRecente, nil
+}
@@ -0,0 +1,113 @@
+package certificado
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// gravarCertificado cria um certificado autoassinado com o CN informado
+func gravarCertificado(t *testing.T, dir, cn string, modificadoEm time.Time) (string, string) {
+	t.Helper()
+
+	chave, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	modelo := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, modelo, modelo, &chave.PublicKey, chave)
+	assert.NoError(t, err)
+	chaveDER, err := x509.MarshalECPrivateKey(chave)
+	assert.NoError(t, err)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	assert.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: chaveDER}), 0o600))
+	assert.NoError(t, os.Chtimes(certFile, modificadoEm, modificadoEm))
+	assert.NoError(t, os.Chtimes(keyFile, modificadoEm, modificadoEm))
+
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, r *Recarregavel) string {
+	t.Helper()
+	cert, err := r.GetCertificate(nil)
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestRecarregavel_RecarregaQuandoArquivoMuda(t *testing.T) {
+	//ARRANGE
+	dir := t.TempDir()
+	agora := time.Now()
+	certFile, keyFile := gravarCertificado(t, dir, "antigo", agora.Add(-time.Minute))
+
+	r, err := New(certFile, keyFile, 0, slog.New(slog.DiscardHandler))
+	assert.NoError(t, err)
+	assert.Equal(t, "antigo", commonName(t, r))
+
+	//ACT
+	gravarCertificado(t, dir, "novo", agora)
+
+	//ASSERT
+	assert.Equal(t, "novo", commonName(t, r))
+}
+
+func TestRecarregavel_MantemAnteriorSeNovoForInvalido(t *testing.T) {
+	//ARRANGE
+	dir := t.TempDir()
+	agora := time.Now()
+	certFile, keyFile := gravarCertificado(t, dir, "valido", agora.Add(-time.Minute))
+
+	r, err := New(certFile, keyFile, 0, slog.New(slog.DiscardHandler))
+	assert.NoError(t, err)
+
+	//ACT
+	assert.NoError(t, os.WriteFile(certFile, []byte("lixo"), 0o600))
+	assert.NoError(t, os.Chtimes(certFile, agora, agora))
+
+	//ASSERT
+	assert.Equal(t, "valido", commonName(t, r))
+}
+
+func TestRecarregavel_RespeitaIntervalo(t *testing.T) {
+	//ARRANGE
+	dir := t.TempDir()
+	agora := time.Now()
+	certFile, keyFile := gravarCertificado(t, dir, "antigo", agora.Add(-time.Minute))
+
+	r, err := New(certFile, keyFile, time.Hour, slog.New(slog.DiscardHandler))
+	assert.NoError(t, err)
+	commonName(t, r) // primeira checagem
+
+	//ACT
+	gravarCertificado(t, dir, "novo", agora)
+
+	//ASSERT
+	assert.Equal(t, "antigo", commonName(t, r))
+}
+
+func TestNew_ArquivoInexistente(t *testing.T) {
+	//ACT
+	_, err := New("nao-existe.crt", "nao-existe.key", 0, slog.New(slog.DiscardHandler))
+
+	//ASSERT
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"encoding/json"
+	"time"
+)
+
+// DefinirTaxaRequest: 1 unidade da moeda de origem vale Taxa na de destino.
+// Aceita número ou texto ("5.4321") sem perder casas.
+type DefinirTaxaRequest struct {
+	Taxa json.Number `json:"taxa" binding:"required"`
+}
+
+type TaxaCambioResponse struct {
+	De            dinheiro.Moeda `json:"de"`
+	Para          dinheiro.Moeda `json:"para"`
+	Taxa          json.Number    `json:"taxa"`
+	AtualizadaEm  time.Time      `json:"atualizada_em"`
+	AtualizadaPor int            `json:"atualizada_por,omitempty"`
+}
+
+// PrecoConvertidoResponse acompanha o item quando a listagem pede ?moeda=
+type PrecoConvertidoResponse struct {
+	Preco    json.Number    `json:"preco"`
+	Moeda    dinheiro.Moeda `json:"moeda"`
+	Taxa     json.Number    `json:"taxa"`
+	CotadaEm *time.Time     `json:"cotada_em,omitempty"` // Vazio quando o item já está na moeda pedida
+}
+
+func FromTaxa(taxa cambio.Taxa) TaxaCambioResponse {
+	return TaxaCambioResponse{
+		De:            taxa.De,
+		Para:          taxa.Para,
+		Taxa:          json.Number(taxa.Texto()),
+		AtualizadaEm:  taxa.AtualizadaEm,
+		AtualizadaPor: taxa.AtualizadaPor,
+	}
+}
+
+func FromConversao(conversao cambio.Conversao) *PrecoConvertidoResponse {
+	resp := &PrecoConvertidoResponse{
+		Preco: json.Number(conversao.Valor.String()),
+		Moeda: conversao.Valor.Moeda,
+		Taxa:  json.Number(cambio.FormatarTaxa(conversao.Taxa)),
+	}
+	if !conversao.CotadaEm.IsZero() {
+		resp.CotadaEm = &conversao.CotadaEm
+	}
+	return resp
+}
@@ -0,0 +1,168 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/compra"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// LinhaCompraRequest: sem custo, vale o custo do item no fornecedor
+type LinhaCompraRequest struct {
+	ItemID     int         `json:"item_id" binding:"required"`
+	Quantidade int         `json:"quantidade" binding:"required,min=1"`
+	Custo      json.Number `json:"custo"`
+	Moeda      string      `json:"moeda"`
+}
+
+// AlterarPedidoCompraRequest é o PUT de um rascunho; o fornecedor não muda
+type AlterarPedidoCompraRequest struct {
+	Observacao string               `json:"observacao"`
+	Linhas     []LinhaCompraRequest `json:"linhas" binding:"required,min=1,dive"`
+}
+
+func (r *AlterarPedidoCompraRequest) ToLinhas() ([]compra.Linha, error) {
+	linhas := make([]compra.Linha, 0, len(r.Linhas))
+	for i, l := range r.Linhas {
+		linha := compra.Linha{ItemID: l.ItemID, Quantidade: l.Quantidade}
+		if l.Custo != "" {
+			custo, err := lerPreco(l.Custo, l.Moeda)
+			if err != nil {
+				return nil, fmt.Errorf("linha %d: %w", i+1, err)
+			}
+			linha.CustoUnitario = custo
+		}
+		linhas = append(linhas, linha)
+	}
+	return linhas, nil
+}
+
+type PedidoCompraRequest struct {
+	FornecedorID int `json:"fornecedor_id" binding:"required"`
+	AlterarPedidoCompraRequest
+}
+
+func (r *PedidoCompraRequest) ToEntity(criadoPor int) (compra.Pedido, error) {
+	linhas, err := r.ToLinhas()
+	if err != nil {
+		return compra.Pedido{}, err
+	}
+	return compra.Pedido{
+		FornecedorID: r.FornecedorID,
+		Observacao:   r.Observacao,
+		Linhas:       linhas,
+		CriadoPor:    criadoPor,
+	}, nil
+}
+
+type RecebimentoCompraRequest struct {
+	Itens []struct {
+		ItemID     int `json:"item_id" binding:"required"`
+		Quantidade int `json:"quantidade" binding:"required,min=1"`
+	} `json:"itens" binding:"required,min=1,dive"`
+}
+
+// ToQuantidades monta itemID → quantidade; o mesmo item duas vezes é erro
+func (r *RecebimentoCompraRequest) ToQuantidades() (map[int]int, error) {
+	quantidades := make(map[int]int, len(r.Itens))
+	for _, i := range r.Itens {
+		if _, repetido := quantidades[i.ItemID]; repetido {
+			return nil, fmt.Errorf("item %d repetido", i.ItemID)
+		}
+		quantidades[i.ItemID] = i.Quantidade
+	}
+	return quantidades, nil
+}
+
+type LinhaCompraResponse struct {
+	ID         int            `json:"id"`
+	ItemID     int            `json:"item_id"`
+	Quantidade int            `json:"quantidade"`
+	Recebida   int            `json:"recebida"`
+	Pendente   int            `json:"pendente"`
+	Custo      json.Number    `json:"custo"`
+	Moeda      dinheiro.Moeda `json:"moeda"`
+	Subtotal   json.Number    `json:"subtotal"`
+}
+
+// TotalMoedaResponse: pedidos com linhas em moedas diferentes têm um total
+// por moeda
+type TotalMoedaResponse struct {
+	Moeda dinheiro.Moeda `json:"moeda"`
+	Total json.Number    `json:"total"`
+}
+
+func fromTotais(totais map[dinheiro.Moeda]dinheiro.Dinheiro) []TotalMoedaResponse {
+	resp := make([]TotalMoedaResponse, 0, len(totais))
+	for _, moeda := range slices.Sorted(maps.Keys(totais)) {
+		resp = append(resp, TotalMoedaResponse{Moeda: moeda, Total: json.Number(totais[moeda].String())})
+	}
+	return resp
+}
+
+type RecebimentoCompraResponse struct {
+	ItemID      int       `json:"item_id"`
+	Quantidade  int       `json:"quantidade"`
+	RecebidoPor int       `json:"recebido_por"`
+	RecebidoEm  time.Time `json:"recebido_em"`
+}
+
+type PedidoCompraResponse struct {
+	ID           int                         `json:"id"`
+	FornecedorID int                         `json:"fornecedor_id"`
+	Status       compra.Status               `json:"status"`
+	Observacao   string                      `json:"observacao,omitempty"`
+	Linhas       []LinhaCompraResponse       `json:"linhas"`
+	Totais       []TotalMoedaResponse        `json:"totais"`
+	CriadoPor    int                         `json:"criado_por"`
+	CriadoEm     time.Time                   `json:"criado_em"`
+	AtualizadoEm time.Time                   `json:"atualizado_em"`
+	EnviadoEm    *time.Time                  `json:"enviado_em,omitempty"`
+	RecebidoEm   *time.Time                  `json:"recebido_em,omitempty"`
+	Recebimentos []RecebimentoCompraResponse `json:"recebimentos,omitempty"`
+}
+
+func FromPedidoCompra(p compra.Pedido) PedidoCompraResponse {
+	resp := PedidoCompraResponse{
+		ID:           p.ID,
+		FornecedorID: p.FornecedorID,
+		Status:       p.Status,
+		Observacao:   p.Observacao,
+		Linhas:       make([]LinhaCompraResponse, 0, len(p.Linhas)),
+		CriadoPor:    p.CriadoPor,
+		CriadoEm:     p.CriadoEm,
+		AtualizadoEm: p.AtualizadoEm,
+		EnviadoEm:    p.EnviadoEm,
+		RecebidoEm:   p.RecebidoEm,
+	}
+	for _, l := range p.Linhas {
+		resp.Linhas = append(resp.Linhas, LinhaCompraResponse{
+			ID:         l.ID,
+			ItemID:     l.ItemID,
+			Quantidade: l.Quantidade,
+			Recebida:   l.Recebida,
+			Pendente:   l.Pendente(),
+			Custo:      json.Number(l.CustoUnitario.String()),
+			Moeda:      l.CustoUnitario.Moeda,
+			Subtotal:   json.Number(l.CustoUnitario.Vezes(l.Quantidade).String()),
+		})
+	}
+	resp.Totais = fromTotais(p.Total())
+	return resp
+}
+
+// ComRecebimentos inclui o histórico de entradas (GET /compras/:id)
+func (r *PedidoCompraResponse) ComRecebimentos(recebimentos []compra.Recebimento) {
+	r.Recebimentos = make([]RecebimentoCompraResponse, 0, len(recebimentos))
+	for _, rec := range recebimentos {
+		r.Recebimentos = append(r.Recebimentos, RecebimentoCompraResponse{
+			ItemID:      rec.ItemID,
+			Quantidade:  rec.Quantidade,
+			RecebidoPor: rec.RecebidoPor,
+			RecebidoEm:  rec.RecebidoEm,
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/deposito"
+	"time"
+)
+
+type DepositoRequest struct {
+	Codigo string `json:"codigo" binding:"required"`
+	Nome   string `json:"nome" binding:"required"`
+}
+
+func (r *DepositoRequest) ToEntity() (deposito.Deposito, error) {
+	return deposito.NovoDeposito(r.Codigo, r.Nome)
+}
+
+type DepositoResponse struct {
+	ID       int       `json:"id"`
+	Codigo   string    `json:"codigo"`
+	Nome     string    `json:"nome"`
+	Padrao   bool      `json:"padrao"`
+	CriadoEm time.Time `json:"criado_em"`
+}
+
+func FromDeposito(d deposito.Deposito) DepositoResponse {
+	return DepositoResponse{
+		ID:       d.ID,
+		Codigo:   d.Codigo,
+		Nome:     d.Nome,
+		Padrao:   d.Padrao,
+		CriadoEm: d.CriadoEm,
+	}
+}
+
+// DefinirSaldoRequest: ponteiro para aceitar 0 com o required
+type DefinirSaldoRequest struct {
+	Quantidade *int `json:"quantidade" binding:"required,min=0"`
+}
+
+type TransferenciaRequest struct {
+	Origem     int `json:"origem" binding:"required"`
+	Destino    int `json:"destino" binding:"required"`
+	Quantidade int `json:"quantidade" binding:"required,min=1"`
+}
+
+func (r *TransferenciaRequest) ToEntity(itemID, usuarioID int) deposito.Transferencia {
+	return deposito.Transferencia{
+		ItemID:     itemID,
+		Origem:     r.Origem,
+		Destino:    r.Destino,
+		Quantidade: r.Quantidade,
+		UsuarioID:  usuarioID,
+	}
+}
+
+type TransferenciaResponse struct {
+	ID         int       `json:"id"`
+	Origem     int       `json:"origem"`
+	Destino    int       `json:"destino"`
+	Quantidade int       `json:"quantidade"`
+	UsuarioID  int       `json:"usuario_id"`
+	CriadoEm   time.Time `json:"criado_em"`
+}
+
+func FromTransferencia(t deposito.Transferencia) TransferenciaResponse {
+	return TransferenciaResponse{
+		ID:         t.ID,
+		Origem:     t.Origem,
+		Destino:    t.Destino,
+		Quantidade: t.Quantidade,
+		UsuarioID:  t.UsuarioID,
+		CriadoEm:   t.CriadoEm,
+	}
+}
+
+type SaldoResponse struct {
+	Deposito   DepositoResponse `json:"deposito"`
+	Quantidade int              `json:"quantidade"`
+}
+
+// PosicaoEstoqueResponse é o GET /itens/:id/estoque: total (o estoque do
+// item) e quanto há em cada depósito
+type PosicaoEstoqueResponse struct {
+	ItemID         int                     `json:"item_id"`
+	Total          int                     `json:"total"`
+	Depositos      []SaldoResponse         `json:"depositos"`
+	Transferencias []TransferenciaResponse `json:"transferencias"`
+}
+
+func FromPosicaoEstoque(p deposito.Posicao) PosicaoEstoqueResponse {
+	resp := PosicaoEstoqueResponse{
+		ItemID:         p.ItemID,
+		Total:          p.Total,
+		Depositos:      make([]SaldoResponse, 0, len(p.Saldos)),
+		Transferencias: make([]TransferenciaResponse, 0, len(p.Transferencias)),
+	}
+	for _, s := range p.Saldos {
+		resp.Depositos = append(resp.Depositos, SaldoResponse{Deposito: FromDeposito(s.Deposito), Quantidade: s.Quantidade})
+	}
+	for _, t := range p.Transferencias {
+		resp.Transferencias = append(resp.Transferencias, FromTransferencia(t))
+	}
+	return resp
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/fornecedor"
+	"encoding/json"
+	"time"
+)
+
+type FornecedorRequest struct {
+	Nome      string `json:"nome" binding:"required"`
+	Documento string `json:"documento"`
+	Email     string `json:"email"`
+	Telefone  string `json:"telefone"`
+}
+
+func (r *FornecedorRequest) ToEntity(id int) (fornecedor.Fornecedor, error) {
+	f := fornecedor.Fornecedor{
+		ID:        id,
+		Nome:      r.Nome,
+		Documento: r.Documento,
+		Email:     r.Email,
+		Telefone:  r.Telefone,
+	}
+	f.Normalizar()
+	return f, f.Validar()
+}
+
+type FornecedorResponse struct {
+	ID           int       `json:"id"`
+	Nome         string    `json:"nome"`
+	Documento    string    `json:"documento,omitempty"`
+	Email        string    `json:"email,omitempty"`
+	Telefone     string    `json:"telefone,omitempty"`
+	CriadoEm     time.Time `json:"criado_em"`
+	AtualizadoEm time.Time `json:"atualizado_em"`
+}
+
+func FromFornecedor(f fornecedor.Fornecedor) FornecedorResponse {
+	return FornecedorResponse{
+		ID:           f.ID,
+		Nome:         f.Nome,
+		Documento:    f.Documento,
+		Email:        f.Email,
+		Telefone:     f.Telefone,
+		CriadoEm:     f.CriadoEm,
+		AtualizadoEm: f.AtualizadoEm,
+	}
+}
+
+// VinculoFornecedorRequest: sem moeda, o custo fica em BRL
+type VinculoFornecedorRequest struct {
+	SKU   string      `json:"sku"`
+	Custo json.Number `json:"custo" binding:"required"`
+	Moeda string      `json:"moeda"`
+}
+
+func (r *VinculoFornecedorRequest) ToEntity(itemID, fornecedorID int) (fornecedor.ItemFornecedor, error) {
+	custo, err := lerPreco(r.Custo, r.Moeda)
+	if err != nil {
+		return fornecedor.ItemFornecedor{}, err
+	}
+
+	v := fornecedor.ItemFornecedor{ItemID: itemID, FornecedorID: fornecedorID, SKU: r.SKU, Custo: custo}
+	return v, v.Validar()
+}
+
+type VinculoFornecedorResponse struct {
+	FornecedorID int            `json:"fornecedor_id"`
+	Fornecedor   string         `json:"fornecedor"`
+	SKU          string         `json:"sku"`
+	Custo        json.Number    `json:"custo"`
+	Moeda        dinheiro.Moeda `json:"moeda"`
+	AtualizadoEm time.Time      `json:"atualizado_em"`
+}
+
+func FromVinculoFornecedor(v fornecedor.ItemFornecedor) VinculoFornecedorResponse {
+	return VinculoFornecedorResponse{
+		FornecedorID: v.FornecedorID,
+		Fornecedor:   v.FornecedorNome,
+		SKU:          v.SKU,
+		Custo:        json.Number(v.Custo.String()),
+		Moeda:        v.Custo.Moeda,
+		AtualizadoEm: v.AtualizadoEm,
+	}
+}
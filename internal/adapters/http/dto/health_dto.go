@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"database/sql"
+	"time"
+)
+
+type VerificacaoResponse struct {
+	Nome       string  `json:"nome"`
+	Ok         bool    `json:"ok"`
+	LatenciaMs float64 `json:"latencia_ms"`
+	Erro       string  `json:"erro,omitempty"`
+}
+
+type PoolResponse struct {
+	MaxAbertas        int     `json:"max_abertas"`
+	Abertas           int     `json:"abertas"`
+	EmUso             int     `json:"em_uso"`
+	Ociosas           int     `json:"ociosas"`
+	Esperas           int64   `json:"esperas"`
+	TempoEsperaMs     float64 `json:"tempo_espera_ms"`
+	FechadasOciosas   int64   `json:"fechadas_ociosas"`
+	FechadasTempoVida int64   `json:"fechadas_tempo_vida"`
+}
+
+type StatusResponse struct {
+	Versao       string                `json:"versao"`
+	Commit       string                `json:"commit"`
+	GoVersion    string                `json:"go_version"`
+	IniciadoEm   time.Time             `json:"iniciado_em"`
+	Uptime       string                `json:"uptime"`
+	Pool         PoolResponse          `json:"pool"`
+	Dependencias []VerificacaoResponse `json:"dependencias"`
+}
+
+// FromDBStats converte as estatísticas do database/sql → PoolResponse
+func FromDBStats(s sql.DBStats) PoolResponse {
+	return PoolResponse{
+		MaxAbertas:        s.MaxOpenConnections,
+		Abertas:           s.OpenConnections,
+		EmUso:             s.InUse,
+		Ociosas:           s.Idle,
+		Esperas:           s.WaitCount,
+		TempoEsperaMs:     float64(s.WaitDuration) / float64(time.Millisecond),
+		FechadasOciosas:   s.MaxIdleClosed,
+		FechadasTempoVida: s.MaxLifetimeClosed,
+	}
+}
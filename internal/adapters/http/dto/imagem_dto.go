@@ -0,0 +1,42 @@
+package dto
+
+import "desafio-itens-app/internal/domain/imagem"
+
+// ReordenarImagensRequest lista todas as imagens do item na nova ordem
+type ReordenarImagensRequest struct {
+	IDs []int `json:"ids" binding:"required,min=1"`
+}
+
+type ImagemResponse struct {
+	ID           int    `json:"id"`
+	URL          string `json:"url"`
+	MiniaturaURL string `json:"miniatura_url"`
+	ContentType  string `json:"content_type"`
+	Largura      int    `json:"largura"`
+	Altura       int    `json:"altura"`
+	Tamanho      int64  `json:"tamanho"`
+	Ordem        int    `json:"ordem"`
+	Principal    bool   `json:"principal"`
+}
+
+func FromImagem(img imagem.Imagem) ImagemResponse {
+	return ImagemResponse{
+		ID:           img.ID,
+		URL:          img.URL,
+		MiniaturaURL: img.URLMiniatura,
+		ContentType:  img.ContentType,
+		Largura:      img.Largura,
+		Altura:       img.Altura,
+		Tamanho:      img.Tamanho,
+		Ordem:        img.Ordem,
+		Principal:    img.Principal,
+	}
+}
+
+func FromImagens(imagens []imagem.Imagem) []ImagemResponse {
+	resp := make([]ImagemResponse, 0, len(imagens))
+	for _, img := range imagens {
+		resp = append(resp, FromImagem(img))
+	}
+	return resp
+}
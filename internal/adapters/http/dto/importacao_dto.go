@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/importacao"
+	"time"
+)
+
+type ResultadoLinhaResponse struct {
+	Linha  int             `json:"linha"`
+	Acao   importacao.Acao `json:"acao"`
+	ItemID int             `json:"item_id,omitempty"`
+	Code   string          `json:"code,omitempty"`
+	Erro   string          `json:"erro,omitempty"`
+}
+
+type RelatorioImportacaoResponse struct {
+	DryRun      bool                     `json:"dry_run"`
+	Total       int                      `json:"total"`
+	Criados     int                      `json:"criados"`
+	Atualizados int                      `json:"atualizados"`
+	Erros       int                      `json:"erros"`
+	Linhas      []ResultadoLinhaResponse `json:"linhas"`
+}
+
+type ImportacaoJobResponse struct {
+	ID           string                       `json:"id"`
+	Status       importacao.StatusJob         `json:"status"`
+	Total        int                          `json:"total"`
+	Processadas  int                          `json:"processadas"`
+	Erro         string                       `json:"erro,omitempty"`
+	Relatorio    *RelatorioImportacaoResponse `json:"relatorio,omitempty"`
+	CriadoEm     time.Time                    `json:"criado_em"`
+	AtualizadoEm time.Time                    `json:"atualizado_em"`
+}
+
+// FromRelatorio converte o relatório do domínio → resposta
+func FromRelatorio(r importacao.Relatorio) RelatorioImportacaoResponse {
+	linhas := make([]ResultadoLinhaResponse, 0, len(r.Linhas))
+	for _, l := range r.Linhas {
+		linhas = append(linhas, ResultadoLinhaResponse{
+			Linha:  l.Linha,
+			Acao:   l.Acao,
+			ItemID: l.ItemID,
+			Code:   l.Code,
+			Erro:   l.Erro,
+		})
+	}
+
+	return RelatorioImportacaoResponse{
+		DryRun:      r.DryRun,
+		Total:       r.Total,
+		Criados:     r.Criados,
+		Atualizados: r.Atualizados,
+		Erros:       r.Erros,
+		Linhas:      linhas,
+	}
+}
+
+func FromImportacaoJob(job importacao.Job) ImportacaoJobResponse {
+	resp := ImportacaoJobResponse{
+		ID:           job.ID,
+		Status:       job.Status,
+		Total:        job.Total,
+		Processadas:  job.Processadas,
+		Erro:         job.Erro,
+		CriadoEm:     job.CriadoEm,
+		AtualizadoEm: job.AtualizadoEm,
+	}
+	if job.Relatorio != nil {
+		relatorio := FromRelatorio(*job.Relatorio)
+		resp.Relatorio = &relatorio
+	}
+	return resp
+}
@@ -1,71 +1,229 @@
 package dto
 
 import (
+	"desafio-itens-app/internal/domain/dinheiro"
 	entity "desafio-itens-app/internal/domain/item"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 )
 
+// Preços trafegam como json.Number: o decimal do JSON é lido e escrito
+// exatamente como veio, sem passar por float64
+
 type CreateItemRequest struct {
-	Nome      string  `json:"nome"`
-	Descricao string  `json:"descricao"`
-	Preco     float64 `json:"preco"`
-	Estoque   int     `json:"estoque"`
+	Nome      string      `json:"nome"`
+	EAN       string      `json:"ean"` // Opcional: EAN-13 do fabricante
+	Descricao string      `json:"descricao"`
+	Categoria string      `json:"categoria"`
+	Tags      []string    `json:"tags"`
+	Preco     json.Number `json:"preco"`
+	Moeda     string      `json:"moeda"` // Opcional, padrão BRL
+	Estoque   int         `json:"estoque"`
+
+	PontoReposicao      int `json:"ponto_reposicao"` // 0 = sem alerta
+	QuantidadeReposicao int `json:"quantidade_reposicao"`
 }
 type ItemResponse struct {
-	ID        int           `json:"id"`
-	Code      string        `json:"code"`
-	Nome      string        `json:"nome"`
-	Descricao string        `json:"descricao"`
-	Preco     float64       `json:"preco"`
-	Estoque   int           `json:"estoque"`
-	Status    entity.Status `json:"status"`
-	CreatedAt time.Time     `json:"created_at"`
-	UpdatedAt time.Time     `json:"updated_at"`
-	CreatedBy *int          `json:"created_by,omitempty"`
-	UpdatedBy *int          `json:"updated_by,omitempty"`
+	ID                  int            `json:"id"`
+	Code                string         `json:"code"`
+	EAN                 *string        `json:"ean,omitempty"`
+	Nome                string         `json:"nome"`
+	Descricao           string         `json:"descricao"`
+	Categoria           string         `json:"categoria"`
+	Tags                []string       `json:"tags"`
+	Preco               json.Number    `json:"preco"` // Sempre com duas casas: 10.10
+	Moeda               dinheiro.Moeda `json:"moeda"`
+	Estoque             int            `json:"estoque"`
+	Reservado           int            `json:"reservado"`
+	Disponivel          int            `json:"disponivel"` // Estoque − reservas vigentes
+	Status              entity.Status  `json:"status"`
+	PontoReposicao      int            `json:"ponto_reposicao"`
+	QuantidadeReposicao int            `json:"quantidade_reposicao"`
+	EstoqueBaixo        bool           `json:"estoque_baixo"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	CreatedBy           *int           `json:"created_by,omitempty"`
+	UpdatedBy           *int           `json:"updated_by,omitempty"`
+
+	// Variantes: eixos no produto, opcoes e produto_id na variante
+	ProdutoID    *int              `json:"produto_id,omitempty"`
+	Eixos        []EixoDTO         `json:"eixos,omitempty"`
+	Opcoes       map[string]string `json:"opcoes,omitempty"`
+	PrecoProprio bool              `json:"preco_proprio,omitempty"`
+	Variantes    []ItemResponse    `json:"variantes,omitempty"` // Só em GET /produtos
+
+	Imagens []ImagemResponse `json:"imagens,omitempty"` // Pela ordem; a principal vem marcada
+
+	PrecoEfetivo    json.Number                `json:"preco_efetivo,omitempty"` // Com as promoções vigentes (leituras)
+	Promocoes       []PromocaoAplicadaResponse `json:"promocoes,omitempty"`
+	PrecoConvertido *PrecoConvertidoResponse   `json:"preco_convertido,omitempty"` // Só com ?moeda=
 }
 
 type UpdateItemRequest struct {
-	Preco     *float64 `json:"preco,omitempty"`
-	Estoque   *int     `json:"estoque,omitempty"`
-	Descricao *string  `json:"descricao,omitempty"`
+	Preco     *json.Number `json:"preco,omitempty"`
+	Moeda     *string      `json:"moeda,omitempty"` // Só junto com preco
+	Estoque   *int         `json:"estoque,omitempty"`
+	Descricao *string      `json:"descricao,omitempty"`
+	Categoria *string      `json:"categoria,omitempty"`
+	Tags      *[]string    `json:"tags,omitempty"` // Substitui todas as tags
+	EAN       *string      `json:"ean,omitempty"`  // "" remove o EAN
+
+	PrecoProprio *bool `json:"preco_proprio,omitempty"` // Só variantes; false volta a seguir o produto
+
+	PontoReposicao      *int `json:"ponto_reposicao,omitempty"`
+	QuantidadeReposicao *int `json:"quantidade_reposicao,omitempty"`
 }
 
-func (r *CreateItemRequest) ToEntity() entity.Item {
+func (r *CreateItemRequest) ToEntity() (entity.Item, error) {
+	preco, err := lerPreco(r.Preco, r.Moeda)
+	if err != nil {
+		return entity.Item{}, err
+	}
+
 	return entity.Item{
 		Nome:      r.Nome,
+		EAN:       lerEAN(r.EAN),
 		Descricao: r.Descricao,
-		Preco:     r.Preco,
+		Categoria: r.Categoria,
+		Tags:      r.Tags,
+		Preco:     preco,
 		Estoque:   r.Estoque,
-	}
+
+		PontoReposicao:      r.PontoReposicao,
+		QuantidadeReposicao: r.QuantidadeReposicao,
+	}, nil
 }
 
 func FromEntity(item entity.Item) ItemResponse {
+	tags := item.Tags
+	if tags == nil {
+		tags = []string{} // "tags": [] em vez de null
+	}
 	return ItemResponse{
-		ID:        item.ID,
-		Code:      item.Code,
-		Nome:      item.Nome,
-		Descricao: item.Descricao,
-		Preco:     item.Preco,
-		Estoque:   item.Estoque,
-		Status:    item.Status,
-		CreatedAt: item.CreatedAt,
-		UpdatedAt: item.UpdatedAt,
-		CreatedBy: item.CreatedBy,
-		UpdatedBy: item.UpdateBy,
+		ID:                  item.ID,
+		Code:                item.Code,
+		EAN:                 item.EAN,
+		Nome:                item.Nome,
+		Descricao:           item.Descricao,
+		Categoria:           item.Categoria,
+		Tags:                tags,
+		Preco:               json.Number(item.Preco.String()),
+		Moeda:               item.Preco.Moeda,
+		Estoque:             item.Estoque,
+		Disponivel:          item.Estoque, // Sem reservas até AplicarReservas
+		Status:              item.Status,
+		PontoReposicao:      item.PontoReposicao,
+		QuantidadeReposicao: item.QuantidadeReposicao,
+		EstoqueBaixo:        item.EstoqueBaixo(),
+		CreatedAt:           item.CreatedAt,
+		UpdatedAt:           item.UpdatedAt,
+		CreatedBy:           item.CreatedBy,
+		UpdatedBy:           item.UpdateBy,
+		ProdutoID:           item.ProdutoID,
+		Eixos:               fromEixos(item.Eixos),
+		Opcoes:              item.Opcoes,
+		PrecoProprio:        item.PrecoProprio,
 	}
 }
 
-func (r *UpdateItemRequest) ApplyTo(item *entity.Item) {
+func (r *UpdateItemRequest) ApplyTo(item *entity.Item) error {
+	preco, err := r.preco()
+	if err != nil {
+		return err
+	}
 
 	if r.Descricao != nil {
 		item.Descricao = strings.TrimSpace(*r.Descricao)
 	}
-	if r.Preco != nil && *r.Preco > 0 {
-		item.Preco = *r.Preco
+	if r.Categoria != nil {
+		item.Categoria = strings.TrimSpace(*r.Categoria)
+	}
+	if r.Tags != nil {
+		item.Tags = entity.NormalizarTags(*r.Tags)
+	}
+	if r.EAN != nil {
+		item.EAN = lerEAN(*r.EAN)
+	}
+	if preco != nil && preco.Positivo() {
+		if preco.Moeda == "" {
+			preco.Moeda = item.Preco.Moeda
+		}
+		item.DefinirPreco(*preco)
+	}
+	if r.PrecoProprio != nil {
+		if !item.EhVariante() {
+			return errors.New("preco_proprio só vale para variantes")
+		}
+		if preco != nil && !*r.PrecoProprio {
+			return errors.New("informe preco ou preco_proprio=false, não os dois")
+		}
+		item.PrecoProprio = *r.PrecoProprio // false: volta a seguir o produto
 	}
 	if r.Estoque != nil && *r.Estoque >= 0 {
 		item.Estoque = *r.Estoque
 	}
+	if r.PontoReposicao != nil {
+		item.PontoReposicao = *r.PontoReposicao
+	}
+	if r.QuantidadeReposicao != nil {
+		item.QuantidadeReposicao = *r.QuantidadeReposicao
+	}
+	return nil
+}
+
+// preco lê o preço do PUT. Sem "moeda", o valor fica na moeda atual do
+// item (Moeda vazia).
+func (r *UpdateItemRequest) preco() (*dinheiro.Dinheiro, error) {
+	if r.Preco == nil {
+		if r.Moeda != nil {
+			return nil, errors.New("moeda só pode ser alterada junto com o preço")
+		}
+		return nil, nil
+	}
+
+	moeda := ""
+	if r.Moeda != nil {
+		moeda = *r.Moeda
+	}
+	preco, err := lerPreco(*r.Preco, moeda)
+	if err != nil {
+		return nil, err
+	}
+	if r.Moeda == nil {
+		preco.Moeda = ""
+	}
+	return &preco, nil
+}
+
+// lerPreco converte o decimal do JSON; preço ausente vira zero e a
+// validação do item recusa
+func lerPreco(numero json.Number, moedaTexto string) (dinheiro.Dinheiro, error) {
+	moeda := dinheiro.MoedaPadrao
+	if moedaTexto != "" {
+		var err error
+		if moeda, err = dinheiro.ParseMoeda(moedaTexto); err != nil {
+			return dinheiro.Dinheiro{}, err
+		}
+	}
+
+	if numero == "" {
+		return dinheiro.Novo(0, moeda), nil
+	}
+	preco, err := dinheiro.Parse(numero.String(), moeda)
+	if err != nil {
+		return dinheiro.Dinheiro{}, fmt.Errorf("preço inválido: %s", numero)
+	}
+	return preco, nil
+}
+
+// lerEAN normaliza o EAN enviado; vazio vira nil (item sem EAN)
+func lerEAN(ean string) *string {
+	ean = entity.NormalizarEAN(ean)
+	if ean == "" {
+		return nil
+	}
+	return &ean
 }
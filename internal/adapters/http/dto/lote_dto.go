@@ -0,0 +1,110 @@
+package dto
+
+import (
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/lote"
+	"fmt"
+)
+
+// FiltroLoteRequest usa os mesmos filtros do GET /itens
+type FiltroLoteRequest struct {
+	Status string `json:"status"`
+	Busca  string `json:"busca"`
+}
+
+type AtualizarLoteRequest struct {
+	IDs        []int              `json:"ids"`
+	Filtro     *FiltroLoteRequest `json:"filtro"`
+	Alteracoes UpdateItemRequest  `json:"alteracoes"`
+	Modo       lote.Modo          `json:"modo"` // tudo_ou_nada (padrão) ou melhor_esforco
+}
+
+type RemoverLoteRequest struct {
+	IDs    []int              `json:"ids"`
+	Filtro *FiltroLoteRequest `json:"filtro"`
+	Modo   lote.Modo          `json:"modo"`
+}
+
+type ResultadoItemLoteResponse struct {
+	ItemID int       `json:"item_id"`
+	Acao   lote.Acao `json:"acao"`
+	Erro   string    `json:"erro,omitempty"`
+}
+
+type RelatorioLoteResponse struct {
+	Modo     lote.Modo                   `json:"modo"`
+	Aplicado bool                        `json:"aplicado"`
+	Total    int                         `json:"total"`
+	Sucessos int                         `json:"sucessos"`
+	Erros    int                         `json:"erros"`
+	Itens    []ResultadoItemLoteResponse `json:"itens"`
+}
+
+func (r *AtualizarLoteRequest) ToSelecao() (lote.Selecao, error) {
+	return selecaoLote(r.IDs, r.Filtro)
+}
+
+func (r *RemoverLoteRequest) ToSelecao() (lote.Selecao, error) {
+	return selecaoLote(r.IDs, r.Filtro)
+}
+
+func selecaoLote(ids []int, filtro *FiltroLoteRequest) (lote.Selecao, error) {
+	selecao := lote.Selecao{IDs: ids}
+	if filtro == nil {
+		return selecao, nil
+	}
+
+	selecao.Busca = filtro.Busca
+	if filtro.Status != "" {
+		status := entity.Status(filtro.Status)
+		if status != entity.StatusAtivo && status != entity.StatusInativo {
+			return lote.Selecao{}, fmt.Errorf("status deve ser '%s' ou '%s'", entity.StatusAtivo, entity.StatusInativo)
+		}
+		selecao.Status = &status
+	}
+	return selecao, nil
+}
+
+// ToAlteracao converte o corpo do PUT na alteração aplicada a cada item
+func (r *UpdateItemRequest) ToAlteracao() (lote.Alteracao, error) {
+	preco, err := r.preco()
+	if err != nil {
+		return lote.Alteracao{}, err
+	}
+
+	return lote.Alteracao{
+		Preco:     preco,
+		Estoque:   r.Estoque,
+		Descricao: r.Descricao,
+		Categoria: r.Categoria,
+		Tags:      r.Tags,
+	}, nil
+}
+
+// ModoOuPadrao: sem modo informado, o lote é tudo_ou_nada
+func ModoOuPadrao(modo lote.Modo) lote.Modo {
+	if modo == "" {
+		return lote.ModoTudoOuNada
+	}
+	return modo
+}
+
+func FromRelatorioLote(r lote.Relatorio) RelatorioLoteResponse {
+	itens := make([]ResultadoItemLoteResponse, 0, len(r.Itens))
+	for _, i := range r.Itens {
+		itens = append(itens, ResultadoItemLoteResponse{
+			ItemID: i.ItemID,
+			Acao:   i.Acao,
+			Erro:   i.Erro,
+		})
+	}
+
+	return RelatorioLoteResponse{
+		Modo:     r.Modo,
+		Aplicado: r.Aplicado,
+		Total:    r.Total,
+		Sucessos: r.Sucessos,
+		Erros:    r.Erros,
+		Itens:    itens,
+	}
+}
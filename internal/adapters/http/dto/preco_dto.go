@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/preco"
+	"encoding/json"
+	"time"
+)
+
+// AgendarPrecoRequest: sem moeda, o preço fica na moeda atual do item
+type AgendarPrecoRequest struct {
+	Preco            json.Number `json:"preco" binding:"required"`
+	Moeda            string      `json:"moeda"`
+	VigenteAPartirDe time.Time   `json:"vigente_a_partir_de" binding:"required"` // RFC 3339
+}
+
+func (r *AgendarPrecoRequest) ToAgendamento(item int, moedaItem dinheiro.Moeda, criadoPor int) (preco.Agendamento, error) {
+	moeda := r.Moeda
+	if moeda == "" {
+		moeda = string(moedaItem)
+	}
+	valor, err := lerPreco(r.Preco, moeda)
+	if err != nil {
+		return preco.Agendamento{}, err
+	}
+	return preco.NovoAgendamento(item, valor, r.VigenteAPartirDe, time.Now(), criadoPor)
+}
+
+type AlteracaoPrecoResponse struct {
+	PrecoAnterior *json.Number   `json:"preco_anterior"`
+	MoedaAnterior dinheiro.Moeda `json:"moeda_anterior,omitempty"`
+	Preco         json.Number    `json:"preco"`
+	Moeda         dinheiro.Moeda `json:"moeda"`
+	AlteradoEm    time.Time      `json:"alterado_em"`
+	AlteradoPor   *int           `json:"alterado_por"`
+}
+
+type AgendamentoPrecoResponse struct {
+	ID               int                     `json:"id"`
+	Preco            json.Number             `json:"preco"`
+	Moeda            dinheiro.Moeda          `json:"moeda"`
+	VigenteAPartirDe time.Time               `json:"vigente_a_partir_de"`
+	Status           preco.StatusAgendamento `json:"status"`
+	Erro             string                  `json:"erro,omitempty"`
+	CriadoPor        int                     `json:"criado_por"`
+	CriadoEm         time.Time               `json:"criado_em"`
+	AplicadoEm       *time.Time              `json:"aplicado_em,omitempty"`
+}
+
+// PrecosItemResponse é o GET /itens/:id/precos: histórico (mais recente
+// primeiro) e agendamentos
+type PrecosItemResponse struct {
+	ItemID    int                        `json:"item_id"`
+	Historico []AlteracaoPrecoResponse   `json:"historico"`
+	Agendados []AgendamentoPrecoResponse `json:"agendados"`
+}
+
+func FromAlteracaoPreco(a preco.Alteracao) AlteracaoPrecoResponse {
+	resp := AlteracaoPrecoResponse{
+		Preco:       json.Number(a.Preco.String()),
+		Moeda:       a.Preco.Moeda,
+		AlteradoEm:  a.AlteradoEm,
+		AlteradoPor: a.AlteradoPor,
+	}
+	if a.Anterior != nil {
+		anterior := json.Number(a.Anterior.String())
+		resp.PrecoAnterior = &anterior
+		resp.MoedaAnterior = a.Anterior.Moeda
+	}
+	return resp
+}
+
+func FromAgendamentoPreco(a preco.Agendamento) AgendamentoPrecoResponse {
+	return AgendamentoPrecoResponse{
+		ID:               a.ID,
+		Preco:            json.Number(a.Preco.String()),
+		Moeda:            a.Preco.Moeda,
+		VigenteAPartirDe: a.VigenteAPartirDe,
+		Status:           a.Status,
+		Erro:             a.Erro,
+		CriadoPor:        a.CriadoPor,
+		CriadoEm:         a.CriadoEm,
+		AplicadoEm:       a.AplicadoEm,
+	}
+}
+
+func FromPrecosItem(itemID int, historico []preco.Alteracao, agendados []preco.Agendamento) PrecosItemResponse {
+	resp := PrecosItemResponse{
+		ItemID:    itemID,
+		Historico: make([]AlteracaoPrecoResponse, 0, len(historico)),
+		Agendados: make([]AgendamentoPrecoResponse, 0, len(agendados)),
+	}
+	for _, a := range historico {
+		resp.Historico = append(resp.Historico, FromAlteracaoPreco(a))
+	}
+	for _, a := range agendados {
+		resp.Agendados = append(resp.Agendados, FromAgendamentoPreco(a))
+	}
+	return resp
+}
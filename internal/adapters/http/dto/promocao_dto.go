@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/promocao"
+	"encoding/json"
+	"time"
+)
+
+// PromocaoRequest é o corpo do POST e do PUT (o PUT substitui a promoção
+// inteira). percentual é "15.5" (%); desconto e moeda, para valor_fixo.
+type PromocaoRequest struct {
+	Nome       string        `json:"nome" binding:"required"`
+	Tipo       promocao.Tipo `json:"tipo" binding:"required"`
+	Percentual json.Number   `json:"percentual"`
+	Desconto   json.Number   `json:"desconto"`
+	Moeda      string        `json:"moeda"` // Padrão BRL
+	Alvo       promocao.Alvo `json:"alvo" binding:"required"`
+	ItemID     int           `json:"item_id"`
+	Categoria  string        `json:"categoria"`
+	Tag        string        `json:"tag"`
+	Inicio     time.Time     `json:"inicio" binding:"required"`
+	Fim        time.Time     `json:"fim" binding:"required"`
+	Prioridade int           `json:"prioridade"` // Maior vence
+	Cumulativa bool          `json:"cumulativa"`
+	Ativa      *bool         `json:"ativa"` // Padrão true
+}
+
+type PromocaoResponse struct {
+	ID           int            `json:"id"`
+	Nome         string         `json:"nome"`
+	Tipo         promocao.Tipo  `json:"tipo"`
+	Percentual   json.Number    `json:"percentual,omitempty"`
+	Desconto     json.Number    `json:"desconto,omitempty"`
+	Moeda        dinheiro.Moeda `json:"moeda,omitempty"`
+	Alvo         promocao.Alvo  `json:"alvo"`
+	ItemID       int            `json:"item_id,omitempty"`
+	Categoria    string         `json:"categoria,omitempty"`
+	Tag          string         `json:"tag,omitempty"`
+	Inicio       time.Time      `json:"inicio"`
+	Fim          time.Time      `json:"fim"`
+	Prioridade   int            `json:"prioridade"`
+	Cumulativa   bool           `json:"cumulativa"`
+	Ativa        bool           `json:"ativa"`
+	CriadoPor    int            `json:"criado_por"`
+	CriadoEm     time.Time      `json:"criado_em"`
+	AtualizadoEm time.Time      `json:"atualizado_em"`
+}
+
+// PromocaoAplicadaResponse vai no item: quanto cada promoção descontou
+type PromocaoAplicadaResponse struct {
+	ID       int         `json:"id"`
+	Nome     string      `json:"nome"`
+	Desconto json.Number `json:"desconto"`
+}
+
+// ToEntity monta e valida a promoção
+func (r *PromocaoRequest) ToEntity(id, usuarioID int) (promocao.Promocao, error) {
+	p := promocao.Promocao{
+		ID:         id,
+		Nome:       r.Nome,
+		Tipo:       r.Tipo,
+		Alvo:       r.Alvo,
+		ItemID:     r.ItemID,
+		Categoria:  r.Categoria,
+		Tag:        r.Tag,
+		Inicio:     r.Inicio,
+		Fim:        r.Fim,
+		Prioridade: r.Prioridade,
+		Cumulativa: r.Cumulativa,
+		Ativa:      r.Ativa == nil || *r.Ativa,
+		CriadoPor:  usuarioID,
+	}
+
+	switch r.Tipo {
+	case promocao.TipoPercentual:
+		percentual, err := promocao.ParsePercentual(r.Percentual.String())
+		if err != nil {
+			return promocao.Promocao{}, err
+		}
+		p.Percentual = percentual
+	case promocao.TipoValorFixo:
+		desconto, err := lerPreco(r.Desconto, r.Moeda)
+		if err != nil {
+			return promocao.Promocao{}, err
+		}
+		p.Desconto = desconto
+	}
+
+	p.Normalizar()
+	if err := p.Validar(); err != nil {
+		return promocao.Promocao{}, err
+	}
+	return p, nil
+}
+
+func FromPromocao(p promocao.Promocao) PromocaoResponse {
+	resp := PromocaoResponse{
+		ID:           p.ID,
+		Nome:         p.Nome,
+		Tipo:         p.Tipo,
+		Alvo:         p.Alvo,
+		ItemID:       p.ItemID,
+		Categoria:    p.Categoria,
+		Tag:          p.Tag,
+		Inicio:       p.Inicio,
+		Fim:          p.Fim,
+		Prioridade:   p.Prioridade,
+		Cumulativa:   p.Cumulativa,
+		Ativa:        p.Ativa,
+		CriadoPor:    p.CriadoPor,
+		CriadoEm:     p.CriadoEm,
+		AtualizadoEm: p.AtualizadoEm,
+	}
+	if p.Tipo == promocao.TipoPercentual {
+		resp.Percentual = json.Number(promocao.FormatarPercentual(p.Percentual))
+	} else {
+		resp.Desconto = json.Number(p.Desconto.String())
+		resp.Moeda = p.Desconto.Moeda
+	}
+	return resp
+}
+
+// AplicarPromocao preenche preco_efetivo e promocoes do item
+func (r *ItemResponse) AplicarPromocao(resultado promocao.Resultado) {
+	r.PrecoEfetivo = json.Number(resultado.PrecoEfetivo.String())
+	r.Promocoes = nil
+	for _, a := range resultado.Aplicadas {
+		r.Promocoes = append(r.Promocoes, PromocaoAplicadaResponse{
+			ID:       a.PromocaoID,
+			Nome:     a.Nome,
+			Desconto: json.Number(a.Desconto.String()),
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/reserva"
+	"time"
+)
+
+// ReservaRequest: sem ttl_segundos a reserva vale 15 minutos
+type ReservaRequest struct {
+	Quantidade  int `json:"quantidade" binding:"required,min=1"`
+	TTLSegundos int `json:"ttl_segundos" binding:"min=0"`
+}
+
+func (r *ReservaRequest) ToEntity(itemID, usuarioID int) (reserva.Reserva, error) {
+	ttl := time.Duration(r.TTLSegundos) * time.Second
+	return reserva.NovaReserva(itemID, r.Quantidade, ttl, usuarioID, time.Now())
+}
+
+type ReservaResponse struct {
+	ID          int            `json:"id"`
+	ItemID      int            `json:"item_id"`
+	Quantidade  int            `json:"quantidade"`
+	Status      reserva.Status `json:"status"`
+	UsuarioID   int            `json:"usuario_id"`
+	ExpiraEm    time.Time      `json:"expira_em"`
+	CriadoEm    time.Time      `json:"criado_em"`
+	ConcluidaEm *time.Time     `json:"concluida_em,omitempty"`
+}
+
+func FromReserva(r reserva.Reserva) ReservaResponse {
+	return ReservaResponse{
+		ID:          r.ID,
+		ItemID:      r.ItemID,
+		Quantidade:  r.Quantidade,
+		Status:      r.Status,
+		UsuarioID:   r.UsuarioID,
+		ExpiraEm:    r.ExpiraEm,
+		CriadoEm:    r.CriadoEm,
+		ConcluidaEm: r.ConcluidaEm,
+	}
+}
+
+// AplicarReservas preenche reservado e disponivel (estoque − reservado)
+func (r *ItemResponse) AplicarReservas(reservado int) {
+	r.Reservado = reservado
+	r.Disponivel = reserva.Disponivel(r.Estoque, reservado)
+}
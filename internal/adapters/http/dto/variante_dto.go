@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"encoding/json"
+	"errors"
+)
+
+type EixoDTO struct {
+	Nome    string   `json:"nome"`
+	Valores []string `json:"valores"`
+}
+
+// EixosRequest substitui todos os eixos do produto; lista vazia tira a
+// variação (só sem variantes)
+type EixosRequest struct {
+	Eixos []EixoDTO `json:"eixos"`
+}
+
+func (r *EixosRequest) ToEntity() []entity.Eixo {
+	eixos := make([]entity.Eixo, 0, len(r.Eixos))
+	for _, e := range r.Eixos {
+		eixos = append(eixos, entity.Eixo{Nome: e.Nome, Valores: e.Valores})
+	}
+	return eixos
+}
+
+type VarianteRequest struct {
+	Opcoes  map[string]string `json:"opcoes" binding:"required"` // Eixo → valor
+	Preco   json.Number       `json:"preco"`                     // Opcional: sem preço segue o produto
+	Moeda   string            `json:"moeda"`                     // Opcional, padrão a do produto
+	Estoque int               `json:"estoque" binding:"min=0"`
+}
+
+// PrecoProprio devolve nil quando a variante segue o preço do produto. Sem
+// "moeda", o valor fica na moeda do produto (Moeda vazia).
+func (r *VarianteRequest) PrecoProprio() (*dinheiro.Dinheiro, error) {
+	if r.Preco == "" {
+		return nil, nil
+	}
+	preco, err := lerPreco(r.Preco, r.Moeda)
+	if err != nil {
+		return nil, err
+	}
+	if !preco.Positivo() {
+		return nil, errors.New("Preço deve ser maior que zero")
+	}
+	if r.Moeda == "" {
+		preco.Moeda = ""
+	}
+	return &preco, nil
+}
+
+// FromProduto devolve o item de topo com as variantes em "variantes"
+func FromProduto(p entity.Produto) ItemResponse {
+	resp := FromEntity(p.Item)
+	for _, v := range p.Variantes {
+		resp.Variantes = append(resp.Variantes, FromEntity(v))
+	}
+	return resp
+}
+
+func fromEixos(eixos []entity.Eixo) []EixoDTO {
+	if len(eixos) == 0 {
+		return nil
+	}
+	resp := make([]EixoDTO, 0, len(eixos))
+	for _, e := range eixos {
+		resp = append(resp, EixoDTO{Nome: e.Nome, Valores: e.Valores})
+	}
+	return resp
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/venda"
+	"encoding/json"
+	"time"
+)
+
+// PedidoRequest: só item e quantidade; nome e preço vêm do item
+type PedidoRequest struct {
+	Linhas []struct {
+		ItemID     int `json:"item_id" binding:"required"`
+		Quantidade int `json:"quantidade" binding:"required,min=1"`
+	} `json:"linhas" binding:"required,min=1,dive"`
+}
+
+func (r *PedidoRequest) ToLinhas() []venda.Linha {
+	linhas := make([]venda.Linha, 0, len(r.Linhas))
+	for _, l := range r.Linhas {
+		linhas = append(linhas, venda.Linha{ItemID: l.ItemID, Quantidade: l.Quantidade})
+	}
+	return linhas
+}
+
+type LinhaPedidoResponse struct {
+	ItemID        int            `json:"item_id"`
+	Code          string         `json:"code"`
+	Nome          string         `json:"nome"`
+	Quantidade    int            `json:"quantidade"`
+	PrecoUnitario json.Number    `json:"preco_unitario"`
+	Moeda         dinheiro.Moeda `json:"moeda"`
+	Subtotal      json.Number    `json:"subtotal"`
+}
+
+type PedidoResponse struct {
+	ID           int                   `json:"id"`
+	UsuarioID    int                   `json:"usuario_id"`
+	Status       venda.Status          `json:"status"`
+	Linhas       []LinhaPedidoResponse `json:"linhas"`
+	Totais       []TotalMoedaResponse  `json:"totais"`
+	CriadoEm     time.Time             `json:"criado_em"`
+	AtualizadoEm time.Time             `json:"atualizado_em"`
+	ConfirmadoEm *time.Time            `json:"confirmado_em,omitempty"`
+	CanceladoEm  *time.Time            `json:"cancelado_em,omitempty"`
+}
+
+func FromPedido(p venda.Pedido) PedidoResponse {
+	resp := PedidoResponse{
+		ID:           p.ID,
+		UsuarioID:    p.UsuarioID,
+		Status:       p.Status,
+		Linhas:       make([]LinhaPedidoResponse, 0, len(p.Linhas)),
+		Totais:       fromTotais(p.Total()),
+		CriadoEm:     p.CriadoEm,
+		AtualizadoEm: p.AtualizadoEm,
+		ConfirmadoEm: p.ConfirmadoEm,
+		CanceladoEm:  p.CanceladoEm,
+	}
+	for _, l := range p.Linhas {
+		resp.Linhas = append(resp.Linhas, LinhaPedidoResponse{
+			ItemID:        l.ItemID,
+			Code:          l.Code,
+			Nome:          l.Nome,
+			Quantidade:    l.Quantidade,
+			PrecoUnitario: json.Number(l.PrecoUnitario.String()),
+			Moeda:         l.PrecoUnitario.Moeda,
+			Subtotal:      json.Number(l.PrecoUnitario.Vezes(l.Quantidade).String()),
+		})
+	}
+	return resp
+}
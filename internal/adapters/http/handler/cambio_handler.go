@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+)
+
+type CambioHandler struct {
+	service services.CambioService
+	logger  *slog.Logger
+}
+
+func NewCambioHandler(service services.CambioService, logger *slog.Logger) *CambioHandler {
+	return &CambioHandler{service: service, logger: logger}
+}
+
+func (h *CambioHandler) ListarTaxas(c *gin.Context) {
+	taxas, err := h.service.ListarTaxas(c.Request.Context())
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao listar taxas de câmbio", "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.TaxaCambioResponse, 0, len(taxas))
+	for _, taxa := range taxas {
+		resp = append(resp, dto.FromTaxa(taxa))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+// DefinirTaxa cria ou substitui a taxa de /cambio/taxas/:de/:para
+func (h *CambioHandler) DefinirTaxa(c *gin.Context) {
+	// PASSO 1: LER o par da URL
+	de, para, ok := parMoedas(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR a taxa
+	var req dto.DefinirTaxaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	taxa, err := cambio.NovaTaxa(de, para, req.Taxa.String())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	if userID, ok := c.Get("userID"); ok {
+		taxa.AtualizadaPor, _ = userID.(int) // Auditoria
+	}
+
+	// PASSO 3: CHAMAR Service
+	taxa, err = h.service.DefinirTaxa(c.Request.Context(), taxa)
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao definir taxa de câmbio", "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromTaxa(taxa)})
+}
+
+func (h *CambioHandler) RemoverTaxa(c *gin.Context) {
+	de, para, ok := parMoedas(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoverTaxa(c.Request.Context(), de, para); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, cambio.ErrTaxaNaoEncontrada) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{Result: "Taxa de câmbio removida"})
+}
+
+func parMoedas(c *gin.Context) (dinheiro.Moeda, dinheiro.Moeda, bool) {
+	de, errDe := dinheiro.ParseMoeda(c.Param("de"))
+	para, errPara := dinheiro.ParseMoeda(c.Param("para"))
+	if err := errors.Join(errDe, errPara); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: dinheiro.ErrMoedaNaoSuportada.Error()})
+		return "", "", false
+	}
+	return de, para, true
+}
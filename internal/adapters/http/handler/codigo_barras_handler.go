@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/codigobarras"
+	entity "desafio-itens-app/internal/domain/item"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Até 10 folhas por pedido
+const maxEtiquetas = 10 * codigobarras.EtiquetasPorFolha
+
+// CodigoBarras devolve o código do item como imagem:
+// ?formato=code128|ean13|qr e ?saida=png|svg
+func (h *ItemHandler) CodigoBarras(c *gin.Context) {
+	formato, err := codigobarras.ParseFormato(c.Query("formato"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	saida, err := codigobarras.ParseSaida(c.Query("saida"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	item, ok := buscarItem(c, h.service)
+	if !ok {
+		return
+	}
+
+	codigo, err := codigobarras.Gerar(*item, formato)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", saida.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s-%s.%s"`, item.Code, formato, saida))
+	c.Status(http.StatusOK)
+	if saida == codigobarras.SaidaSVG {
+		err = codigobarras.EscreverSVG(c.Writer, codigo)
+	} else {
+		err = codigobarras.EscreverPNG(c.Writer, codigo)
+	}
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao escrever código de barras", "item_id", item.ID, "erro", err)
+		c.Abort()
+	}
+}
+
+// Etiquetas monta o PDF para imprimir em folha A4 de etiquetas adesivas:
+// ?ids=1,2,3 (um id repetido sai repetido) e ?formato= como no
+// código de barras
+func (h *ItemHandler) Etiquetas(c *gin.Context) {
+	formato, err := codigobarras.ParseFormato(c.Query("formato"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	ids, err := idsEtiquetas(c.Query("ids"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// 🔍 Cada item uma vez só, mesmo repetido na lista
+	itens := make(map[int]*entity.Item, len(ids))
+	for _, id := range ids {
+		if itens[id] != nil {
+			continue
+		}
+		item, err := h.service.GetItem(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, ResponseInfo{Error: true, Result: fmt.Sprintf("item %d: %s", id, err.Error())})
+			return
+		}
+		itens[id] = item
+	}
+
+	etiquetas := make([]codigobarras.Etiqueta, 0, len(ids))
+	for _, id := range ids {
+		item := itens[id]
+		codigo, err := codigobarras.Gerar(*item, formato)
+		if err != nil {
+			c.JSON(http.StatusUnprocessableEntity, ResponseInfo{Error: true, Result: err.Error()})
+			return
+		}
+		etiquetas = append(etiquetas, codigobarras.Etiqueta{
+			Nome:   item.Nome,
+			Preco:  string(item.Preco.Moeda) + " " + item.Preco.String(),
+			Codigo: codigo,
+		})
+	}
+
+	c.Header("Content-Type", "application/pdf")
+	c.Header("Content-Disposition", `inline; filename="etiquetas.pdf"`)
+	c.Status(http.StatusOK)
+	if err := codigobarras.FolhaEtiquetas(c.Writer, etiquetas); err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao gerar etiquetas", "erro", err)
+		c.Abort()
+	}
+}
+
+// idsEtiquetas lê "1,2,3" na ordem em que veio
+func idsEtiquetas(texto string) ([]int, error) {
+	if strings.TrimSpace(texto) == "" {
+		return nil, fmt.Errorf("informe os itens em ?ids=1,2,3")
+	}
+
+	partes := strings.Split(texto, ",")
+	if len(partes) > maxEtiquetas {
+		return nil, fmt.Errorf("no máximo %d etiquetas por pedido", maxEtiquetas)
+	}
+	ids := make([]int, 0, len(partes))
+	for _, parte := range partes {
+		id, err := strconv.Atoi(strings.TrimSpace(parte))
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("id inválido: %q", parte)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
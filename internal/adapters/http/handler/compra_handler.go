@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/compra"
+	"desafio-itens-app/internal/domain/fornecedor"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type CompraHandler struct {
+	service services.CompraService
+	logger  *slog.Logger
+}
+
+func NewCompraHandler(service services.CompraService, logger *slog.Logger) *CompraHandler {
+	return &CompraHandler{service: service, logger: logger}
+}
+
+// Listar aceita ?status= e ?fornecedor=
+func (h *CompraHandler) Listar(c *gin.Context) {
+	var filtro compra.Filtro
+	if status := c.Query("status"); status != "" {
+		filtro.Status = compra.Status(status)
+		if !filtro.Status.Valido() {
+			c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "Status inválido"})
+			return
+		}
+	}
+	if fornecedorID := c.Query("fornecedor"); fornecedorID != "" {
+		id, err := strconv.Atoi(fornecedorID)
+		if err != nil || id <= 0 {
+			c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID do fornecedor inválido"})
+			return
+		}
+		filtro.FornecedorID = id
+	}
+
+	pedidos, err := h.service.Listar(c.Request.Context(), filtro)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.PedidoCompraResponse, 0, len(pedidos))
+	for _, p := range pedidos {
+		resp = append(resp, dto.FromPedidoCompra(p))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+// Buscar traz o pedido com o histórico de recebimentos
+func (h *CompraHandler) Buscar(c *gin.Context) {
+	id, ok := idPedidoCompra(c)
+	if !ok {
+		return
+	}
+
+	p, err := h.service.Buscar(c.Request.Context(), id)
+	if err != nil {
+		responderErroCompra(c, err)
+		return
+	}
+	recebimentos, err := h.service.Recebimentos(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := dto.FromPedidoCompra(*p)
+	resp.ComRecebimentos(recebimentos)
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+func (h *CompraHandler) Criar(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.PedidoCompraRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	p, err := req.ToEntity(opcoes.UsuarioID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: CHAMAR Service
+	p, err = h.service.Criar(c.Request.Context(), p)
+	if err != nil {
+		responderErroCompra(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromPedidoCompra(p)})
+}
+
+// Atualizar troca observação e linhas; só vale para rascunho
+func (h *CompraHandler) Atualizar(c *gin.Context) {
+	id, ok := idPedidoCompra(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AlterarPedidoCompraRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	linhas, err := req.ToLinhas()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	p, err := h.service.Atualizar(c.Request.Context(), id, req.Observacao, linhas)
+	if err != nil {
+		responderErroCompra(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedidoCompra(p)})
+}
+
+func (h *CompraHandler) Enviar(c *gin.Context) {
+	id, ok := idPedidoCompra(c)
+	if !ok {
+		return
+	}
+
+	p, err := h.service.Enviar(c.Request.Context(), id)
+	if err != nil {
+		responderErroCompra(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedidoCompra(p)})
+}
+
+func (h *CompraHandler) Cancelar(c *gin.Context) {
+	id, ok := idPedidoCompra(c)
+	if !ok {
+		return
+	}
+
+	p, err := h.service.Cancelar(c.Request.Context(), id)
+	if err != nil {
+		responderErroCompra(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedidoCompra(p)})
+}
+
+// Receber dá entrada no estoque dos itens recebidos
+func (h *CompraHandler) Receber(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+	id, ok := idPedidoCompra(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RecebimentoCompraRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	quantidades, err := req.ToQuantidades()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	p, err := h.service.Receber(c.Request.Context(), id, quantidades, opcoes.UsuarioID)
+	if err != nil {
+		responderErroCompra(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedidoCompra(p)})
+}
+
+func idPedidoCompra(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID inválido"})
+		return 0, false
+	}
+	return id, true
+}
+
+func responderErroCompra(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, compra.ErrPedidoNaoEncontrado), errors.Is(err, fornecedor.ErrFornecedorNaoEncontrado):
+		status = http.StatusNotFound
+	case errors.Is(err, compra.ErrTransicaoInvalida):
+		status = http.StatusConflict
+	case errors.Is(err, compra.ErrPedidoInvalido):
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
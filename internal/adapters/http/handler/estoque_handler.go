@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/deposito"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type EstoqueHandler struct {
+	service services.EstoqueService
+	itens   services.ItemService
+	logger  *slog.Logger
+}
+
+func NewEstoqueHandler(service services.EstoqueService, itens services.ItemService, logger *slog.Logger) *EstoqueHandler {
+	return &EstoqueHandler{service: service, itens: itens, logger: logger}
+}
+
+func (h *EstoqueHandler) ListarDepositos(c *gin.Context) {
+	depositos, err := h.service.ListarDepositos(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.DepositoResponse, 0, len(depositos))
+	for _, d := range depositos {
+		resp = append(resp, dto.FromDeposito(d))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+func (h *EstoqueHandler) CriarDeposito(c *gin.Context) {
+	var req dto.DepositoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	d, err := req.ToEntity()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	d, err = h.service.CriarDeposito(c.Request.Context(), d)
+	if err != nil {
+		responderErroEstoque(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromDeposito(d)})
+}
+
+// Posicao devolve o estoque do item quebrado por depósito
+func (h *EstoqueHandler) Posicao(c *gin.Context) {
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+
+	posicao, err := h.service.Posicao(c.Request.Context(), item.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPosicaoEstoque(posicao)})
+}
+
+// DefinirSaldo grava a quantidade do item num depósito; o estoque do item
+// passa a ser a soma dos depósitos
+func (h *EstoqueHandler) DefinirSaldo(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	depositoID, ok := idDeposito(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.DefinirSaldoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: BUSCAR item e VERIFICAR AUTORIZAÇÃO
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	// PASSO 4: CHAMAR Service
+	posicao, err := h.service.DefinirSaldo(c.Request.Context(), item.ID, depositoID, *req.Quantidade, opcoes.UsuarioID)
+	if err != nil {
+		responderErroEstoque(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPosicaoEstoque(posicao)})
+}
+
+func (h *EstoqueHandler) Transferir(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	var req dto.TransferenciaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	transferencia := req.ToEntity(item.ID, opcoes.UsuarioID)
+	if err := transferencia.Validar(); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	transferencia, err := h.service.Transferir(c.Request.Context(), transferencia)
+	if err != nil {
+		responderErroEstoque(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromTransferencia(transferencia)})
+}
+
+func idDeposito(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("deposito"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID do depósito inválido"})
+		return 0, false
+	}
+	return id, true
+}
+
+func responderErroEstoque(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, deposito.ErrDepositoNaoEncontrado):
+		status = http.StatusNotFound
+	case errors.Is(err, deposito.ErrCodigoDuplicado):
+		status = http.StatusConflict
+	case errors.Is(err, deposito.ErrEstoqueInsuficiente):
+		status = http.StatusConflict
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
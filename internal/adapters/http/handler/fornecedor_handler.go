@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/fornecedor"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type FornecedorHandler struct {
+	service services.FornecedorService
+	itens   services.ItemService
+	logger  *slog.Logger
+}
+
+func NewFornecedorHandler(service services.FornecedorService, itens services.ItemService, logger *slog.Logger) *FornecedorHandler {
+	return &FornecedorHandler{service: service, itens: itens, logger: logger}
+}
+
+func (h *FornecedorHandler) Listar(c *gin.Context) {
+	fornecedores, err := h.service.Listar(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.FornecedorResponse, 0, len(fornecedores))
+	for _, f := range fornecedores {
+		resp = append(resp, dto.FromFornecedor(f))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+func (h *FornecedorHandler) Buscar(c *gin.Context) {
+	id, ok := idFornecedor(c, "id")
+	if !ok {
+		return
+	}
+
+	f, err := h.service.Buscar(c.Request.Context(), id)
+	if err != nil {
+		responderErroFornecedor(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromFornecedor(*f)})
+}
+
+func (h *FornecedorHandler) Criar(c *gin.Context) {
+	var req dto.FornecedorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	f, err := req.ToEntity(0)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	f, err = h.service.Criar(c.Request.Context(), f)
+	if err != nil {
+		responderErroFornecedor(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromFornecedor(f)})
+}
+
+// Atualizar substitui os dados do fornecedor (mesmo corpo do POST)
+func (h *FornecedorHandler) Atualizar(c *gin.Context) {
+	id, ok := idFornecedor(c, "id")
+	if !ok {
+		return
+	}
+
+	var req dto.FornecedorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	f, err := req.ToEntity(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	f, err = h.service.Atualizar(c.Request.Context(), f)
+	if err != nil {
+		responderErroFornecedor(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromFornecedor(f)})
+}
+
+// Vinculos lista quem fornece o item, com SKU e custo
+func (h *FornecedorHandler) Vinculos(c *gin.Context) {
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+
+	vinculos, err := h.service.Vinculos(c.Request.Context(), item.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.VinculoFornecedorResponse, 0, len(vinculos))
+	for _, v := range vinculos {
+		resp = append(resp, dto.FromVinculoFornecedor(v))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+// Vincular cria ou substitui o SKU e o custo do item no fornecedor
+func (h *FornecedorHandler) Vincular(c *gin.Context) {
+	// PASSO 1: RECEBER e VALIDAR JSON
+	fornecedorID, ok := idFornecedor(c, "fornecedor")
+	if !ok {
+		return
+	}
+	var req dto.VinculoFornecedorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 2: BUSCAR item
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+	v, err := req.ToEntity(item.ID, fornecedorID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: CHAMAR Service
+	v, err = h.service.Vincular(c.Request.Context(), v)
+	if err != nil {
+		responderErroFornecedor(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromVinculoFornecedor(v)})
+}
+
+func (h *FornecedorHandler) Desvincular(c *gin.Context) {
+	fornecedorID, ok := idFornecedor(c, "fornecedor")
+	if !ok {
+		return
+	}
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Desvincular(c.Request.Context(), item.ID, fornecedorID); err != nil {
+		responderErroFornecedor(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: "Fornecedor desvinculado do item"})
+}
+
+func idFornecedor(c *gin.Context, param string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(param))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID do fornecedor inválido"})
+		return 0, false
+	}
+	return id, true
+}
+
+func responderErroFornecedor(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, fornecedor.ErrFornecedorNaoEncontrado) || errors.Is(err, fornecedor.ErrVinculoNaoEncontrado) {
+		status = http.StatusNotFound
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"desafio-itens-app/internal/adapters/http/dto"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Verificacao é uma dependência checada pelo /readyz e pelo /v1/admin/status
+type Verificacao struct {
+	Nome   string
+	Checar func(ctx context.Context) error
+}
+
+// Build identifica o binário (preenchido via -ldflags no main)
+type Build struct {
+	Versao string
+	Commit string
+}
+
+type HealthHandler struct { // Handler das sondas do orquestrador e do status
+	build        Build
+	inicio       time.Time
+	poolStats    func() sql.DBStats
+	verificacoes []Verificacao
+	timeout      time.Duration // Limite de cada verificação
+	logger       *slog.Logger
+}
+
+func NewHealthHandler(build Build, poolStats func() sql.DBStats, logger *slog.Logger, verificacoes ...Verificacao) *HealthHandler {
+	return &HealthHandler{
+		build:        build,
+		inicio:       time.Now(),
+		poolStats:    poolStats,
+		verificacoes: verificacoes,
+		timeout:      2 * time.Second,
+		logger:       logger,
+	}
+}
+
+// Liveness: o processo está de pé e respondendo. Não olha dependências,
+// senão uma queda do banco faria o orquestrador reiniciar todas as réplicas.
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, ResponseInfo{
+		Error:  false,
+		Result: "ok",
+	})
+}
+
+// Readiness: só recebe tráfego quem alcança o banco, está com o schema em
+// dia e tem a chave JWT carregada.
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	resultados, ok := h.verificar(c.Request.Context())
+	if !ok {
+		c.JSON(http.StatusServiceUnavailable, ResponseInfo{
+			Error:  true,
+			Result: resultados,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{
+		Error:  false,
+		Result: resultados,
+	})
+}
+
+// Status mostra versão, uptime, pool de conexões e latência das dependências (só admin)
+func (h *HealthHandler) Status(c *gin.Context) {
+	resultados, _ := h.verificar(c.Request.Context())
+
+	c.JSON(http.StatusOK, ResponseInfo{
+		Error: false,
+		Result: dto.StatusResponse{
+			Versao:       h.build.Versao,
+			Commit:       h.build.Commit,
+			GoVersion:    runtime.Version(),
+			IniciadoEm:   h.inicio,
+			Uptime:       time.Since(h.inicio).Round(time.Second).String(),
+			Pool:         dto.FromDBStats(h.poolStats()),
+			Dependencias: resultados,
+		},
+	})
+}
+
+// verificar roda as verificações em paralelo, cada uma com seu timeout
+func (h *HealthHandler) verificar(ctx context.Context) ([]dto.VerificacaoResponse, bool) {
+	resultados := make([]dto.VerificacaoResponse, len(h.verificacoes))
+
+	var wg sync.WaitGroup
+	for i, v := range h.verificacoes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctxVerificacao, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+
+			inicio := time.Now()
+			err := v.Checar(ctxVerificacao)
+			resultados[i] = dto.VerificacaoResponse{
+				Nome:       v.Nome,
+				Ok:         err == nil,
+				LatenciaMs: float64(time.Since(inicio)) / float64(time.Millisecond),
+			}
+			if err != nil {
+				resultados[i].Erro = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	ok := true
+	for _, r := range resultados {
+		if !r.Ok {
+			ok = false
+			h.logger.WarnContext(ctx, "dependência indisponível", "dependencia", r.Nome, "erro", r.Erro)
+		}
+	}
+	return resultados, ok
+}
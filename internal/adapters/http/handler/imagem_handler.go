@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/blobs"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/imagem"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// TamanhoMaximoEnvioImagens: um envio leva no máximo as imagens que cabem
+// no item, com folga para o envelope multipart
+const TamanhoMaximoEnvioImagens = imagem.MaxPorItem*imagem.TamanhoMaximo + 1<<20
+
+type ImagemHandler struct {
+	service services.ImagemService
+	itens   services.ItemService
+	logger  *slog.Logger
+}
+
+func NewImagemHandler(service services.ImagemService, itens services.ItemService, logger *slog.Logger) *ImagemHandler {
+	return &ImagemHandler{service: service, itens: itens, logger: logger}
+}
+
+// Enviar recebe um ou mais arquivos no campo "arquivo" (multipart) e os
+// acrescenta no fim da ordem das imagens do item
+func (h *ImagemHandler) Enviar(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: BUSCAR item (só admin ou o criador)
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	// PASSO 3: LER os arquivos
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, TamanhoMaximoEnvioImagens)
+	arquivos, err := lerArquivosImagem(c)
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytes *http.MaxBytesError
+		if errors.As(err, &maxBytes) || errors.Is(err, imagem.ErrImagemGrande) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 4: CHAMAR Service
+	criadas, err := h.service.Enviar(c.Request.Context(), item.ID, arquivos, opcoes.UsuarioID)
+	if err != nil {
+		h.responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromImagens(criadas)})
+}
+
+func (h *ImagemHandler) Listar(c *gin.Context) {
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+
+	imagens, err := h.service.Listar(c.Request.Context(), item.ID)
+	if err != nil {
+		h.responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromImagens(imagens)})
+}
+
+// Reordenar recebe todos os ids das imagens do item na ordem nova
+func (h *ImagemHandler) Reordenar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReordenarImagensRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	imagens, err := h.service.Reordenar(c.Request.Context(), item.ID, req.IDs)
+	if err != nil {
+		h.responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromImagens(imagens)})
+}
+
+func (h *ImagemHandler) DefinirPrincipal(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+	imagemID, ok := idImagem(c)
+	if !ok {
+		return
+	}
+
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	imagens, err := h.service.DefinirPrincipal(c.Request.Context(), item.ID, imagemID)
+	if err != nil {
+		h.responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromImagens(imagens)})
+}
+
+func (h *ImagemHandler) Remover(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+	imagemID, ok := idImagem(c)
+	if !ok {
+		return
+	}
+
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Remover(c.Request.Context(), item.ID, imagemID); err != nil {
+		h.responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: "Imagem removida com sucesso"})
+}
+
+// Servir entrega o arquivo guardado (/v1/imagens/itens/7/ab12.jpg). A chave
+// tem nome aleatório e nunca é regravada, então o cache pode ser eterno.
+func (h *ImagemHandler) Servir(c *gin.Context) {
+	chave := strings.TrimPrefix(c.Param("chave"), "/")
+	if chave != path.Clean(chave) || !strings.HasPrefix(chave, "itens/") {
+		c.JSON(http.StatusNotFound, ResponseInfo{Error: true, Result: blobs.ErrBlobNaoEncontrado.Error()})
+		return
+	}
+
+	conteudo, contentType, err := h.service.Abrir(c.Request.Context(), chave)
+	if err != nil {
+		if errors.Is(err, blobs.ErrBlobNaoEncontrado) {
+			c.JSON(http.StatusNotFound, ResponseInfo{Error: true, Result: err.Error()})
+			return
+		}
+		h.logger.ErrorContext(c.Request.Context(), "erro ao abrir imagem", "chave", chave, "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: "Erro ao abrir a imagem"})
+		return
+	}
+	defer conteudo.Close()
+
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.DataFromReader(http.StatusOK, -1, contentType, conteudo, nil)
+}
+
+func (h *ImagemHandler) responderErro(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, imagem.ErrImagemNaoEncontrada):
+		status = http.StatusNotFound
+	case errors.Is(err, imagem.ErrTipoNaoSuportado):
+		status = http.StatusUnsupportedMediaType
+	case errors.Is(err, imagem.ErrImagemGrande):
+		status = http.StatusRequestEntityTooLarge
+	case errors.Is(err, imagem.ErrLimiteImagens):
+		status = http.StatusConflict
+	case errors.Is(err, imagem.ErrImagemInvalida), errors.Is(err, imagem.ErrOrdemInvalida):
+		status = http.StatusBadRequest
+	default:
+		h.logger.ErrorContext(c.Request.Context(), "erro nas imagens do item", "erro", err)
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
+
+// lerArquivosImagem lê todos os arquivos do campo "arquivo"; o conteúdo é
+// conferido depois, pelos bytes, e não pelo nome ou Content-Type enviados
+func lerArquivosImagem(c *gin.Context) ([][]byte, error) {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, fmt.Errorf("envie as imagens como multipart no campo 'arquivo': %w", err)
+	}
+	cabecalhos := form.File["arquivo"]
+	if len(cabecalhos) == 0 {
+		return nil, errors.New("envie as imagens no campo 'arquivo'")
+	}
+	if len(cabecalhos) > imagem.MaxPorItem {
+		return nil, imagem.ErrLimiteImagens
+	}
+
+	arquivos := make([][]byte, 0, len(cabecalhos))
+	for _, cabecalho := range cabecalhos {
+		if cabecalho.Size > imagem.TamanhoMaximo {
+			return nil, fmt.Errorf("%s: %w", cabecalho.Filename, imagem.ErrImagemGrande)
+		}
+		f, err := cabecalho.Open()
+		if err != nil {
+			return nil, err
+		}
+		dados, err := io.ReadAll(io.LimitReader(f, imagem.TamanhoMaximo+1))
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		arquivos = append(arquivos, dados)
+	}
+	return arquivos, nil
+}
+
+func idImagem(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("imagem"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID da imagem inválido"})
+		return 0, false
+	}
+	return id, true
+}
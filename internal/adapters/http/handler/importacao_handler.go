@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/importacao"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	TamanhoMaximoImportacao  = 20 << 20 // 20 MB (o middleware de idempotência usa o mesmo teto)
+	limiteImportacaoSincrona = 1000     // Acima disso vira job assíncrono
+)
+
+type ImportacaoHandler struct {
+	service services.ImportacaoService
+	logger  *slog.Logger
+}
+
+func NewImportacaoHandler(service services.ImportacaoService, logger *slog.Logger) *ImportacaoHandler {
+	return &ImportacaoHandler{service: service, logger: logger}
+}
+
+// Importar recebe um CSV ou NDJSON no corpo (ou no campo "arquivo" de um
+// multipart/form-data). Query params:
+//
+//	dry_run=true  só valida, não grava
+//	modo=upsert   linhas com code de item existente atualizam o item
+//	async=true    força o processamento em segundo plano
+func (h *ImportacaoHandler) Importar(c *gin.Context) {
+	// PASSO 1: EXTRAIR usuário do context
+	userID, ok := c.Get("userID")
+	userIDInt, okInt := userID.(int)
+	if !ok || !okInt {
+		c.JSON(http.StatusUnauthorized, ResponseInfo{
+			Error:  true,
+			Result: "Usuário não autenticado",
+		})
+		return
+	}
+	role, _ := c.Get("userRole")
+
+	// PASSO 2: ABRIR o arquivo e descobrir o formato
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, TamanhoMaximoImportacao)
+	arquivo, formato, err := abrirArquivoImportacao(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{
+			Error:  true,
+			Result: err.Error(),
+		})
+		return
+	}
+	defer arquivo.Close()
+
+	// PASSO 3: LER as linhas (erros de uma linha vão para o relatório)
+	linhas, err := importacao.Ler(formato, arquivo)
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytes *http.MaxBytesError
+		if errors.As(err, &maxBytes) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		c.JSON(status, ResponseInfo{
+			Error:  true,
+			Result: err.Error(),
+		})
+		return
+	}
+	if len(linhas) == 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{
+			Error:  true,
+			Result: "Arquivo sem linhas para importar",
+		})
+		return
+	}
+
+	opcoes := importacao.Opcoes{
+		DryRun:    c.Query("dry_run") == "true",
+		Upsert:    c.Query("modo") == "upsert",
+		UsuarioID: userIDInt,
+		Admin:     role == "admin",
+	}
+
+	// PASSO 4a: ARQUIVO GRANDE → job em segundo plano
+	if len(linhas) > limiteImportacaoSincrona || c.Query("async") == "true" {
+		job, err := h.service.IniciarImportacao(c.Request.Context(), linhas, opcoes)
+		if err != nil {
+			h.logger.ErrorContext(c.Request.Context(), "erro ao iniciar importação", "erro", err)
+			c.JSON(http.StatusInternalServerError, ResponseInfo{
+				Error:  true,
+				Result: err.Error(),
+			})
+			return
+		}
+
+		c.Header("Location", "/v1/itens/importar/"+job.ID)
+		c.JSON(http.StatusAccepted, ResponseInfo{
+			Error:  false,
+			Result: dto.FromImportacaoJob(job),
+		})
+		return
+	}
+
+	// PASSO 4b: ARQUIVO PEQUENO → processa na hora
+	relatorio, err := h.service.Importar(c.Request.Context(), linhas, opcoes)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{
+			Error:  true,
+			Result: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{
+		Error:  false,
+		Result: dto.FromRelatorio(relatorio),
+	})
+}
+
+// GetJob mostra o andamento de uma importação assíncrona (só para quem a criou ou admin)
+func (h *ImportacaoHandler) GetJob(c *gin.Context) {
+	job, err := h.service.GetJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, importacao.ErrJobNaoEncontrado) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ResponseInfo{
+			Error:  true,
+			Result: err.Error(),
+		})
+		return
+	}
+
+	userID, _ := c.Get("userID")
+	role, _ := c.Get("userRole")
+	if role != "admin" && userID != job.CriadoPor {
+		// 404 e não 403: não revela que o job existe
+		c.JSON(http.StatusNotFound, ResponseInfo{
+			Error:  true,
+			Result: importacao.ErrJobNaoEncontrado.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{
+		Error:  false,
+		Result: dto.FromImportacaoJob(*job),
+	})
+}
+
+// abrirArquivoImportacao aceita o arquivo direto no corpo ou via multipart.
+// O formato vem de ?formato=, da extensão do arquivo ou do Content-Type.
+func abrirArquivoImportacao(c *gin.Context) (io.ReadCloser, importacao.Formato, error) {
+	contentType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+
+	var arquivo io.ReadCloser = c.Request.Body
+	nome := ""
+	if contentType == "multipart/form-data" {
+		cabecalho, err := c.FormFile("arquivo")
+		if err != nil {
+			return nil, "", errors.New("envie o arquivo no campo 'arquivo'")
+		}
+		f, err := cabecalho.Open()
+		if err != nil {
+			return nil, "", err
+		}
+		arquivo = f
+		nome = cabecalho.Filename
+		contentType, _, _ = mime.ParseMediaType(cabecalho.Header.Get("Content-Type"))
+	}
+
+	formato := importacao.Formato(strings.ToLower(c.Query("formato")))
+	if formato == "" {
+		formato = detectarFormato(nome, contentType)
+	}
+	if formato == "" {
+		arquivo.Close()
+		return nil, "", errors.New("formato não identificado: use ?formato=csv|ndjson ou Content-Type text/csv / application/x-ndjson")
+	}
+
+	return arquivo, formato, nil
+}
+
+func detectarFormato(nome, contentType string) importacao.Formato {
+	switch strings.ToLower(filepath.Ext(nome)) {
+	case ".csv":
+		return importacao.FormatoCSV
+	case ".ndjson", ".jsonl":
+		return importacao.FormatoNDJSON
+	}
+
+	switch contentType {
+	case "text/csv", "application/csv":
+		return importacao.FormatoCSV
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/json":
+		return importacao.FormatoNDJSON
+	}
+	return ""
+}
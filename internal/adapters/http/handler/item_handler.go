@@ -1,13 +1,18 @@
 package handler
 
 import (
+	"desafio-itens-app/internal/adapters/exportacao"
 	"desafio-itens-app/internal/adapters/http/dto"
 	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
 	entity "desafio-itens-app/internal/domain/item" // Domain entities
-	"fmt"
+	"errors"
 	"github.com/gin-gonic/gin" // HTTP framework
+	"log/slog"                 // Logs estruturados
 	"net/http"                 // HTTP status codes
 	"strconv"                  // String conversions
+	"time"
 )
 
 type ResponseInfo struct { // Padronização de resposta HTTP
@@ -25,21 +30,22 @@ type PageInfo struct {
 }
 
 type ItemHandler struct { // Handler para operações de Item
-	service services.ItemService // Dependência: service layer
+	service   services.ItemService // Dependência: service layer
+	cambio    services.CambioService
+	promocoes services.PromocaoService
+	reservas  services.ReservaService
+	variantes services.VarianteService
+	imagens   services.ImagemService
+	logger    *slog.Logger
 }
 
-func NewItemHandler(service services.ItemService) *ItemHandler { // Factory function
-	return &ItemHandler{service: service} // Injeta dependência
+func NewItemHandler(service services.ItemService, cambio services.CambioService, promocoes services.PromocaoService, reservas services.ReservaService, variantes services.VarianteService, imagens services.ImagemService, logger *slog.Logger) *ItemHandler { // Factory function
+	return &ItemHandler{service: service, cambio: cambio, promocoes: promocoes, reservas: reservas, variantes: variantes, imagens: imagens, logger: logger} // Injeta dependência
 }
 
 func (h *ItemHandler) AddItem(c *gin.Context) {
 	// PASSO 1: EXTRAIR userID do context
 	userID, exists := c.Get("userID")
-
-	// 🔍 DEBUG - adicionar logs
-	fmt.Printf("🔍 DEBUG - userID exists: %v\n", exists)
-	fmt.Printf("🔍 DEBUG - userID value: %v\n", userID)
-
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ResponseInfo{
 			Error:  true,
@@ -50,9 +56,8 @@ func (h *ItemHandler) AddItem(c *gin.Context) {
 
 	// PASSO 2: CONVERTER para int
 	userIDInt, ok := userID.(int)
-	fmt.Printf("🔍 DEBUG - userIDInt: %v, ok: %v\n", userIDInt, ok) // ← Mais um log
-
 	if !ok {
+		h.logger.ErrorContext(c.Request.Context(), "userID com tipo inesperado no contexto", "userID", userID)
 		c.JSON(http.StatusInternalServerError, ResponseInfo{
 			Error:  true,
 			Result: "Erro interno: userID inválido",
@@ -63,7 +68,7 @@ func (h *ItemHandler) AddItem(c *gin.Context) {
 	// PASSO 3: RECEBER e VALIDAR JSON
 	var req dto.CreateItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		fmt.Printf("erro: %v", err)
+		h.logger.DebugContext(c.Request.Context(), "JSON inválido ao criar item", "erro", err)
 		c.JSON(http.StatusBadRequest, ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
@@ -72,14 +77,20 @@ func (h *ItemHandler) AddItem(c *gin.Context) {
 	}
 
 	// PASSO 4: CONVERTER para Entity e DEFINIR auditoria
-	item := req.ToEntity()
+	item, err := req.ToEntity()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{
+			Error:  true,
+			Result: err.Error(),
+		})
+		return
+	}
 	item.CreatedBy = &userIDInt
-	fmt.Printf("🔍 DEBUG - item.CreatedBy: %v\n", item.CreatedBy) // ← Mais um log
 
 	// PASSO 5: CHAMAR Service
-	createdItem, err := h.service.AddItem(item)
+	createdItem, err := h.service.AddItem(c.Request.Context(), item)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ResponseInfo{
+		c.JSON(statusErroGravacaoItem(err), ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
 		})
@@ -96,6 +107,11 @@ func (h *ItemHandler) AddItem(c *gin.Context) {
 func (h *ItemHandler) GetItem(c *gin.Context) {
 	idParam := c.Param("id") // Extrai parâmetro da URL
 
+	moeda, ok := moedaConsulta(c) // ?moeda=USD
+	if !ok {
+		return
+	}
+
 	id, err := strconv.Atoi(idParam) // 🔄 TRANSFORMATION: string → int
 	if err != nil {                  // 🛡️ VALIDATION GUARD
 		c.JSON(http.StatusBadRequest, ResponseInfo{
@@ -105,8 +121,8 @@ func (h *ItemHandler) GetItem(c *gin.Context) {
 		return
 	}
 
-	item, err := h.service.GetItem(id) // 🌐 EXTERNAL CALL: busca no service
-	if item == nil || item.ID == 0 {   // ⚙️ BUSINESS RULE: verifica se encontrou
+	item, err := h.service.GetItem(c.Request.Context(), id) // 🌐 EXTERNAL CALL: busca no service
+	if item == nil || item.ID == 0 {                        // ⚙️ BUSINESS RULE: verifica se encontrou
 		c.JSON(http.StatusNotFound, ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
@@ -114,15 +130,26 @@ func (h *ItemHandler) GetItem(c *gin.Context) {
 		return
 	}
 
+	resp := []dto.ItemResponse{dto.FromEntity(*item)} // Mesmo formato da listagem (preço decimal exato)
+	if !h.aplicarReservas(c, []entity.Item{*item}, resp) || !h.aplicarPromocoes(c, []entity.Item{*item}, resp) || !h.converterPrecos(c, moeda, []entity.Item{*item}, resp) || !h.aplicarImagens(c, []entity.Item{*item}, resp) {
+		return
+	}
+
 	c.JSON(http.StatusOK, ResponseInfo{ // Retorna item encontrado
 		TotalPages: 1,
-		Data:       item,
+		Data:       resp[0],
 	})
 }
 
 func (h *ItemHandler) GetItens(c *gin.Context) {
 	// 🔍 PARÂMETROS DE FILTRO (já existentes)
 	statusParam := c.Query("status") // ?status=active
+	buscaParam := c.Query("busca")   // ?busca=mouse
+
+	moeda, ok := moedaConsulta(c) // ?moeda=USD
+	if !ok {
+		return
+	}
 
 	// 📄 PARÂMETROS DE PAGINAÇÃO (novos)
 	pageParam := c.DefaultQuery("page", "1")          // ?page=2
@@ -150,8 +177,15 @@ func (h *ItemHandler) GetItens(c *gin.Context) {
 	}
 
 	// 📞 CHAMAR Service com paginação E filtros
-	itens, totalItens, err := h.service.GetItensFiltradosPaginados(status, page, pageSize)
+	var itens []entity.Item
+	var totalItens int
+	if buscaParam != "" {
+		itens, totalItens, err = h.service.BuscarItens(c.Request.Context(), buscaParam, status, page, pageSize)
+	} else {
+		itens, totalItens, err = h.service.GetItensFiltradosPaginados(c.Request.Context(), status, page, pageSize)
+	}
 	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao listar itens", "erro", err)
 		c.JSON(http.StatusInternalServerError, ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
@@ -164,6 +198,9 @@ func (h *ItemHandler) GetItens(c *gin.Context) {
 	for _, it := range itens {
 		resp = append(resp, dto.FromEntity(it))
 	}
+	if !h.aplicarReservas(c, itens, resp) || !h.aplicarPromocoes(c, itens, resp) || !h.converterPrecos(c, moeda, itens, resp) || !h.aplicarImagens(c, itens, resp) {
+		return
+	}
 
 	// 🧮 CALCULAR total de páginas
 	totalPages := (totalItens + pageSize - 1) / pageSize
@@ -219,17 +256,7 @@ func (h *ItemHandler) UpdateItem(c *gin.Context) {
 		return
 	}
 
-	// PASSO 5: BUSCAR item existente
-	existingItem, err := h.service.GetItem(id)
-	if err != nil {
-		c.JSON(http.StatusNotFound, ResponseInfo{
-			Error:  true,
-			Result: err.Error(),
-		})
-		return
-	}
-
-	// PASSO 6: VERIFICAR AUTORIZAÇÃO
+	// PASSO 5: VERIFICAR AUTORIZAÇÃO
 	userRole, exists := c.Get("userRole")
 	if !exists {
 		c.JSON(http.StatusForbidden, ResponseInfo{
@@ -241,38 +268,70 @@ func (h *ItemHandler) UpdateItem(c *gin.Context) {
 
 	roleStr := userRole.(string)
 
-	if roleStr != "admin" {
-		if existingItem.CreatedBy == nil || *existingItem.CreatedBy != userIDInt {
-			c.JSON(http.StatusForbidden, ResponseInfo{
-				Error:  true,
-				Result: "Você só pode editar itens que criou",
-			})
-			return
+	// PASSO 6: APLICAR mudanças no item travado e relido, e DEFINIR auditoria
+	updatedItem, err := h.service.AlterarItem(c.Request.Context(), id, func(item *entity.Item) error {
+		if !item.PodeSerEditadoPor(userIDInt, roleStr == "admin") {
+			return entity.ErrEdicaoNaoPermitida
 		}
-	}
-
-	// PASSO 6: APLICAR mudanças e DEFINIR auditoria
-	updatedItem := *existingItem
-	req.ApplyTo(&updatedItem)         // ← Usando SEU método
-	updatedItem.UpdateBy = &userIDInt // ← AUDITORIA: quem atualizou
-
-	// PASSO 7: CHAMAR Service
-	err = h.service.UpdateItem(updatedItem)
+		if err := req.ApplyTo(item); err != nil { // ← Usando SEU método
+			return entity.Invalido(err)
+		}
+		item.UpdateBy = &userIDInt // ← AUDITORIA: quem atualizou
+		return nil
+	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ResponseInfo{
+		c.JSON(statusErroGravacaoItem(err), ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
 		})
 		return
 	}
 
-	// PASSO 8: RETORNAR resposta
+	// PASSO 7: RETORNAR resposta (o disponível desconta as reservas em aberto)
+	resp := []dto.ItemResponse{dto.FromEntity(updatedItem)}
+	if !h.aplicarReservas(c, []entity.Item{updatedItem}, resp) {
+		return
+	}
 	c.JSON(http.StatusOK, ResponseInfo{
 		Error:  false,
-		Result: dto.FromEntity(updatedItem),
+		Result: resp[0],
 	})
 }
 
+// statusErroGravacaoItem: item que não existe é 404, item de outro usuário
+// é 403, code ou EAN de outro item é conflito (409); o resto do que o
+// service recusa é 400
+func statusErroGravacaoItem(err error) int {
+	switch {
+	case errors.Is(err, entity.ErrItemNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, entity.ErrEdicaoNaoPermitida):
+		return http.StatusForbidden
+	case errors.Is(err, entity.ErrCodeDuplicado) || errors.Is(err, entity.ErrEANDuplicado):
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
+
+// ListarEstoqueBaixo é o relatório de reposição: itens no ponto de
+// reposição ou abaixo, os mais distantes dele primeiro
+func (h *ItemHandler) ListarEstoqueBaixo(c *gin.Context) {
+	itens, err := h.service.ListarEstoqueBaixo(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.ItemResponse, 0, len(itens))
+	for _, it := range itens {
+		resp = append(resp, dto.FromEntity(it))
+	}
+	if !h.aplicarReservas(c, itens, resp) {
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
 func (h *ItemHandler) DeleteItem(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := strconv.Atoi(idParam)
@@ -285,7 +344,7 @@ func (h *ItemHandler) DeleteItem(c *gin.Context) {
 	}
 
 	// 🔑 CORREÇÃO: Lógica simples e clara
-	err = h.service.DeleteItem(id)
+	err = h.service.DeleteItem(c.Request.Context(), id)
 	if err != nil {
 		// ✅ QUALQUER erro = resposta de erro
 		c.JSON(http.StatusBadRequest, ResponseInfo{
@@ -301,3 +360,188 @@ func (h *ItemHandler) DeleteItem(c *gin.Context) {
 		Result: "Item deletado com sucesso!",
 	})
 }
+
+// ExportarItens baixa o catálogo em CSV, JSON Lines ou XLSX com os mesmos
+// filtros do GET /itens (?status, ?busca). Os itens são lidos em lotes e
+// escritos direto na resposta.
+func (h *ItemHandler) ExportarItens(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 🔍 VALIDAR tudo antes de começar a escrever (depois não dá mais para
+	// devolver 400)
+	formato := exportacao.Formato(c.DefaultQuery("formato", string(exportacao.FormatoCSV)))
+	colunas, err := exportacao.ParseColunas(c.Query("colunas"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	var status *entity.Status
+	if statusParam := c.Query("status"); statusParam != "" {
+		s := entity.Status(statusParam)
+		if s != entity.StatusAtivo && s != entity.StatusInativo {
+			c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "status deve ser 'active' ou 'inactive'"})
+			return
+		}
+		status = &s
+	}
+
+	locale := exportacao.EscolherLocale(c.Query("locale"), c.GetHeader("Accept-Language"))
+	escritor, err := exportacao.NovoEscritor(formato, c.Writer, colunas, locale)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// 📥 HEADERS do download
+	nomeArquivo := "itens-" + time.Now().Format("20060102") + "." + formato.Extensao()
+	c.Header("Content-Type", formato.ContentType())
+	c.Header("Content-Disposition", `attachment; filename="`+nomeArquivo+`"`)
+	c.Status(http.StatusOK)
+
+	// Catálogos grandes passam do HTTP_WRITE_TIMEOUT; o fim do stream é
+	// controlado pelo contexto da requisição
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.DebugContext(ctx, "não foi possível remover o prazo de escrita", "erro", err)
+	}
+
+	// 📤 STREAM: a partir daqui a resposta já começou, erros só vão para o log
+	err = h.service.ExportarItens(ctx, status, c.Query("busca"), escritor.Escrever)
+	if errFechar := escritor.Fechar(); err == nil {
+		err = errFechar
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "exportação interrompida", "formato", formato, "erro", err)
+		c.Abort()
+	}
+}
+
+// moedaConsulta lê ?moeda= (vazio = sem conversão)
+func moedaConsulta(c *gin.Context) (dinheiro.Moeda, bool) {
+	texto := c.Query("moeda")
+	if texto == "" {
+		return "", true
+	}
+
+	moeda, err := dinheiro.ParseMoeda(texto)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return "", false
+	}
+	return moeda, true
+}
+
+// aplicarReservas preenche reservado e disponivel em resp (mesma ordem de
+// itens), com as reservas vigentes agora
+func (h *ItemHandler) aplicarReservas(c *gin.Context, itens []entity.Item, resp []dto.ItemResponse) bool {
+	ids := make([]int, 0, len(itens))
+	for _, it := range itens {
+		ids = append(ids, it.ID)
+	}
+
+	reservados, err := h.reservas.Reservados(c.Request.Context(), ids)
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao somar reservas", "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return false
+	}
+
+	for i, it := range itens {
+		resp[i].AplicarReservas(reservados[it.ID])
+	}
+	return true
+}
+
+// aplicarPromocoes preenche preco_efetivo e promocoes em resp (mesma ordem
+// de itens), com as promoções vigentes agora
+func (h *ItemHandler) aplicarPromocoes(c *gin.Context, itens []entity.Item, resp []dto.ItemResponse) bool {
+	resultados, err := h.promocoes.Aplicar(c.Request.Context(), itens)
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao aplicar promoções", "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return false
+	}
+
+	for i, resultado := range resultados {
+		resp[i].AplicarPromocao(resultado)
+	}
+	return true
+}
+
+// aplicarImagens preenche imagens em resp (mesma ordem de itens), com uma
+// consulta só para a página inteira
+func (h *ItemHandler) aplicarImagens(c *gin.Context, itens []entity.Item, resp []dto.ItemResponse) bool {
+	ids := make([]int, 0, len(itens))
+	for _, it := range itens {
+		ids = append(ids, it.ID)
+	}
+
+	porItem, err := h.imagens.PorItens(c.Request.Context(), ids)
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao buscar imagens", "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return false
+	}
+
+	for i, it := range itens {
+		if imagens := porItem[it.ID]; len(imagens) > 0 {
+			resp[i].Imagens = dto.FromImagens(imagens)
+		}
+	}
+	return true
+}
+
+// converterPrecos preenche preco_convertido em resp (mesma ordem de itens).
+// Sem taxa cadastrada para alguma moeda responde 422.
+func (h *ItemHandler) converterPrecos(c *gin.Context, moeda dinheiro.Moeda, itens []entity.Item, resp []dto.ItemResponse) bool {
+	if moeda == "" {
+		return true
+	}
+
+	conversoes, err := h.cambio.ConverterPrecos(c.Request.Context(), itens, moeda)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, cambio.ErrTaxaNaoEncontrada) {
+			status = http.StatusUnprocessableEntity
+		} else {
+			h.logger.ErrorContext(c.Request.Context(), "erro ao converter preços", "moeda", moeda, "erro", err)
+		}
+		c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+		return false
+	}
+
+	for i, conversao := range conversoes {
+		resp[i].PrecoConvertido = dto.FromConversao(conversao)
+	}
+	return true
+}
+
+// buscarItem lê o :id da rota e busca o item; responde 400/404 sozinho
+func buscarItem(c *gin.Context, itens services.ItemService) (*entity.Item, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID inválido"})
+		return nil, false
+	}
+
+	item, err := itens.GetItem(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ResponseInfo{Error: true, Result: err.Error()})
+		return nil, false
+	}
+	return item, true
+}
+
+// buscarItemEditavel aplica a mesma regra do PUT /itens/:id: só admin ou o
+// criador do item
+func buscarItemEditavel(c *gin.Context, itens services.ItemService, userID int, admin bool) (*entity.Item, bool) {
+	item, ok := buscarItem(c, itens)
+	if !ok {
+		return nil, false
+	}
+	if !item.PodeSerEditadoPor(userID, admin) {
+		c.JSON(http.StatusForbidden, ResponseInfo{Error: true, Result: "Você só pode editar itens que criou"})
+		return nil, false
+	}
+	return item, true
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/lote"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+)
+
+type LoteHandler struct {
+	service services.LoteService
+	logger  *slog.Logger
+}
+
+func NewLoteHandler(service services.LoteService, logger *slog.Logger) *LoteHandler {
+	return &LoteHandler{service: service, logger: logger}
+}
+
+// AtualizarItens aplica a mesma alteração do PUT /itens/:id a vários itens,
+// escolhidos por "ids" ou por "filtro" ({"status", "busca"})
+func (h *LoteHandler) AtualizarItens(c *gin.Context) {
+	// PASSO 1: EXTRAIR usuário do context
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.AtualizarLoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	selecao, err := req.ToSelecao()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	opcoes.Modo = dto.ModoOuPadrao(req.Modo)
+	if !validarLote(c, selecao, opcoes) {
+		return
+	}
+	alteracao, err := req.Alteracoes.ToAlteracao()
+	if err == nil {
+		err = alteracao.Validar()
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: CHAMAR Service
+	relatorio, err := h.service.AtualizarItens(c.Request.Context(), selecao, alteracao, opcoes)
+	h.responderLote(c, relatorio, err)
+}
+
+// RemoverItens apaga vários itens de uma vez (só admin)
+func (h *LoteHandler) RemoverItens(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RemoverLoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	selecao, err := req.ToSelecao()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	opcoes.Modo = dto.ModoOuPadrao(req.Modo)
+	if !validarLote(c, selecao, opcoes) {
+		return
+	}
+
+	relatorio, err := h.service.RemoverItens(c.Request.Context(), selecao, opcoes)
+	h.responderLote(c, relatorio, err)
+}
+
+func opcoesLote(c *gin.Context) (lote.Opcoes, bool) {
+	userID, ok := c.Get("userID")
+	userIDInt, okInt := userID.(int)
+	if !ok || !okInt {
+		c.JSON(http.StatusUnauthorized, ResponseInfo{
+			Error:  true,
+			Result: "Usuário não autenticado",
+		})
+		return lote.Opcoes{}, false
+	}
+	role, _ := c.Get("userRole")
+
+	return lote.Opcoes{UsuarioID: userIDInt, Admin: role == "admin"}, true
+}
+
+// validarLote recusa o pedido antes de tocar qualquer item
+func validarLote(c *gin.Context, selecao lote.Selecao, opcoes lote.Opcoes) bool {
+	err := selecao.Validar()
+	if err == nil && !opcoes.Modo.Valido() {
+		err = fmt.Errorf("modo deve ser '%s' ou '%s'", lote.ModoTudoOuNada, lote.ModoMelhorEsforco)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return false
+	}
+	return true
+}
+
+// responderLote: 200 com o relatório; 422 quando o lote tudo_ou_nada foi
+// desfeito (o relatório diz qual item falhou)
+func (h *LoteHandler) responderLote(c *gin.Context, relatorio lote.Relatorio, err error) {
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, lote.ErrMuitosItens) {
+			status = http.StatusBadRequest // Filtro amplo demais
+		} else {
+			h.logger.ErrorContext(c.Request.Context(), "erro ao processar lote", "erro", err)
+		}
+		c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := dto.FromRelatorioLote(relatorio)
+	if relatorio.Modo == lote.ModoTudoOuNada && relatorio.Erros > 0 {
+		c.JSON(http.StatusUnprocessableEntity, ResponseInfo{Error: true, Result: resp})
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
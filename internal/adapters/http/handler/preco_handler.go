@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/preco"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type PrecoHandler struct {
+	service services.PrecoService
+	itens   services.ItemService
+	logger  *slog.Logger
+}
+
+func NewPrecoHandler(service services.PrecoService, itens services.ItemService, logger *slog.Logger) *PrecoHandler {
+	return &PrecoHandler{service: service, itens: itens, logger: logger}
+}
+
+// ListarPrecos devolve o histórico e os agendamentos do item
+func (h *PrecoHandler) ListarPrecos(c *gin.Context) {
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+
+	historico, err := h.service.Historico(c.Request.Context(), item.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	agendados, err := h.service.Agendamentos(c.Request.Context(), item.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPrecosItem(item.ID, historico, agendados)})
+}
+
+// AgendarPreco agenda um preço futuro, com a mesma regra do PUT /itens/:id:
+// só admin ou o criador do item
+func (h *PrecoHandler) AgendarPreco(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.AgendarPrecoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: BUSCAR item e VERIFICAR AUTORIZAÇÃO
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	agendamento, err := req.ToAgendamento(item.ID, item.Preco.Moeda, opcoes.UsuarioID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 4: CHAMAR Service
+	agendamento, err = h.service.Agendar(c.Request.Context(), agendamento)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromAgendamentoPreco(agendamento)})
+}
+
+func (h *PrecoHandler) CancelarAgendamento(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	agendamentoID, err := strconv.Atoi(c.Param("agendamento"))
+	if err != nil || agendamentoID <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID do agendamento inválido"})
+		return
+	}
+
+	item, ok := buscarItemEditavel(c, h.itens, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CancelarAgendamento(c.Request.Context(), item.ID, agendamentoID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, preco.ErrAgendamentoNaoEncontrado) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{Result: "Agendamento cancelado"})
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/promocao"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type PromocaoHandler struct {
+	service services.PromocaoService
+	logger  *slog.Logger
+}
+
+func NewPromocaoHandler(service services.PromocaoService, logger *slog.Logger) *PromocaoHandler {
+	return &PromocaoHandler{service: service, logger: logger}
+}
+
+func (h *PromocaoHandler) Listar(c *gin.Context) {
+	promocoes, err := h.service.Listar(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.PromocaoResponse, 0, len(promocoes))
+	for _, p := range promocoes {
+		resp = append(resp, dto.FromPromocao(p))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+func (h *PromocaoHandler) Buscar(c *gin.Context) {
+	id, ok := idPromocao(c)
+	if !ok {
+		return
+	}
+
+	p, err := h.service.Buscar(c.Request.Context(), id)
+	if err != nil {
+		responderErroPromocao(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPromocao(*p)})
+}
+
+func (h *PromocaoHandler) Criar(c *gin.Context) {
+	// PASSO 1: RECEBER e VALIDAR JSON
+	var req dto.PromocaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	userID, _ := c.Get("userID")
+	userIDInt, _ := userID.(int) // Auditoria
+	p, err := req.ToEntity(0, userIDInt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 2: CHAMAR Service
+	p, err = h.service.Criar(c.Request.Context(), p)
+	if err != nil {
+		responderErroPromocao(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromPromocao(p)})
+}
+
+// Atualizar substitui a promoção inteira (mesmo corpo do POST)
+func (h *PromocaoHandler) Atualizar(c *gin.Context) {
+	id, ok := idPromocao(c)
+	if !ok {
+		return
+	}
+
+	var req dto.PromocaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	p, err := req.ToEntity(id, 0)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	p, err = h.service.Atualizar(c.Request.Context(), p)
+	if err != nil {
+		responderErroPromocao(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPromocao(p)})
+}
+
+func (h *PromocaoHandler) Remover(c *gin.Context) {
+	id, ok := idPromocao(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Remover(c.Request.Context(), id); err != nil {
+		responderErroPromocao(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: "Promoção removida"})
+}
+
+func idPromocao(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID inválido"})
+		return 0, false
+	}
+	return id, true
+}
+
+func responderErroPromocao(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, promocao.ErrPromocaoNaoEncontrada) {
+		status = http.StatusNotFound
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
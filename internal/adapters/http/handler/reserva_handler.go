@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/reserva"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type ReservaHandler struct {
+	service services.ReservaService
+	itens   services.ItemService
+	logger  *slog.Logger
+}
+
+func NewReservaHandler(service services.ReservaService, itens services.ItemService, logger *slog.Logger) *ReservaHandler {
+	return &ReservaHandler{service: service, itens: itens, logger: logger}
+}
+
+// Reservar segura estoque do item enquanto o cliente paga. Qualquer
+// usuário reserva qualquer item; só quem reservou (ou admin) confirma ou
+// cancela.
+func (h *ReservaHandler) Reservar(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.ReservaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: BUSCAR item
+	item, ok := buscarItem(c, h.itens)
+	if !ok {
+		return
+	}
+
+	r, err := req.ToEntity(item.ID, opcoes.UsuarioID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 4: CHAMAR Service
+	r, err = h.service.Reservar(c.Request.Context(), r)
+	if err != nil {
+		responderErroReserva(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromReserva(r)})
+}
+
+func (h *ReservaHandler) Buscar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	r, ok := h.buscarReservaPropria(c, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromReserva(*r)})
+}
+
+// Confirmar baixa a quantidade do estoque do item
+func (h *ReservaHandler) Confirmar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	r, ok := h.buscarReservaPropria(c, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	confirmada, err := h.service.Confirmar(c.Request.Context(), r.ID, opcoes.UsuarioID)
+	if err != nil {
+		responderErroReserva(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromReserva(confirmada)})
+}
+
+func (h *ReservaHandler) Cancelar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	r, ok := h.buscarReservaPropria(c, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	cancelada, err := h.service.Cancelar(c.Request.Context(), r.ID)
+	if err != nil {
+		responderErroReserva(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromReserva(cancelada)})
+}
+
+// buscarReservaPropria: reserva de outro usuário responde 404, para não
+// revelar que ela existe
+func (h *ReservaHandler) buscarReservaPropria(c *gin.Context, userID int, admin bool) (*reserva.Reserva, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID inválido"})
+		return nil, false
+	}
+
+	r, err := h.service.Buscar(c.Request.Context(), id)
+	if err == nil && !r.PodeSerAlteradaPor(userID, admin) {
+		err = reserva.ErrReservaNaoEncontrada
+	}
+	if err != nil {
+		responderErroReserva(c, err)
+		return nil, false
+	}
+	return r, true
+}
+
+func responderErroReserva(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, reserva.ErrReservaNaoEncontrada):
+		status = http.StatusNotFound
+	case errors.Is(err, reserva.ErrEstoqueIndisponivel), errors.Is(err, reserva.ErrReservaEncerrada):
+		status = http.StatusConflict
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
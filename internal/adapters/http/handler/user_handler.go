@@ -4,7 +4,10 @@ import (
 	"desafio-itens-app/internal/adapters/http/auth"
 	"desafio-itens-app/internal/adapters/http/dto"
 	"desafio-itens-app/internal/application/ports/services"
+	userDomain "desafio-itens-app/internal/domain/user"
+	"errors"
 	"github.com/gin-gonic/gin"
+	"log/slog"
 	"net/http"
 	"strconv"
 )
@@ -12,13 +15,15 @@ import (
 type UserHandler struct {
 	service    services.UserService // ← Dependência: UserService
 	jwtService *auth.JWTService
+	logger     *slog.Logger
 }
 
 // NewUserHandler - Factory function (cria instância do handler)
-func NewUserHandler(service services.UserService, jwtService *auth.JWTService) *UserHandler {
+func NewUserHandler(service services.UserService, jwtService *auth.JWTService, logger *slog.Logger) *UserHandler {
 	return &UserHandler{
 		service:    service,
 		jwtService: jwtService, // ← Injetar dependência
+		logger:     logger,
 	}
 }
 
@@ -37,9 +42,9 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	user := req.ToEntity()
 
 	//PASSO 3: CHAMAR Service (toda lógica está lá)
-	createdUser, err := h.service.CreateUser(user)
+	createdUser, err := h.service.CreateUser(c.Request.Context(), user)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ResponseInfo{
+		c.JSON(statusErroGravacaoUsuario(err), ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
 		})
@@ -65,7 +70,7 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 		return
 	}
 
-	user, err := h.service.GetUser(id)
+	user, err := h.service.GetUser(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, ResponseInfo{
 			Error:  true,
@@ -89,6 +94,7 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 	// PASSO 2: Chamar service
 	result, err := h.service.ListUsers(c.Request.Context(), page, limit)
 	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao listar usuários", "erro", err)
 		c.JSON(http.StatusInternalServerError, ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
@@ -113,7 +119,7 @@ func (h *UserHandler) GetUserByUsername(c *gin.Context) {
 		return
 	}
 
-	user, err := h.service.GetUserByUsername(username)
+	user, err := h.service.GetUserByUsername(c.Request.Context(), username)
 	if err != nil {
 		c.JSON(http.StatusNotFound, ResponseInfo{
 			Error:  true,
@@ -148,7 +154,7 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	existingUser, err := h.service.GetUser(id)
+	existingUser, err := h.service.GetUser(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, ResponseInfo{
 			Error:  true,
@@ -160,9 +166,9 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 	updateUser := *existingUser
 	req.ApplyTo(&updateUser)
 
-	err = h.service.UpdateUser(updateUser)
+	err = h.service.UpdateUser(c.Request.Context(), updateUser)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ResponseInfo{
+		c.JSON(statusErroGravacaoUsuario(err), ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
 		})
@@ -186,7 +192,7 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 		return
 	}
 
-	err = h.service.DeleteUser(id)
+	err = h.service.DeleteUser(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, ResponseInfo{
 			Error:  true,
@@ -215,16 +221,16 @@ func (h *UserHandler) Register(c *gin.Context) {
 
 	user := req.ToEntity()
 
-	createdUser, err := h.service.CreateUser(user)
+	createdUser, err := h.service.CreateUser(c.Request.Context(), user)
 	if err != nil {
-		if err.Error() == "username já está em uso" {
-			c.JSON(http.StatusBadRequest, ResponseInfo{
+		if errors.Is(err, userDomain.ErrUsernameEmUso) {
+			c.JSON(http.StatusConflict, ResponseInfo{
 				Error:  true,
 				Result: "username já existe",
 			})
 			return
 		}
-		c.JSON(http.StatusBadRequest, ResponseInfo{
+		c.JSON(statusErroGravacaoUsuario(err), ResponseInfo{
 			Error:  true,
 			Result: err.Error(),
 		})
@@ -249,7 +255,7 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
-	user, err := h.service.ValidateCredentials(req.Username, req.Password)
+	user, err := h.service.ValidateCredentials(c.Request.Context(), req.Username, req.Password)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, ResponseInfo{
 			Error:  true,
@@ -260,6 +266,7 @@ func (h *UserHandler) Login(c *gin.Context) {
 
 	token, err := h.jwtService.GenerateToken(user.ID, user.Username, string(user.Role))
 	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao gerar token", "user_id", user.ID, "erro", err)
 		c.JSON(http.StatusInternalServerError, ResponseInfo{
 			Error:  true,
 			Result: "erro ao gerar o token",
@@ -291,7 +298,7 @@ func (h *UserHandler) ValidateCredentials(c *gin.Context) {
 		return
 	}
 
-	user, err := h.service.ValidateCredentials(req.Username, req.Password)
+	user, err := h.service.ValidateCredentials(c.Request.Context(), req.Username, req.Password)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, ResponseInfo{
 			Error:  true,
@@ -302,6 +309,7 @@ func (h *UserHandler) ValidateCredentials(c *gin.Context) {
 
 	token, err := h.jwtService.GenerateToken(user.ID, user.Username, string(user.Role))
 	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao gerar token", "user_id", user.ID, "erro", err)
 		c.JSON(http.StatusInternalServerError, ResponseInfo{
 			Error:  true,
 			Result: "erro ao gerar o token",
@@ -320,3 +328,12 @@ func (h *UserHandler) ValidateCredentials(c *gin.Context) {
 		Result: LoginResponse,
 	})
 }
+
+// statusErroGravacaoUsuario: username ou email de outro usuário é conflito
+// (409); o resto do que o service recusa é 400
+func statusErroGravacaoUsuario(err error) int {
+	if errors.Is(err, userDomain.ErrUsernameEmUso) || errors.Is(err, userDomain.ErrEmailEmUso) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	entity "desafio-itens-app/internal/domain/item"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// ListarProdutos pagina os itens de topo com as variantes aninhadas em
+// "variantes"; variantes não aparecem soltas
+func (h *ItemHandler) ListarProdutos(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	produtos, totalItens, err := h.variantes.ListarProdutos(c.Request.Context(), page, pageSize)
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "erro ao listar produtos", "erro", err)
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// 🔄 Reservas e promoções numa passada só: produtos e variantes lado a lado
+	var itens []entity.Item
+	for _, p := range produtos {
+		itens = append(itens, p.Item)
+		itens = append(itens, p.Variantes...)
+	}
+	planos := make([]dto.ItemResponse, 0, len(itens))
+	for _, it := range itens {
+		planos = append(planos, dto.FromEntity(it))
+	}
+	if !h.aplicarReservas(c, itens, planos) || !h.aplicarPromocoes(c, itens, planos) || !h.aplicarImagens(c, itens, planos) {
+		return
+	}
+
+	resp := make([]dto.ItemResponse, 0, len(produtos))
+	i := 0
+	for _, p := range produtos {
+		produto := planos[i]
+		produto.Variantes = planos[i+1 : i+1+len(p.Variantes)]
+		resp = append(resp, produto)
+		i += 1 + len(p.Variantes)
+	}
+
+	c.JSON(http.StatusOK, ResponseInfo{
+		TotalItens: totalItens,
+		TotalPages: (totalItens + pageSize - 1) / pageSize,
+		Data:       resp,
+	})
+}
+
+// ListarVariantes lista as variantes do produto, na ordem de criação
+func (h *ItemHandler) ListarVariantes(c *gin.Context) {
+	produto, ok := buscarItem(c, h.service)
+	if !ok {
+		return
+	}
+
+	variantes, err := h.variantes.Variantes(c.Request.Context(), produto.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.ItemResponse, 0, len(variantes))
+	for _, v := range variantes {
+		resp = append(resp, dto.FromEntity(v))
+	}
+	if !h.aplicarReservas(c, variantes, resp) || !h.aplicarPromocoes(c, variantes, resp) || !h.aplicarImagens(c, variantes, resp) {
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: resp})
+}
+
+// DefinirEixos troca os eixos de variação do produto (mesma regra do PUT)
+func (h *ItemHandler) DefinirEixos(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.EixosRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: BUSCAR item e VERIFICAR AUTORIZAÇÃO
+	produto, ok := buscarItemEditavel(c, h.service, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	// PASSO 4: CHAMAR Service
+	atualizado, err := h.variantes.DefinirEixos(c.Request.Context(), *produto, req.ToEntity(), opcoes.UsuarioID)
+	if err != nil {
+		responderErroVariante(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromEntity(atualizado)})
+}
+
+// CriarVariante cria uma combinação de eixos do produto, com código
+// derivado do código do produto
+func (h *ItemHandler) CriarVariante(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.VarianteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+	preco, err := req.PrecoProprio()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: BUSCAR produto e VERIFICAR AUTORIZAÇÃO
+	produto, ok := buscarItemEditavel(c, h.service, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	// PASSO 4: CHAMAR Service
+	variante, err := h.variantes.CriarVariante(c.Request.Context(), *produto, req.Opcoes, preco, req.Estoque, opcoes.UsuarioID)
+	if err != nil {
+		responderErroVariante(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromEntity(variante)})
+}
+
+// GerarVariantes cria sem estoque todas as combinações que faltam
+func (h *ItemHandler) GerarVariantes(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+	produto, ok := buscarItemEditavel(c, h.service, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	criadas, err := h.variantes.GerarVariantes(c.Request.Context(), *produto, opcoes.UsuarioID)
+	if err != nil {
+		responderErroVariante(c, err)
+		return
+	}
+
+	resp := make([]dto.ItemResponse, 0, len(criadas))
+	for _, v := range criadas {
+		resp = append(resp, dto.FromEntity(v))
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: resp})
+}
+
+func responderErroVariante(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrVarianteDuplicada), errors.Is(err, entity.ErrCodeDuplicado):
+		status = http.StatusConflict
+	case errors.Is(err, entity.ErrProdutoInvalido), errors.Is(err, entity.ErrVarianteInvalida):
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/venda"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type VendaHandler struct {
+	service services.VendaService
+	logger  *slog.Logger
+}
+
+func NewVendaHandler(service services.VendaService, logger *slog.Logger) *VendaHandler {
+	return &VendaHandler{service: service, logger: logger}
+}
+
+// Criar grava o pedido como pendente; o estoque só baixa na confirmação
+func (h *VendaHandler) Criar(c *gin.Context) {
+	// PASSO 1: QUEM está pedindo
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	// PASSO 2: RECEBER e VALIDAR JSON
+	var req dto.PedidoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	// PASSO 3: CHAMAR Service
+	p, err := h.service.Criar(c.Request.Context(), opcoes.UsuarioID, req.ToLinhas())
+	if err != nil {
+		responderErroVenda(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ResponseInfo{Result: dto.FromPedido(p)})
+}
+
+// Listar traz só os pedidos de quem está logado, paginados como GET /itens
+func (h *VendaHandler) Listar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Limite máximo
+	}
+
+	pedidos, total, err := h.service.ListarDoUsuario(c.Request.Context(), opcoes.UsuarioID, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ResponseInfo{Error: true, Result: err.Error()})
+		return
+	}
+
+	resp := make([]dto.PedidoResponse, 0, len(pedidos))
+	for _, p := range pedidos {
+		resp = append(resp, dto.FromPedido(p))
+	}
+	c.JSON(http.StatusOK, ResponseInfo{
+		TotalItens: total,
+		TotalPages: (total + pageSize - 1) / pageSize,
+		Data:       resp,
+	})
+}
+
+func (h *VendaHandler) Buscar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	p, ok := h.buscarPedidoProprio(c, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedido(*p)})
+}
+
+// Confirmar baixa o estoque de todas as linhas
+func (h *VendaHandler) Confirmar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	p, ok := h.buscarPedidoProprio(c, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	confirmado, err := h.service.Confirmar(c.Request.Context(), p.ID, opcoes.UsuarioID)
+	if err != nil {
+		responderErroVenda(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedido(confirmado)})
+}
+
+// Cancelar devolve o estoque se o pedido já estava confirmado
+func (h *VendaHandler) Cancelar(c *gin.Context) {
+	opcoes, ok := opcoesLote(c)
+	if !ok {
+		return
+	}
+
+	p, ok := h.buscarPedidoProprio(c, opcoes.UsuarioID, opcoes.Admin)
+	if !ok {
+		return
+	}
+
+	cancelado, err := h.service.Cancelar(c.Request.Context(), p.ID, opcoes.UsuarioID)
+	if err != nil {
+		responderErroVenda(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResponseInfo{Result: dto.FromPedido(cancelado)})
+}
+
+// buscarPedidoProprio: pedido de outro usuário responde 404, como nas
+// reservas
+func (h *VendaHandler) buscarPedidoProprio(c *gin.Context, userID int, admin bool) (*venda.Pedido, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ResponseInfo{Error: true, Result: "ID inválido"})
+		return nil, false
+	}
+
+	p, err := h.service.Buscar(c.Request.Context(), id)
+	if err == nil && !p.PodeSerVistoPor(userID, admin) {
+		err = venda.ErrPedidoNaoEncontrado
+	}
+	if err != nil {
+		responderErroVenda(c, err)
+		return nil, false
+	}
+	return p, true
+}
+
+func responderErroVenda(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, venda.ErrPedidoNaoEncontrado):
+		status = http.StatusNotFound
+	case errors.Is(err, venda.ErrPedidoInvalido):
+		status = http.StatusBadRequest
+	case errors.Is(err, venda.ErrTransicaoInvalida), errors.Is(err, venda.ErrEstoqueInsuficiente), errors.Is(err, venda.ErrItemIndisponivel):
+		status = http.StatusConflict
+	}
+	c.JSON(status, ResponseInfo{Error: true, Result: err.Error()})
+}
@@ -0,0 +1,35 @@
+package middlewares
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gin-gonic/gin"
+)
+
+const HeaderAPIKey = "X-API-Key"
+
+// APIKeys reconhece as API keys configuradas (RATE_LIMIT_API_KEYS): só uma
+// key conhecida vira a identidade do cliente no rate limit e na
+// idempotência. Qualquer outro valor no header é ignorado, senão bastaria
+// trocar a key a cada requisição para ganhar uma cota nova.
+func APIKeys(chaves []string) gin.HandlerFunc {
+	conhecidas := make(map[string]bool, len(chaves))
+	for _, chave := range chaves {
+		conhecidas[hashAPIKey(chave)] = true
+	}
+
+	return func(c *gin.Context) {
+		if apiKey := c.GetHeader(HeaderAPIKey); apiKey != "" {
+			if hash := hashAPIKey(apiKey); conhecidas[hash] {
+				c.Set("apiKey", hash)
+			}
+		}
+		c.Next()
+	}
+}
+
+// hashAPIKey: a key entra como hash para não ficar exposta no store
+func hashAPIKey(apiKey string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(hash[:8])
+}
@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"desafio-itens-app/internal/application/ports/repositories"
+	"github.com/gin-gonic/gin"
+	"strings"
+)
+
+// HeaderReadYourWrites pede que as leituras da requisição usem o banco
+// primário, útil logo após uma escrita quando as réplicas ainda não
+// receberam a mudança.
+const HeaderReadYourWrites = "X-Read-Your-Writes"
+
+func ReadYourWrites() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if strings.EqualFold(c.GetHeader(HeaderReadYourWrites), "true") {
+			ctx := repositories.ComLeituraNoPrimario(c.Request.Context())
+			c.Request = c.Request.WithContext(ctx)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,181 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"desafio-itens-app/internal/adapters/http/handler"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/idempotencia"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	tamanhoMaximoChave = 255
+	tamanhoMaximoCorpo = 1 << 20 // 1 MB, para rotas sem teto próprio (ver LimitarCorpo)
+)
+
+type Idempotencia struct {
+	repo    repositories.IdempotenciaRepository
+	ttl     time.Duration
+	limites map[string]int64 // Rota (c.FullPath) → teto do corpo
+	logger  *slog.Logger
+}
+
+func NewIdempotencia(repo repositories.IdempotenciaRepository, ttl time.Duration, logger *slog.Logger) *Idempotencia {
+	return &Idempotencia{repo: repo, ttl: ttl, limites: make(map[string]int64), logger: logger}
+}
+
+// LimitarCorpo troca o teto de 1 MB do corpo lido para a impressão digital
+// numa rota que aceita mais (upload, importação). Use o mesmo teto do
+// handler; chame antes de servir requisições.
+func (m *Idempotencia) LimitarCorpo(rota string, limite int64) {
+	m.limites[rota] = limite
+}
+
+// Middleware honra o header Idempotency-Key em POST/PUT: a primeira resposta
+// fica guardada e as repetições com a mesma chave recebem a mesma resposta,
+// sem executar o handler de novo. Sem o header, nada muda. Em rotas
+// autenticadas deve vir depois do RequireAuth (a chave vale por usuário).
+func (m *Idempotencia) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chaveCliente := c.GetHeader(HeaderIdempotencyKey)
+		metodo := c.Request.Method
+		if chaveCliente == "" || (metodo != http.MethodPost && metodo != http.MethodPut) {
+			c.Next()
+			return
+		}
+
+		if len(chaveCliente) > tamanhoMaximoChave {
+			c.JSON(http.StatusBadRequest, handler.ResponseInfo{
+				Error:  true,
+				Result: "Idempotency-Key deve ter no máximo 255 caracteres",
+			})
+			c.Abort()
+			return
+		}
+
+		// PASSO 1: IMPRESSÃO DIGITAL da requisição (método + rota + query + corpo)
+		limite, ok := m.limites[c.FullPath()]
+		if !ok {
+			limite = tamanhoMaximoCorpo
+		}
+		corpo, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, limite))
+		var maxBytes *http.MaxBytesError
+		if errors.As(err, &maxBytes) {
+			c.JSON(http.StatusRequestEntityTooLarge, handler.ResponseInfo{
+				Error:  true,
+				Result: fmt.Sprintf("Corpo da requisição maior que %d bytes", limite),
+			})
+			c.Abort()
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, handler.ResponseInfo{
+				Error:  true,
+				Result: "Não foi possível ler o corpo da requisição",
+			})
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(corpo))
+
+		agora := time.Now()
+		registro := idempotencia.Registro{
+			Chave:       idempotencia.Hash([]byte(identificarCliente(c)), []byte(chaveCliente)),
+			Fingerprint: idempotencia.Hash([]byte(metodo), []byte(c.Request.URL.Path), []byte(c.Request.URL.RawQuery), corpo),
+			CriadoEm:    agora,
+			ExpiraEm:    agora.Add(m.ttl),
+		}
+
+		// PASSO 2: RESERVAR a chave (ou descobrir que já foi usada)
+		ctx := c.Request.Context()
+		existente, err := m.repo.Reservar(ctx, registro)
+		if err != nil {
+			// Sem o store não dá para garantir nada: melhor recusar que duplicar
+			m.logger.ErrorContext(ctx, "falha ao reservar chave de idempotência", "erro", err)
+			c.JSON(http.StatusServiceUnavailable, handler.ResponseInfo{
+				Error:  true,
+				Result: "Não foi possível processar a Idempotency-Key, tente novamente",
+			})
+			c.Abort()
+			return
+		}
+
+		if existente != nil {
+			m.responderExistente(c, *existente, registro.Fingerprint)
+			return
+		}
+
+		// PASSO 3: EXECUTAR o handler guardando a resposta
+		gravador := &gravadorResposta{ResponseWriter: c.Writer}
+		c.Writer = gravador
+
+		concluido := false
+		defer func() {
+			// Panic ou erro 5xx: libera a chave para o cliente poder repetir
+			if !concluido {
+				if err := m.repo.Liberar(context.WithoutCancel(ctx), registro.Chave); err != nil {
+					m.logger.WarnContext(ctx, "falha ao liberar chave de idempotência", "erro", err)
+				}
+			}
+		}()
+
+		c.Next()
+
+		status := gravador.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+
+		contentType := gravador.Header().Get("Content-Type")
+		if err := m.repo.Concluir(context.WithoutCancel(ctx), registro.Chave, status, contentType, gravador.corpo.Bytes()); err != nil {
+			m.logger.WarnContext(ctx, "falha ao salvar resposta idempotente", "erro", err)
+			return
+		}
+		concluido = true
+	}
+}
+
+func (m *Idempotencia) responderExistente(c *gin.Context, existente idempotencia.Registro, fingerprint string) {
+	switch {
+	case existente.Fingerprint != fingerprint:
+		c.JSON(http.StatusUnprocessableEntity, handler.ResponseInfo{
+			Error:  true,
+			Result: "Idempotency-Key já usada com outra requisição",
+		})
+	case !existente.Concluido:
+		c.JSON(http.StatusConflict, handler.ResponseInfo{
+			Error:  true,
+			Result: "Requisição com esta Idempotency-Key ainda está em processamento",
+		})
+	default:
+		c.Header(HeaderIdempotentReplayed, "true")
+		c.Data(existente.StatusCode, existente.ContentType, existente.Corpo)
+	}
+	c.Abort()
+}
+
+// gravadorResposta copia o corpo escrito pelo handler
+type gravadorResposta struct {
+	gin.ResponseWriter
+	corpo bytes.Buffer
+}
+
+func (g *gravadorResposta) Write(b []byte) (int, error) {
+	g.corpo.Write(b)
+	return g.ResponseWriter.Write(b)
+}
+
+func (g *gravadorResposta) WriteString(s string) (int, error) {
+	g.corpo.WriteString(s)
+	return g.ResponseWriter.WriteString(s)
+}
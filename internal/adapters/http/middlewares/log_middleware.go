@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"time"
+)
+
+var sondas = map[string]bool{"/healthz": true, "/readyz": true}
+
+// AccessLog registra uma linha por requisição (substitui o logger do gin).
+// Erros 5xx saem em Error, 4xx em Warn e o resto em Info. Sondas do
+// orquestrador bem-sucedidas (/healthz, /readyz) saem em Debug para não
+// inundar os logs.
+func AccessLog(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		inicio := time.Now()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		nivel := slog.LevelInfo
+		switch {
+		case status >= 500:
+			nivel = slog.LevelError
+		case status >= 400:
+			nivel = slog.LevelWarn
+		case sondas[c.FullPath()]:
+			nivel = slog.LevelDebug
+		}
+
+		attrs := []any{
+			slog.String("metodo", c.Request.Method),
+			slog.String("rota", c.FullPath()),
+			slog.String("caminho", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Duration("duracao", time.Since(inicio)),
+			slog.String("ip", c.ClientIP()),
+		}
+		if userID, ok := c.Get("userID"); ok {
+			attrs = append(attrs, slog.Any("user_id", userID))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("erros", c.Errors.String()))
+		}
+
+		logger.Log(c.Request.Context(), nivel, "requisição", attrs...)
+	}
+}
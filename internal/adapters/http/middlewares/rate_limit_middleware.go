@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"desafio-itens-app/internal/adapters/http/handler"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/ratelimit"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type RateLimiter struct {
+	repo   repositories.RateLimitRepository
+	logger *slog.Logger
+}
+
+func NewRateLimiter(repo repositories.RateLimitRepository, logger *slog.Logger) *RateLimiter {
+	return &RateLimiter{repo: repo, logger: logger}
+}
+
+// Limitar aplica a política ao grupo de rotas. Em rotas autenticadas deve
+// vir depois do RequireAuth para contar por usuário e não por IP.
+// Com o limiter nil (RATE_LIMIT_ENABLED=false) não limita nada.
+func (l *RateLimiter) Limitar(politica ratelimit.Politica) gin.HandlerFunc {
+	if l == nil {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		chave := politica.Nome + ":" + identificarCliente(c)
+
+		resultado, err := l.repo.Consumir(c.Request.Context(), chave, politica, time.Now())
+		if err != nil {
+			// Falha no store não derruba a API: deixa passar e avisa
+			l.logger.WarnContext(c.Request.Context(), "rate limit indisponível", "politica", politica.Nome, "erro", err)
+			c.Next()
+			return
+		}
+
+		// Headers do draft IETF "RateLimit header fields for HTTP"
+		c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", politica.Limite, int(politica.Janela.Seconds())))
+		c.Header("RateLimit-Limit", strconv.Itoa(resultado.Limite))
+		c.Header("RateLimit-Remaining", strconv.Itoa(resultado.Restante))
+		c.Header("RateLimit-Reset", strconv.Itoa(segundos(resultado.Reset)))
+
+		if !resultado.Permitido {
+			c.Header("Retry-After", strconv.Itoa(segundos(resultado.RetryAfter)))
+			c.JSON(http.StatusTooManyRequests, handler.ResponseInfo{
+				Error:  true,
+				Result: "Limite de requisições excedido, tente novamente mais tarde",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// identificarCliente escolhe de quem é a cota: usuário logado (setado pelo
+// RequireAuth), API key conhecida (setada pelo APIKeys) ou, por último, o
+// IP. O IP só vem de X-Forwarded-For quando o proxy está em TRUSTED_PROXIES.
+func identificarCliente(c *gin.Context) string {
+	if userID, ok := c.Get("userID"); ok {
+		return fmt.Sprintf("user:%v", userID)
+	}
+	if hash, ok := c.Get("apiKey"); ok {
+		return fmt.Sprintf("apikey:%v", hash)
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// segundos arredonda para cima: "Retry-After: 0" faria o cliente repetir na hora
+func segundos(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,38 @@
+package middlewares
+
+import (
+	"crypto/rand"
+	"desafio-itens-app/internal/adapters/logging"
+	"encoding/hex"
+	"github.com/gin-gonic/gin"
+	"regexp"
+)
+
+const HeaderRequestID = "X-Request-ID"
+
+// requestIDValido aceita IDs de proxies/clientes (UUID, hex, etc.) mas
+// recusa valores longos ou com caracteres que poluiriam os logs
+var requestIDValido = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID reaproveita o X-Request-ID recebido ou gera um novo, devolve no
+// header da resposta e coloca no contexto para os logs.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestID)
+		if !requestIDValido.MatchString(id) {
+			id = novoRequestID()
+		}
+
+		c.Set("requestID", id)
+		c.Header(HeaderRequestID, id)
+		c.Request = c.Request.WithContext(logging.ComRequestID(c.Request.Context(), id))
+
+		c.Next()
+	}
+}
+
+func novoRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,90 @@
+package logging
+
+import (
+	"context"
+	"desafio-itens-app/internal/config"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"log/slog"
+	"time"
+)
+
+// GormLogger envia os logs do GORM para o slog. SQL comum sai em Debug,
+// consultas acima de Lenta em Warn e falhas em Error. Com MostrarParametros
+// desligado os valores das queries (senhas, e-mails...) não aparecem: o SQL
+// é registrado com os placeholders.
+type GormLogger struct {
+	logger            *slog.Logger
+	Lenta             time.Duration
+	MostrarParametros bool
+}
+
+var _ gormlogger.Interface = (*GormLogger)(nil)
+
+func NewGormLogger(logger *slog.Logger, cfg config.Log) *GormLogger {
+	return &GormLogger{
+		logger:            logger.With("componente", "gorm"),
+		Lenta:             cfg.SlowQuery,
+		MostrarParametros: cfg.SQLParams,
+	}
+}
+
+// LogMode existe para satisfazer a interface; o nível vem do slog
+func (l *GormLogger) LogMode(gormlogger.LogLevel) gormlogger.Interface {
+	return l
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+}
+
+func (l *GormLogger) Trace(ctx context.Context, inicio time.Time, fc func() (string, int64), err error) {
+	duracao := time.Since(inicio)
+
+	var nivel slog.Level
+	var msg string
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		nivel, msg = slog.LevelError, "falha na query"
+	case l.Lenta > 0 && duracao > l.Lenta:
+		nivel, msg = slog.LevelWarn, "query lenta"
+	default:
+		nivel, msg = slog.LevelDebug, "query"
+	}
+
+	// Montar o SQL custa caro: só monta se o nível vai ser registrado
+	if !l.logger.Enabled(ctx, nivel) {
+		return
+	}
+
+	sql, linhas := fc()
+	attrs := []any{
+		slog.String("sql", sql),
+		slog.Int64("linhas", linhas),
+		slog.Duration("duracao", duracao),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("erro", err.Error()))
+	}
+
+	l.logger.Log(ctx, nivel, msg, attrs...)
+}
+
+// ParamsFilter é chamado pelo GORM antes de montar o SQL do log; devolver
+// params nil mantém os placeholders no lugar dos valores.
+func (l *GormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	if l.MostrarParametros {
+		return sql, params
+	}
+	return sql, nil
+}
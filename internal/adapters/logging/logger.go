@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"context"
+	"desafio-itens-app/internal/config"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+type chaveRequestID struct{}
+
+// ComRequestID guarda o ID da requisição no contexto; todo log feito com
+// esse contexto (InfoContext, ErrorContext...) sai com o campo request_id.
+func ComRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, chaveRequestID{}, id)
+}
+
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(chaveRequestID{}).(string)
+	return id
+}
+
+// New cria o logger da aplicação: JSON em produção, texto legível em dev
+func New(cfg config.Log, saida io.Writer) *slog.Logger {
+	opcoes := &slog.HandlerOptions{Level: nivel(cfg.Level)}
+
+	var handler slog.Handler
+	if cfg.Formato == "json" {
+		handler = slog.NewJSONHandler(saida, opcoes)
+	} else {
+		handler = slog.NewTextHandler(saida, opcoes)
+	}
+
+	return slog.New(contextHandler{handler})
+}
+
+func nivel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// contextHandler acrescenta o request_id e o trace_id/span_id do
+// contexto em cada registro, ligando a linha de log ao trace
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(nome string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(nome)}
+}
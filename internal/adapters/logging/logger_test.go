@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"desafio-itens-app/internal/config"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNew_WhenContextoTemRequestID_IncluiNoLog(t *testing.T) {
+	//ARRANGE
+	var saida bytes.Buffer
+	logger := New(config.Log{Level: "info", Formato: "json"}, &saida)
+	ctx := ComRequestID(context.Background(), "abc-123")
+
+	//ACT
+	logger.InfoContext(ctx, "teste")
+
+	//ASSERT
+	var registro map[string]any
+	assert.NoError(t, json.Unmarshal(saida.Bytes(), &registro))
+	assert.Equal(t, "abc-123", registro["request_id"])
+	assert.Equal(t, "teste", registro["msg"])
+}
+
+func TestNew_WhenNivelWarn_IgnoraInfo(t *testing.T) {
+	//ARRANGE
+	var saida bytes.Buffer
+	logger := New(config.Log{Level: "warn", Formato: "json"}, &saida)
+
+	//ACT
+	logger.Info("não deve aparecer")
+
+	//ASSERT
+	assert.Empty(t, saida.String())
+}
+
+func TestGormLogger_ParamsFilter_RedigeParametros(t *testing.T) {
+	//ARRANGE
+	l := &GormLogger{MostrarParametros: false}
+
+	//ACT
+	sql, params := l.ParamsFilter(context.Background(), "SELECT * FROM users WHERE email = ?", "a@b.com")
+
+	//ASSERT
+	assert.Equal(t, "SELECT * FROM users WHERE email = ?", sql)
+	assert.Nil(t, params)
+}
+
+func TestGormLogger_Trace_QueryLentaViraWarn(t *testing.T) {
+	//ARRANGE
+	var saida bytes.Buffer
+	logger := New(config.Log{Level: "info", Formato: "json"}, &saida)
+	l := NewGormLogger(logger, config.Log{SlowQuery: 10 * time.Millisecond})
+
+	//ACT
+	l.Trace(context.Background(), time.Now().Add(-time.Second), func() (string, int64) {
+		return "SELECT 1", 1
+	}, nil)
+
+	//ASSERT
+	var registro map[string]any
+	assert.NoError(t, json.Unmarshal(saida.Bytes(), &registro))
+	assert.Equal(t, "WARN", registro["level"])
+	assert.Equal(t, "query lenta", registro["msg"])
+}
+
+func TestGormLogger_Trace_QueryRapidaNaoApareceEmInfo(t *testing.T) {
+	//ARRANGE
+	var saida bytes.Buffer
+	logger := New(config.Log{Level: "info", Formato: "json"}, &saida)
+	l := NewGormLogger(logger, config.Log{SlowQuery: time.Second})
+	montou := false
+
+	//ACT
+	l.Trace(context.Background(), time.Now(), func() (string, int64) {
+		montou = true
+		return "SELECT 1", 1
+	}, nil)
+
+	//ASSERT
+	assert.Empty(t, saida.String())
+	assert.False(t, montou)
+}
+
+func TestGormLogger_Trace_ErroViraError(t *testing.T) {
+	//ARRANGE
+	var saida bytes.Buffer
+	logger := New(config.Log{Level: "info", Formato: "json"}, &saida)
+	l := NewGormLogger(logger, config.Log{})
+
+	//ACT
+	l.Trace(context.Background(), time.Now(), func() (string, int64) {
+		return "INSERT INTO itens ...", 0
+	}, errors.New("duplicate entry"))
+
+	//ASSERT
+	var registro map[string]any
+	assert.NoError(t, json.Unmarshal(saida.Bytes(), &registro))
+	assert.Equal(t, "ERROR", registro["level"])
+	assert.Equal(t, "duplicate entry", registro["erro"])
+}
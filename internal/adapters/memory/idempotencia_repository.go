@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/idempotencia"
+	"sync"
+	"time"
+)
+
+// IdempotenciaRepository guarda as chaves na memória do processo; com mais
+// de uma réplica use o store do banco para os replays valerem entre elas.
+type IdempotenciaRepository struct {
+	mu        sync.Mutex
+	registros map[string]idempotencia.Registro
+}
+
+func NewIdempotenciaRepository() *IdempotenciaRepository {
+	return &IdempotenciaRepository{registros: make(map[string]idempotencia.Registro)}
+}
+
+func (r *IdempotenciaRepository) Reservar(_ context.Context, registro idempotencia.Registro) (*idempotencia.Registro, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existente, ok := r.registros[registro.Chave]; ok && !existente.Expirado(registro.CriadoEm) {
+		return &existente, nil
+	}
+
+	r.registros[registro.Chave] = registro
+	return nil, nil
+}
+
+func (r *IdempotenciaRepository) Concluir(_ context.Context, chave string, statusCode int, contentType string, corpo []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registro, ok := r.registros[chave]
+	if !ok {
+		return nil // expirou e foi limpo no meio do caminho
+	}
+	registro.Concluido = true
+	registro.StatusCode = statusCode
+	registro.ContentType = contentType
+	registro.Corpo = corpo
+	r.registros[chave] = registro
+	return nil
+}
+
+func (r *IdempotenciaRepository) Liberar(_ context.Context, chave string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.registros, chave)
+	return nil
+}
+
+func (r *IdempotenciaRepository) LimparExpirados(_ context.Context, agora time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removidos := 0
+	for chave, registro := range r.registros {
+		if registro.Expirado(agora) {
+			delete(r.registros, chave)
+			removidos++
+		}
+	}
+	return removidos, nil
+}
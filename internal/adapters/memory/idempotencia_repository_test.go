@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/idempotencia"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func novoRegistro(agora time.Time) idempotencia.Registro {
+	return idempotencia.Registro{
+		Chave:       "chave",
+		Fingerprint: "fp",
+		CriadoEm:    agora,
+		ExpiraEm:    agora.Add(time.Hour),
+	}
+}
+
+func TestIdempotenciaRepository_ReservarDevolveExistente(t *testing.T) {
+	//ARRANGE
+	repo := NewIdempotenciaRepository()
+	agora := time.Now()
+	existente, err := repo.Reservar(context.Background(), novoRegistro(agora))
+	assert.NoError(t, err)
+	assert.Nil(t, existente)
+	assert.NoError(t, repo.Concluir(context.Background(), "chave", 201, "application/json", []byte(`{"id":1}`)))
+
+	//ACT
+	existente, err = repo.Reservar(context.Background(), novoRegistro(agora.Add(time.Minute)))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.NotNil(t, existente)
+	assert.True(t, existente.Concluido)
+	assert.Equal(t, 201, existente.StatusCode)
+	assert.Equal(t, `{"id":1}`, string(existente.Corpo))
+}
+
+func TestIdempotenciaRepository_ReservarChaveExpirada(t *testing.T) {
+	//ARRANGE
+	repo := NewIdempotenciaRepository()
+	agora := time.Now()
+	_, _ = repo.Reservar(context.Background(), novoRegistro(agora))
+
+	//ACT
+	existente, err := repo.Reservar(context.Background(), novoRegistro(agora.Add(2*time.Hour)))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Nil(t, existente)
+}
+
+func TestIdempotenciaRepository_LiberarELimpar(t *testing.T) {
+	//ARRANGE
+	repo := NewIdempotenciaRepository()
+	agora := time.Now()
+	_, _ = repo.Reservar(context.Background(), novoRegistro(agora))
+
+	//ACT
+	assert.NoError(t, repo.Liberar(context.Background(), "chave"))
+	existente, _ := repo.Reservar(context.Background(), novoRegistro(agora))
+	removidos, err := repo.LimparExpirados(context.Background(), agora.Add(2*time.Hour))
+
+	//ASSERT
+	assert.Nil(t, existente)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removidos)
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/ratelimit"
+	"sync"
+	"time"
+)
+
+// intervaloVarredura: de quanto em quanto tempo o Consumir tira os baldes
+// parados, além do Limpar agendado pelo main
+const intervaloVarredura = time.Minute
+
+// RateLimitRepository guarda os baldes na memória do processo: cada
+// réplica da API tem sua própria cota. Para uma cota global use o store
+// do banco (RATE_LIMIT_STORE=db).
+type RateLimitRepository struct {
+	mu        sync.Mutex
+	baldes    map[string]baldeGuardado
+	varridoEm time.Time
+}
+
+// baldeGuardado lembra quando o balde enche se ficar parado: a partir daí
+// ele é igual a um balde novo e pode sair do mapa
+type baldeGuardado struct {
+	ratelimit.Balde
+	cheioEm time.Time
+}
+
+func NewRateLimitRepository() *RateLimitRepository {
+	return &RateLimitRepository{baldes: make(map[string]baldeGuardado)}
+}
+
+func (r *RateLimitRepository) Consumir(_ context.Context, chave string, politica ratelimit.Politica, agora time.Time) (ratelimit.Resultado, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 🧹 Sem isso, uma chave por IP nunca mais visto ocupa memória até o
+	// próximo Limpar; com muitos IPs o mapa cresceria sem limite
+	if agora.Sub(r.varridoEm) >= intervaloVarredura {
+		r.varrer(agora)
+	}
+
+	guardado, ok := r.baldes[chave]
+	if !ok {
+		guardado.Balde = politica.Cheio(agora)
+	}
+
+	balde, resultado := politica.Consumir(guardado.Balde, agora)
+	r.baldes[chave] = baldeGuardado{Balde: balde, cheioEm: agora.Add(politica.Janela)}
+	return resultado, nil
+}
+
+func (r *RateLimitRepository) Limpar(_ context.Context, antesDe time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removidos := 0
+	for chave, balde := range r.baldes {
+		if balde.AtualizadoEm.Before(antesDe) {
+			delete(r.baldes, chave)
+			removidos++
+		}
+	}
+	return removidos, nil
+}
+
+// varrer tira os baldes que já encheram parados; chamado com o mutex
+func (r *RateLimitRepository) varrer(agora time.Time) {
+	for chave, balde := range r.baldes {
+		if !agora.Before(balde.cheioEm) {
+			delete(r.baldes, chave)
+		}
+	}
+	r.varridoEm = agora
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRepository_ConsumoConcorrente(t *testing.T) {
+	//ARRANGE
+	repo := NewRateLimitRepository()
+	politica := ratelimit.Politica{Nome: "teste", Limite: 50, Janela: time.Hour}
+	agora := time.Now()
+
+	//ACT
+	var permitidos sync.Map
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := repo.Consumir(context.Background(), "user:1", politica, agora)
+			assert.NoError(t, err)
+			if r.Permitido {
+				permitidos.Store(i, true)
+			}
+		}()
+	}
+	wg.Wait()
+
+	//ASSERT
+	total := 0
+	permitidos.Range(func(_, _ any) bool { total++; return true })
+	assert.Equal(t, 50, total)
+}
+
+func TestRateLimitRepository_ChavesIndependentes(t *testing.T) {
+	//ARRANGE
+	repo := NewRateLimitRepository()
+	politica := ratelimit.Politica{Nome: "teste", Limite: 1, Janela: time.Minute}
+	agora := time.Now()
+	_, _ = repo.Consumir(context.Background(), "ip:1.1.1.1", politica, agora)
+
+	//ACT
+	r, err := repo.Consumir(context.Background(), "ip:2.2.2.2", politica, agora)
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.True(t, r.Permitido)
+}
+
+func TestRateLimitRepository_Limpar(t *testing.T) {
+	//ARRANGE
+	repo := NewRateLimitRepository()
+	politica := ratelimit.Politica{Nome: "teste", Limite: 1, Janela: time.Minute}
+	agora := time.Now()
+	// O antigo entra por último: assim a varredura do Consumir não o tira antes
+	_, _ = repo.Consumir(context.Background(), "recente", politica, agora)
+	_, _ = repo.Consumir(context.Background(), "antigo", politica, agora.Add(-time.Hour))
+
+	//ACT
+	removidos, err := repo.Limpar(context.Background(), agora.Add(-time.Minute))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removidos)
+	assert.Len(t, repo.baldes, 1)
+}
+
+func TestRateLimitRepository_ConsumirTiraBaldesParados(t *testing.T) {
+	//ARRANGE
+	repo := NewRateLimitRepository()
+	curta := ratelimit.Politica{Nome: "curta", Limite: 1, Janela: time.Minute}
+	longa := ratelimit.Politica{Nome: "longa", Limite: 1, Janela: time.Hour}
+	agora := time.Now()
+	_, _ = repo.Consumir(context.Background(), "ip:1.1.1.1", curta, agora)
+	_, _ = repo.Consumir(context.Background(), "ip:2.2.2.2", longa, agora)
+
+	//ACT: depois de 2 minutos só o balde da janela curta já encheu
+	_, err := repo.Consumir(context.Background(), "ip:3.3.3.3", curta, agora.Add(2*time.Minute))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.NotContains(t, repo.baldes, "ip:1.1.1.1")
+	assert.Contains(t, repo.baldes, "ip:2.2.2.2")
+	assert.Contains(t, repo.baldes, "ip:3.3.3.3")
+}
@@ -0,0 +1,136 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Migracao é um par de arquivos <versao>_<nome>.up.sql / .down.sql
+type Migracao struct {
+	Versao int64
+	Nome   string
+	Up     string
+	Down   string
+}
+
+var arquivoMigracao = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Carregar lê todas as migrations de fsys, ordenadas por versão. Toda
+// migration precisa ter o arquivo up e o down.
+func Carregar(fsys fs.FS) ([]Migracao, error) {
+	entradas, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar migrations: %w", err)
+	}
+
+	porVersao := map[int64]*Migracao{}
+	for _, entrada := range entradas {
+		if entrada.IsDir() {
+			continue
+		}
+
+		partes := arquivoMigracao.FindStringSubmatch(entrada.Name())
+		if partes == nil {
+			return nil, fmt.Errorf("nome de migration inválido: %s", entrada.Name())
+		}
+
+		versao, _ := strconv.ParseInt(partes[1], 10, 64)
+		conteudo, err := fs.ReadFile(fsys, entrada.Name())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao ler %s: %w", entrada.Name(), err)
+		}
+
+		m, ok := porVersao[versao]
+		if !ok {
+			m = &Migracao{Versao: versao, Nome: partes[2]}
+			porVersao[versao] = m
+		}
+		if m.Nome != partes[2] {
+			return nil, fmt.Errorf("versão %d usada por duas migrations: %s e %s", versao, m.Nome, partes[2])
+		}
+
+		if partes[3] == "up" {
+			m.Up = string(conteudo)
+		} else {
+			m.Down = string(conteudo)
+		}
+	}
+
+	migracoes := make([]Migracao, 0, len(porVersao))
+	for _, m := range porVersao {
+		if strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s precisa dos arquivos up e down", m.Versao, m.Nome)
+		}
+		migracoes = append(migracoes, *m)
+	}
+
+	sort.Slice(migracoes, func(i, j int) bool { return migracoes[i].Versao < migracoes[j].Versao })
+	return migracoes, nil
+}
+
+// Criar gera os arquivos vazios da próxima migration em dir e devolve
+// os caminhos criados.
+func Criar(dir, nome string) (string, string, error) {
+	nome = strings.ToLower(strings.TrimSpace(nome))
+	nome = strings.NewReplacer(" ", "_", "-", "_").Replace(nome)
+	if !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(nome) {
+		return "", "", errors.New("nome da migration deve ter só letras, números e _")
+	}
+
+	existentes, err := Carregar(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+
+	var proxima int64 = 1
+	if len(existentes) > 0 {
+		proxima = existentes[len(existentes)-1].Versao + 1
+	}
+
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", proxima, nome))
+	up, down := base+".up.sql", base+".down.sql"
+
+	if err := os.WriteFile(up, []byte("-- "+nome+" (up)\n"), 0o644); err != nil {
+		return "", "", fmt.Errorf("erro ao criar %s: %w", up, err)
+	}
+	if err := os.WriteFile(down, []byte("-- "+nome+" (down)\n"), 0o644); err != nil {
+		return "", "", fmt.Errorf("erro ao criar %s: %w", down, err)
+	}
+
+	return up, down, nil
+}
+
+// comandos separa o conteúdo de um arquivo em comandos individuais, já que
+// nem todo driver aceita vários comandos num único Exec. Cada comando
+// termina com ";" no fim da linha.
+func comandos(sqlTexto string) []string {
+	var resultado []string
+	var atual strings.Builder
+
+	for _, linha := range strings.Split(sqlTexto, "\n") {
+		limpa := strings.TrimSpace(linha)
+		if limpa == "" || strings.HasPrefix(limpa, "--") {
+			continue
+		}
+
+		atual.WriteString(linha)
+		atual.WriteString("\n")
+
+		if strings.HasSuffix(limpa, ";") {
+			resultado = append(resultado, strings.TrimSuffix(strings.TrimSpace(atual.String()), ";"))
+			atual.Reset()
+		}
+	}
+
+	if resto := strings.TrimSpace(atual.String()); resto != "" {
+		resultado = append(resultado, resto)
+	}
+	return resultado
+}
@@ -0,0 +1,103 @@
+package migrate
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestCarregar_WhenArquivosValidos_RetornaOrdenado(t *testing.T) {
+	//ARRANGE
+	fsys := fstest.MapFS{
+		"0002_criar_itens.up.sql":   {Data: []byte("CREATE TABLE itens (id INT);")},
+		"0002_criar_itens.down.sql": {Data: []byte("DROP TABLE itens;")},
+		"0001_criar_users.up.sql":   {Data: []byte("CREATE TABLE users (id INT);")},
+		"0001_criar_users.down.sql": {Data: []byte("DROP TABLE users;")},
+	}
+
+	//ACT
+	migracoes, err := Carregar(fsys)
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, migracoes, 2)
+	assert.Equal(t, int64(1), migracoes[0].Versao)
+	assert.Equal(t, "criar_users", migracoes[0].Nome)
+	assert.Equal(t, "DROP TABLE itens;", migracoes[1].Down)
+}
+
+func TestCarregar_WhenFaltaDown_RetornaErro(t *testing.T) {
+	//ARRANGE
+	fsys := fstest.MapFS{
+		"0001_criar_users.up.sql": {Data: []byte("CREATE TABLE users (id INT);")},
+	}
+
+	//ACT
+	_, err := Carregar(fsys)
+
+	//ASSERT
+	assert.Error(t, err)
+}
+
+func TestCarregar_WhenVersaoRepetida_RetornaErro(t *testing.T) {
+	//ARRANGE
+	fsys := fstest.MapFS{
+		"0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+		"0001_a.down.sql": {Data: []byte("SELECT 1;")},
+		"0001_b.up.sql":   {Data: []byte("SELECT 1;")},
+		"0001_b.down.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	//ACT
+	_, err := Carregar(fsys)
+
+	//ASSERT
+	assert.Error(t, err)
+}
+
+func TestCarregar_WhenNomeInvalido_RetornaErro(t *testing.T) {
+	//ARRANGE
+	fsys := fstest.MapFS{"criar_users.sql": {Data: []byte("SELECT 1;")}}
+
+	//ACT
+	_, err := Carregar(fsys)
+
+	//ASSERT
+	assert.Error(t, err)
+}
+
+func TestCriar_GeraProximaVersao(t *testing.T) {
+	//ARRANGE
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "0007_antiga.up.sql"), []byte("SELECT 1;"), 0o644)
+	os.WriteFile(filepath.Join(dir, "0007_antiga.down.sql"), []byte("SELECT 1;"), 0o644)
+
+	//ACT
+	up, down, err := Criar(dir, "Adicionar Coluna")
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "0008_adicionar_coluna.up.sql"), up)
+	assert.Equal(t, filepath.Join(dir, "0008_adicionar_coluna.down.sql"), down)
+	assert.FileExists(t, up)
+	assert.FileExists(t, down)
+}
+
+func TestComandos_SeparaPorPontoEVirgulaEIgnoraComentarios(t *testing.T) {
+	//ARRANGE
+	script := `-- cria tabela
+CREATE TABLE a (
+    id INT
+);
+
+CREATE INDEX idx_a ON a (id);
+`
+
+	//ACT
+	resultado := comandos(script)
+
+	//ASSERT
+	assert.Equal(t, []string{"CREATE TABLE a (\n    id INT\n)", "CREATE INDEX idx_a ON a (id)"}, resultado)
+}
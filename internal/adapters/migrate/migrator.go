@@ -0,0 +1,356 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// ErrSchemaDesatualizado indica que existem migrations ainda não aplicadas
+var ErrSchemaDesatualizado = errors.New("schema do banco desatualizado")
+
+// Dialeto concentra o SQL que muda entre bancos
+type Dialeto struct {
+	Nome         string
+	Placeholder  func(n int) string
+	CriarTabelas []string
+}
+
+var MySQL = Dialeto{
+	Nome:        "mysql",
+	Placeholder: func(int) string { return "?" },
+	CriarTabelas: []string{
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+			versao BIGINT NOT NULL PRIMARY KEY,
+			nome VARCHAR(255) NOT NULL,
+			aplicada_em DATETIME(3) NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS schema_migrations_lock (
+			id INT NOT NULL PRIMARY KEY,
+			dono VARCHAR(255) NOT NULL,
+			travado_em DATETIME(3) NOT NULL
+		)`,
+	},
+}
+
+var Postgres = Dialeto{
+	Nome:        "postgres",
+	Placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+	CriarTabelas: []string{
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+			versao BIGINT NOT NULL PRIMARY KEY,
+			nome VARCHAR(255) NOT NULL,
+			aplicada_em TIMESTAMPTZ NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS schema_migrations_lock (
+			id INT NOT NULL PRIMARY KEY,
+			dono VARCHAR(255) NOT NULL,
+			travado_em TIMESTAMPTZ NOT NULL
+		)`,
+	},
+}
+
+// Status descreve uma migration e se ela já foi aplicada
+type Status struct {
+	Migracao
+	Aplicada   bool
+	AplicadaEm *time.Time
+}
+
+type Migrator struct {
+	db        *sql.DB
+	dialeto   Dialeto
+	migracoes []Migracao
+
+	// EsperaLock é quanto tempo Up/Down aguardam outra réplica liberar o lock
+	EsperaLock time.Duration
+	// LockExpira: lock sem renovação há mais que isso é de um processo que
+	// morreu no meio e pode ser quebrado. Quem segura o lock renova a cada
+	// LockExpira/3.
+	LockExpira time.Duration
+}
+
+func New(db *sql.DB, dialeto Dialeto, fsys fs.FS) (*Migrator, error) {
+	migracoes, err := Carregar(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		dialeto:    dialeto,
+		migracoes:  migracoes,
+		EsperaLock: time.Minute,
+		LockExpira: 2 * time.Minute,
+	}, nil
+}
+
+// Up aplica todas as migrations pendentes, em ordem
+func (m *Migrator) Up(ctx context.Context) ([]Migracao, error) {
+	if err := m.prepararTabelas(ctx); err != nil {
+		return nil, err
+	}
+
+	liberar, err := m.travar(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer liberar()
+
+	pendentes, err := m.pendentes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var aplicadas []Migracao
+	for _, mig := range pendentes {
+		registro := fmt.Sprintf("INSERT INTO schema_migrations (versao, nome, aplicada_em) VALUES (%s, %s, %s)",
+			m.dialeto.Placeholder(1), m.dialeto.Placeholder(2), m.dialeto.Placeholder(3))
+
+		if err := m.executar(ctx, mig.Up, registro, mig.Versao, mig.Nome, time.Now().UTC()); err != nil {
+			return aplicadas, fmt.Errorf("erro na migration %d_%s: %w", mig.Versao, mig.Nome, err)
+		}
+		aplicadas = append(aplicadas, mig)
+	}
+
+	return aplicadas, nil
+}
+
+// Down desfaz as últimas `passos` migrations aplicadas
+func (m *Migrator) Down(ctx context.Context, passos int) ([]Migracao, error) {
+	if passos < 1 {
+		return nil, errors.New("número de passos deve ser maior que zero")
+	}
+
+	if err := m.prepararTabelas(ctx); err != nil {
+		return nil, err
+	}
+
+	liberar, err := m.travar(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer liberar()
+
+	aplicadas, err := m.aplicadas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var desfeitas []Migracao
+	for i := len(m.migracoes) - 1; i >= 0 && len(desfeitas) < passos; i-- {
+		mig := m.migracoes[i]
+		if _, ok := aplicadas[mig.Versao]; !ok {
+			continue
+		}
+
+		remocao := fmt.Sprintf("DELETE FROM schema_migrations WHERE versao = %s", m.dialeto.Placeholder(1))
+		if err := m.executar(ctx, mig.Down, remocao, mig.Versao); err != nil {
+			return desfeitas, fmt.Errorf("erro ao desfazer %d_%s: %w", mig.Versao, mig.Nome, err)
+		}
+		desfeitas = append(desfeitas, mig)
+	}
+
+	return desfeitas, nil
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := m.prepararTabelas(ctx); err != nil {
+		return nil, err
+	}
+
+	aplicadas, err := m.aplicadas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]Status, 0, len(m.migracoes))
+	for _, mig := range m.migracoes {
+		s := Status{Migracao: mig}
+		if em, ok := aplicadas[mig.Versao]; ok {
+			s.Aplicada = true
+			s.AplicadaEm = &em
+		}
+		status = append(status, s)
+	}
+	return status, nil
+}
+
+// VerificarAtualizado retorna ErrSchemaDesatualizado se houver migration
+// pendente. Usado no boot: a API não sobe com o schema atrasado.
+func (m *Migrator) VerificarAtualizado(ctx context.Context) error {
+	if err := m.prepararTabelas(ctx); err != nil {
+		return err
+	}
+	return m.Verificar(ctx)
+}
+
+// Verificar faz a mesma conferência de VerificarAtualizado só com um SELECT
+// em schema_migrations, sem DDL. Usado no /readyz, que roda a toda hora.
+func (m *Migrator) Verificar(ctx context.Context) error {
+	pendentes, err := m.pendentes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pendentes) > 0 {
+		return fmt.Errorf("%w: %d migration(s) pendente(s), a primeira é %d_%s",
+			ErrSchemaDesatualizado, len(pendentes), pendentes[0].Versao, pendentes[0].Nome)
+	}
+	return nil
+}
+
+func (m *Migrator) prepararTabelas(ctx context.Context) error {
+	for _, ddl := range m.dialeto.CriarTabelas {
+		if _, err := m.db.ExecContext(ctx, ddl); err != nil {
+			return fmt.Errorf("erro ao preparar tabelas de migration: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) aplicadas(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := m.db.QueryContext(ctx, "SELECT versao, aplicada_em FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler migrations aplicadas: %w", err)
+	}
+	defer rows.Close()
+
+	aplicadas := map[int64]time.Time{}
+	for rows.Next() {
+		var versao int64
+		var em time.Time
+		if err := rows.Scan(&versao, &em); err != nil {
+			return nil, fmt.Errorf("erro ao ler migrations aplicadas: %w", err)
+		}
+		aplicadas[versao] = em
+	}
+	return aplicadas, rows.Err()
+}
+
+func (m *Migrator) pendentes(ctx context.Context) ([]Migracao, error) {
+	aplicadas, err := m.aplicadas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pendentes []Migracao
+	for _, mig := range m.migracoes {
+		if _, ok := aplicadas[mig.Versao]; !ok {
+			pendentes = append(pendentes, mig)
+		}
+	}
+	return pendentes, nil
+}
+
+// executar roda o script e o registro em schema_migrations na mesma
+// transação. No PostgreSQL o DDL também é transacional; no MySQL cada DDL
+// faz commit implícito, por isso cada passo das migrations do MySQL confere
+// antes (IF NOT EXISTS ou information_schema) e pode rodar de novo.
+func (m *Migrator) executar(ctx context.Context, script, registro string, args ...any) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, comando := range comandos(script) {
+		if _, err := tx.ExecContext(ctx, comando); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, registro, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// travar usa a linha id=1 de schema_migrations_lock como lock: só uma
+// réplica consegue inseri-la. As outras esperam até EsperaLock. Enquanto o
+// lock está com esta réplica, travado_em é renovado; um lock parado há mais
+// de LockExpira (processo morto no meio do Up) é apagado e disputado de novo.
+func (m *Migrator) travar(ctx context.Context) (func(), error) {
+	hostname, _ := os.Hostname()
+	dono := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+
+	inserir := fmt.Sprintf("INSERT INTO schema_migrations_lock (id, dono, travado_em) VALUES (1, %s, %s)",
+		m.dialeto.Placeholder(1), m.dialeto.Placeholder(2))
+	quebrar := fmt.Sprintf("DELETE FROM schema_migrations_lock WHERE id = 1 AND travado_em < %s",
+		m.dialeto.Placeholder(1))
+
+	limite := time.Now().Add(m.EsperaLock)
+	for {
+		_, errInsert := m.db.ExecContext(ctx, inserir, dono, time.Now().UTC())
+		if errInsert == nil {
+			return m.renovar(dono), nil
+		}
+
+		// Se a linha não existe, o INSERT falhou por outro motivo
+		var donoAtual string
+		var desde time.Time
+		err := m.db.QueryRowContext(ctx, "SELECT dono, travado_em FROM schema_migrations_lock WHERE id = 1").Scan(&donoAtual, &desde)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("erro ao obter lock de migration: %w", errInsert)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("erro ao consultar lock de migration: %w", err)
+		}
+
+		// O DELETE só pega o lock se ele continua vencido: se o dono renovou
+		// ou outra réplica já o quebrou e travou de novo, nada acontece
+		if time.Since(desde) > m.LockExpira {
+			res, err := m.db.ExecContext(ctx, quebrar, time.Now().Add(-m.LockExpira).UTC())
+			if err != nil {
+				return nil, fmt.Errorf("erro ao quebrar lock de migration vencido: %w", err)
+			}
+			if n, _ := res.RowsAffected(); n > 0 {
+				continue
+			}
+		}
+
+		if time.Now().After(limite) {
+			return nil, fmt.Errorf("lock de migration ocupado por %s desde %s", donoAtual, desde.Format(time.RFC3339))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// renovar atualiza travado_em até o lock ser liberado; devolve a função que
+// para a renovação e apaga o lock
+func (m *Migrator) renovar(dono string) func() {
+	atualizar := fmt.Sprintf("UPDATE schema_migrations_lock SET travado_em = %s WHERE id = 1 AND dono = %s",
+		m.dialeto.Placeholder(1), m.dialeto.Placeholder(2))
+
+	parar := make(chan struct{})
+	terminou := make(chan struct{})
+	go func() {
+		defer close(terminou)
+		ticker := time.NewTicker(m.LockExpira / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-parar:
+				return
+			case <-ticker.C:
+				m.db.ExecContext(context.Background(), atualizar, time.Now().UTC(), dono)
+			}
+		}
+	}()
+
+	return func() {
+		close(parar)
+		<-terminou
+		m.db.ExecContext(context.Background(), "DELETE FROM schema_migrations_lock WHERE id = 1 AND dono = "+m.dialeto.Placeholder(1), dono)
+	}
+}
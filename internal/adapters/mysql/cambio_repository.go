@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MySQLCambioRepository struct {
+	db *gorm.DB
+}
+
+func NewMySQLCambioRepository(db *gorm.DB) *MySQLCambioRepository {
+	return &MySQLCambioRepository{db: db}
+}
+
+func (r *MySQLCambioRepository) Listar(ctx context.Context) ([]cambio.Taxa, error) {
+	var models []TaxaCambioModel
+
+	err := conexao(ctx, r.db).Order("moeda_origem, moeda_destino").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar taxas de câmbio: %w", err)
+	}
+
+	taxas := make([]cambio.Taxa, 0, len(models))
+	for _, model := range models {
+		taxa, err := model.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("Taxa de câmbio inválida no banco (%s → %s): %w", model.MoedaOrigem, model.MoedaDestino, err)
+		}
+		taxas = append(taxas, taxa)
+	}
+	return taxas, nil
+}
+
+func (r *MySQLCambioRepository) Salvar(ctx context.Context, taxa cambio.Taxa) error {
+	model := fromTaxaCambioEntity(taxa)
+
+	err := conexao(ctx, r.db).Clauses(clause.OnConflict{UpdateAll: true}).Create(&model).Error
+	if err != nil {
+		return fmt.Errorf("Erro ao salvar taxa de câmbio: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLCambioRepository) Remover(ctx context.Context, de, para dinheiro.Moeda) error {
+	result := conexao(ctx, r.db).
+		Where("moeda_origem = ? AND moeda_destino = ?", string(de), string(para)).
+		Delete(&TaxaCambioModel{})
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao remover taxa de câmbio: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s → %s", cambio.ErrTaxaNaoEncontrada, de, para)
+	}
+	return nil
+}
@@ -1,26 +1,115 @@
 package mysql
 
 import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/config"
+	"desafio-itens-app/utils"
 	"fmt"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+	"log/slog"
+	"time"
+
+	driver "github.com/go-sql-driver/mysql"
 )
 
-func ConectarGORM() (*gorm.DB, error) {
-	dsn := "root:root@tcp(localhost:3306)/meubanco?charset=utf8mb4&parseTime=True&loc=Local"
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
-	})
+const dsnPadrao = "root:root@tcp(localhost:3306)/meubanco?charset=utf8mb4&parseTime=True&loc=UTC"
+
+func ConectarGORM(cfg config.Database, log *slog.Logger, gormLogger logger.Interface) (*gorm.DB, error) {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = dsnPadrao
+	}
+	dsn, err := emUTC(dsn)
+	if err != nil {
+		return nil, err
+	}
 
+	// 🔁 O banco pode ainda estar subindo (docker-compose): tenta com backoff
+	var db *gorm.DB
+	tentativa := 0
+	err = utils.Retry(context.Background(), cfg.TentativasConexao, cfg.EsperaConexao, func() error {
+		tentativa++
+		var err error
+		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
+			Logger: gormLogger,
+		})
+		if err != nil {
+			log.Warn("banco indisponível",
+				"tentativa", tentativa,
+				"max_tentativas", cfg.TentativasConexao,
+				"erro", err)
+		}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("erro ao conectar com GORM: %w", err)
 	}
 
-	err = db.AutoMigrate(&ItemModel{}, &UserModel{})
+	sqlDB, err := db.DB()
 	if err != nil {
-		return nil, fmt.Errorf("erro na migration: %w", err)
+		return nil, fmt.Errorf("erro ao obter pool de conexões: %w", err)
 	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	// 📚 Réplicas: SELECTs vão para elas, escritas e transações para o primário
+	if len(cfg.ReplicaDSNs) > 0 {
+		replicas := make([]gorm.Dialector, 0, len(cfg.ReplicaDSNs))
+		for _, replica := range cfg.ReplicaDSNs {
+			replica, err := emUTC(replica)
+			if err != nil {
+				return nil, err
+			}
+			replicas = append(replicas, mysql.Open(replica))
+		}
 
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: replicas,
+			Policy:   dbresolver.RandomPolicy{},
+		}).
+			SetMaxOpenConns(cfg.MaxOpenConns).
+			SetMaxIdleConns(cfg.MaxIdleConns).
+			SetConnMaxLifetime(cfg.ConnMaxLifetime).
+			SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+		if err := db.Use(resolver); err != nil {
+			return nil, fmt.Errorf("erro ao configurar réplicas de leitura: %w", err)
+		}
+	}
+
+	log.Info("conectado ao banco", "driver", "mysql", "replicas", len(cfg.ReplicaDSNs))
 	return db, nil
 }
+
+// emUTC força parseTime e loc=UTC no DSN: os horários são gravados em UTC
+// (lock de migrations, expiração de reservas) e lidos de volta comparados
+// com time.Now(); com loc=Local a diferença de fuso vira atraso ou adianto.
+func emUTC(dsn string) (string, error) {
+	c, err := driver.ParseDSN(dsn)
+	if err != nil {
+		return "", fmt.Errorf("DSN do MySQL inválido: %w", err)
+	}
+	c.ParseTime = true
+	c.Loc = time.UTC
+	return c.FormatDSN(), nil
+}
+
+// conexao prepara o *gorm.DB de uma operação: propaga o contexto, usa a
+// transação aberta pelo Transacionador (se houver) e, se a requisição
+// pediu "read your writes", força as leituras no primário.
+func conexao(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx := transacaoDoContexto(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
+	db = db.WithContext(ctx)
+	if repositories.LeituraNoPrimario(ctx) {
+		db = db.Clauses(dbresolver.Write)
+	}
+	return db
+}
@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"desafio-itens-app/internal/domain/deposito"
+	entity "desafio-itens-app/internal/domain/item"
+	userDomain "desafio-itens-app/internal/domain/user"
+	"errors"
+	driver "github.com/go-sql-driver/mysql"
+	"strings"
+)
+
+// erDupEntry é o código do MySQL para chave única duplicada
+const erDupEntry = 1062
+
+// traduzirErro converte violações de unicidade nos erros de conflito do
+// domínio, como o twin do PostgreSQL. O MySQL só informa o índice na
+// mensagem: "Duplicate entry 'x' for key 'itens.idx_itens_ean'" (o prefixo
+// com a tabela aparece a partir do 8.0).
+func traduzirErro(err error) error {
+	var myErr *driver.MySQLError
+	if !errors.As(err, &myErr) || myErr.Number != erDupEntry {
+		return err
+	}
+
+	switch indiceDuplicado(myErr.Message) {
+	case "idx_itens_code":
+		return entity.ErrCodeDuplicado
+	case "idx_itens_ean":
+		return entity.ErrEANDuplicado
+	case "idx_users_username":
+		return userDomain.ErrUsernameEmUso
+	case "idx_users_email":
+		return userDomain.ErrEmailEmUso
+	case "idx_depositos_codigo":
+		return deposito.ErrCodigoDuplicado
+	}
+	return err
+}
+
+func indiceDuplicado(mensagem string) string {
+	_, chave, ok := strings.Cut(mensagem, "for key '")
+	if !ok {
+		return ""
+	}
+	chave = strings.TrimSuffix(chave, "'")
+	if i := strings.LastIndex(chave, "."); i >= 0 {
+		chave = chave[i+1:]
+	}
+	return chave
+}
@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"desafio-itens-app/internal/domain/deposito"
+	entity "desafio-itens-app/internal/domain/item"
+	userDomain "desafio-itens-app/internal/domain/user"
+	"errors"
+	"fmt"
+	driver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTraduzirErro_DupEntry_RetornaErroDeDominio(t *testing.T) {
+	casos := map[string]error{
+		"Duplicate entry 'MO12345678' for key 'itens.idx_itens_code'":   entity.ErrCodeDuplicado,
+		"Duplicate entry '7891234567895' for key 'idx_itens_ean'":       entity.ErrEANDuplicado,
+		"Duplicate entry 'ana' for key 'users.idx_users_username'":      userDomain.ErrUsernameEmUso,
+		"Duplicate entry 'a@b.com' for key 'users.idx_users_email'":     userDomain.ErrEmailEmUso,
+		"Duplicate entry 'sp' for key 'depositos.idx_depositos_codigo'": deposito.ErrCodigoDuplicado,
+	}
+
+	for mensagem, esperado := range casos {
+		//ARRANGE
+		err := fmt.Errorf("insert: %w", &driver.MySQLError{Number: erDupEntry, Message: mensagem})
+
+		//ACT
+		traduzido := traduzirErro(err)
+
+		//ASSERT
+		assert.ErrorIs(t, traduzido, esperado, mensagem)
+	}
+}
+
+func TestTraduzirErro_OutroErro_MantemOriginal(t *testing.T) {
+	//ARRANGE
+	original := &driver.MySQLError{Number: 1452, Message: "Cannot add or update a child row"}
+	generico := errors.New("conexão recusada")
+
+	//ACT + ASSERT
+	assert.Equal(t, error(original), traduzirErro(original))
+	assert.Equal(t, generico, traduzirErro(generico))
+}
@@ -1,27 +1,34 @@
 package mysql
 
 import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
 	entity "desafio-itens-app/internal/domain/item"
 	"fmt"
 	_ "github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log/slog"
+	"strings"
 )
 
 type MySQLItemRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
-func NewMySQLItemRepository(db *gorm.DB) *MySQLItemRepository {
-	return &MySQLItemRepository{db: db}
+func NewMySQLItemRepository(db *gorm.DB, logger *slog.Logger) *MySQLItemRepository {
+	return &MySQLItemRepository{db: db, logger: logger}
 }
 
-func (r *MySQLItemRepository) GetItem(id int) (*entity.Item, error) {
+func (r *MySQLItemRepository) GetItem(ctx context.Context, id int) (*entity.Item, error) {
 	var model ItemModel
 
-	err := r.db.First(&model, id).Error
+	err := conexao(ctx, r.db).First(&model, id).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("Item não encontrado")
+			r.logger.DebugContext(ctx, "item não encontrado", "item_id", id)
+			return nil, entity.ErrItemNaoEncontrado
 		}
 		return nil, fmt.Errorf("Erro ao buscar item: %w", err)
 	}
@@ -30,10 +37,14 @@ func (r *MySQLItemRepository) GetItem(id int) (*entity.Item, error) {
 	return &item, nil
 }
 
-func (r *MySQLItemRepository) GetItens() ([]entity.Item, error) {
+func (r *MySQLItemRepository) TravarItens(ctx context.Context, ids []int) error {
+	return travarItens(conexao(ctx, r.db), ids)
+}
+
+func (r *MySQLItemRepository) GetItens(ctx context.Context) ([]entity.Item, error) {
 	var models []ItemModel
 
-	err := r.db.Find(&models).Error
+	err := conexao(ctx, r.db).Find(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("Erro ao buscar itens: %w", err)
 	}
@@ -46,10 +57,10 @@ func (r *MySQLItemRepository) GetItens() ([]entity.Item, error) {
 	return itens, nil
 }
 
-func (r *MySQLItemRepository) GetItensFiltrados(status *entity.Status, limit int) ([]entity.Item, error) {
+func (r *MySQLItemRepository) GetItensFiltrados(ctx context.Context, status *entity.Status, limit int) ([]entity.Item, error) {
 
 	var models []ItemModel
-	query := r.db.Model(&ItemModel{})
+	query := conexao(ctx, r.db).Model(&ItemModel{})
 
 	if status != nil {
 		query = query.Where("status = ?", string(*status))
@@ -68,16 +79,16 @@ func (r *MySQLItemRepository) GetItensFiltrados(status *entity.Status, limit int
 	return itens, nil
 }
 
-func (r *MySQLItemRepository) GetItensPaginados(offset, limit int) ([]entity.Item, int, error) {
+func (r *MySQLItemRepository) GetItensPaginados(ctx context.Context, offset, limit int) ([]entity.Item, int, error) {
 	var models []ItemModel
 	var totalCount int64
 
-	err := r.db.Model(&ItemModel{}).Count(&totalCount).Error
+	err := conexao(ctx, r.db).Model(&ItemModel{}).Count(&totalCount).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("Erro ao contar itens: %w", err)
 	}
 
-	err = r.db.Offset(offset).Limit(limit).Order("Created_at DESC").Find(&models).Error
+	err = conexao(ctx, r.db).Offset(offset).Limit(limit).Order("Created_at DESC").Find(&models).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("Erro ao buscar itens paginados: %w", err)
 	}
@@ -90,12 +101,12 @@ func (r *MySQLItemRepository) GetItensPaginados(offset, limit int) ([]entity.Ite
 	return itens, int(totalCount), nil
 }
 
-func (r *MySQLItemRepository) GetItensFiltradosPaginados(status *entity.Status, offset, limit int) ([]entity.Item, int, error) {
+func (r *MySQLItemRepository) GetItensFiltradosPaginados(ctx context.Context, status *entity.Status, offset, limit int) ([]entity.Item, int, error) {
 
 	var models []ItemModel
 	var totalCount int64
 
-	query := r.db.Model(&ItemModel{})
+	query := conexao(ctx, r.db).Model(&ItemModel{})
 	if status != nil {
 		query = query.Where("status = ?", string(*status))
 	}
@@ -117,9 +128,60 @@ func (r *MySQLItemRepository) GetItensFiltradosPaginados(status *entity.Status,
 	return itens, int(totalCount), nil
 }
 
-func (r *MySQLItemRepository) CountItens(status *entity.Status) (int, error) {
+func (r *MySQLItemRepository) BuscarItens(ctx context.Context, termo string, status *entity.Status, offset, limit int) ([]entity.Item, int, error) {
+	var models []ItemModel
+	var totalCount int64
+
+	query := filtrarBusca(conexao(ctx, r.db).Model(&ItemModel{}), termo)
+	if status != nil {
+		query = query.Where("status = ?", string(*status))
+	}
+
+	err := query.Count(&totalCount).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("Erro ao contar itens da busca: %w", err)
+	}
+
+	err = query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&models).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("Erro ao buscar itens: %w", err)
+	}
+
+	var itens []entity.Item
+	for _, model := range models {
+		itens = append(itens, model.ToEntity())
+	}
+	return itens, int(totalCount), nil
+}
+
+// ListarLote percorre o catálogo em ordem de ID (keyset): cada chamada
+// continua depois de aposID, sem o custo crescente do OFFSET.
+func (r *MySQLItemRepository) ListarLote(ctx context.Context, status *entity.Status, termo string, aposID, limite int) ([]entity.Item, error) {
+	var models []ItemModel
+
+	query := conexao(ctx, r.db).Model(&ItemModel{}).Where("id > ?", aposID)
+	if termo != "" {
+		query = filtrarBusca(query, termo)
+	}
+	if status != nil {
+		query = query.Where("status = ?", string(*status))
+	}
+
+	err := query.Order("id ASC").Limit(limite).Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar lote de itens: %w", err)
+	}
+
+	itens := make([]entity.Item, 0, len(models))
+	for _, model := range models {
+		itens = append(itens, model.ToEntity())
+	}
+	return itens, nil
+}
+
+func (r *MySQLItemRepository) CountItens(ctx context.Context, status *entity.Status) (int, error) {
 	var count int64
-	query := r.db.Model(&ItemModel{})
+	query := conexao(ctx, r.db).Model(&ItemModel{})
 
 	if status != nil {
 		query = query.Where("status = ?", string(*status))
@@ -132,10 +194,122 @@ func (r *MySQLItemRepository) CountItens(status *entity.Status) (int, error) {
 	return int(count), nil
 }
 
-func (r *MySQLItemRepository) CodeExists(code string) (bool, error) {
+func (r *MySQLItemRepository) ResumoEstoque(ctx context.Context) (entity.ResumoEstoque, error) {
+	var linhas []struct {
+		Status        string
+		Moeda         string
+		Total         int
+		ValorCentavos int64
+	}
+
+	err := conexao(ctx, r.db).Model(&ItemModel{}).
+		Select("status, moeda, COUNT(*) AS total, COALESCE(SUM(preco_centavos * estoque), 0) AS valor_centavos").
+		Group("status, moeda").
+		Scan(&linhas).Error
+	if err != nil {
+		return entity.ResumoEstoque{}, fmt.Errorf("Erro ao resumir estoque: %w", err)
+	}
+
+	resumo := entity.ResumoEstoque{
+		PorStatus:     map[entity.Status]int{},
+		ValorPorMoeda: map[dinheiro.Moeda]dinheiro.Dinheiro{},
+	}
+	for _, l := range linhas {
+		moeda := dinheiro.Moeda(l.Moeda)
+		resumo.PorStatus[entity.Status(l.Status)] += l.Total
+		resumo.ValorPorMoeda[moeda] = dinheiro.Novo(resumo.ValorPorMoeda[moeda].Centavos+l.ValorCentavos, moeda)
+	}
+	return resumo, nil
+}
+
+// ListarEstoqueBaixo traz os itens com ponto de reposição cujo estoque
+// chegou nele, os mais abaixo do ponto primeiro
+func (r *MySQLItemRepository) ListarEstoqueBaixo(ctx context.Context) ([]entity.Item, error) {
+	var models []ItemModel
+
+	err := conexao(ctx, r.db).
+		Where("ponto_reposicao > 0 AND estoque <= ponto_reposicao").
+		Order("estoque - ponto_reposicao, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar itens com estoque baixo: %w", err)
+	}
+
+	itens := make([]entity.Item, 0, len(models))
+	for _, model := range models {
+		itens = append(itens, model.ToEntity())
+	}
+	return itens, nil
+}
+
+// ListarProdutos pagina os itens de topo: produtos e itens sem variação,
+// nunca as variantes
+func (r *MySQLItemRepository) ListarProdutos(ctx context.Context, offset, limit int) ([]entity.Item, int, error) {
+	var models []ItemModel
+	var totalCount int64
+
+	query := conexao(ctx, r.db).Model(&ItemModel{}).Where("produto_id IS NULL")
+
+	err := query.Count(&totalCount).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("Erro ao contar produtos: %w", err)
+	}
+
+	err = query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&models).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("Erro ao listar produtos: %w", err)
+	}
+
+	var itens []entity.Item
+	for _, model := range models {
+		itens = append(itens, model.ToEntity())
+	}
+	return itens, int(totalCount), nil
+}
+
+// ListarVariantes traz as variantes dos produtos, agrupadas por produto e
+// na ordem de criação
+func (r *MySQLItemRepository) ListarVariantes(ctx context.Context, produtoIDs []int) ([]entity.Item, error) {
+	if len(produtoIDs) == 0 {
+		return nil, nil
+	}
+
+	var models []ItemModel
+	err := conexao(ctx, r.db).
+		Where("produto_id IN ?", produtoIDs).
+		Order("produto_id, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar variantes: %w", err)
+	}
+
+	var itens []entity.Item
+	for _, model := range models {
+		itens = append(itens, model.ToEntity())
+	}
+	return itens, nil
+}
+
+// GetItemByCode devolve nil (sem erro) quando não existe item com o código
+func (r *MySQLItemRepository) GetItemByCode(ctx context.Context, code string) (*entity.Item, error) {
+	var model ItemModel
+
+	err := conexao(ctx, r.db).Where("code = ?", code).First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Erro ao buscar item por código: %w", err)
+	}
+
+	item := model.ToEntity()
+	return &item, nil
+}
+
+func (r *MySQLItemRepository) CodeExists(ctx context.Context, code string) (bool, error) {
 	var count int64
 
-	err := r.db.Model(&ItemModel{}).Where("code = ?", code).Count(&count).Error
+	err := conexao(ctx, r.db).Model(&ItemModel{}).Where("code = ?", code).Count(&count).Error
 	if err != nil {
 		return false, fmt.Errorf("Erro ao verificar código: %w", err)
 	}
@@ -143,31 +317,57 @@ func (r *MySQLItemRepository) CodeExists(code string) (bool, error) {
 	return count > 0, nil
 }
 
-func (r *MySQLItemRepository) AddItem(item entity.Item) (entity.Item, error) {
+func (r *MySQLItemRepository) AddItem(ctx context.Context, item entity.Item) (entity.Item, error) {
 
 	model := FromEntity(item)
 
-	err := r.db.Create(&model).Error
+	// 📜 O preço inicial já entra no histórico, na mesma transação
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+		if err := registrarPreco(tx, nil, model, model.CreatedBy); err != nil {
+			return err
+		}
+		return sincronizarDepositoPadrao(tx, model.ID, model.Estoque)
+	})
 	if err != nil {
-		return entity.Item{}, fmt.Errorf("Erro ao criar item: %w", err)
+		return entity.Item{}, fmt.Errorf("Erro ao criar item: %w", traduzirErro(err))
 	}
 
 	return model.ToEntity(), nil
 
 }
 
-func (r *MySQLItemRepository) UpdateItem(item entity.Item) error {
+func (r *MySQLItemRepository) UpdateItem(ctx context.Context, item entity.Item) error {
 	model := FromEntity(item)
 
-	err := r.db.Save(&model).Error
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 🔒 Trava a linha: o histórico precisa do preço que está sendo trocado
+		var anterior ItemModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "preco_centavos", "moeda").
+			First(&anterior, item.ID).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Save(&model).Error; err != nil {
+			return err
+		}
+		if err := registrarPreco(tx, &anterior, model, model.UpdatedBy); err != nil {
+			return err
+		}
+		return sincronizarDepositoPadrao(tx, model.ID, model.Estoque)
+	})
 	if err != nil {
-		return fmt.Errorf("Erro ao atualiazar item :%w", err)
+		return fmt.Errorf("Erro ao atualiazar item :%w", traduzirErro(err))
 	}
 	return nil
 }
 
-func (r *MySQLItemRepository) DeleteItem(id int) error {
-	result := r.db.Delete(&ItemModel{}, id)
+func (r *MySQLItemRepository) DeleteItem(ctx context.Context, id int) error {
+	result := conexao(ctx, r.db).Delete(&ItemModel{}, id)
 
 	if result.Error != nil {
 		return fmt.Errorf("erro ao deletar item: %w", result.Error)
@@ -175,8 +375,31 @@ func (r *MySQLItemRepository) DeleteItem(id int) error {
 
 	// 🔍 Verificar se alguma linha foi afetada
 	if result.RowsAffected == 0 {
+		r.logger.WarnContext(ctx, "delete não afetou nenhuma linha", "item_id", id)
 		return fmt.Errorf("item com ID %d não encontrado", id)
 	}
 
 	return nil
 }
+
+// filtrarBusca aplica o termo de busca em nome, descrição e código
+func filtrarBusca(query *gorm.DB, termo string) *gorm.DB {
+	padrao := "%" + escaparLike(termo) + "%"
+	return query.Where("nome LIKE ? OR descricao LIKE ? OR code LIKE ?", padrao, padrao, padrao)
+}
+
+// escaparLike evita que % e _ digitados pelo usuário virem curingas no LIKE
+func escaparLike(termo string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(termo)
+}
+
+// registrarPreco grava em precos_historico quando o preço (ou a moeda) muda;
+// anterior nil é a criação do item
+func registrarPreco(tx *gorm.DB, anterior *ItemModel, atual ItemModel, alteradoPor *int) error {
+	if anterior != nil && anterior.PrecoCentavos == atual.PrecoCentavos && anterior.Moeda == atual.Moeda {
+		return nil
+	}
+
+	historico := novoHistoricoPreco(anterior, atual, alteradoPor)
+	return tx.Create(&historico).Error
+}
@@ -0,0 +1,29 @@
+package mysql
+
+import (
+	"desafio-itens-app/internal/adapters/migrate"
+	"embed"
+	"fmt"
+	"gorm.io/gorm"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var arquivosMigracao embed.FS
+
+// DirMigracoes é onde `migrate create` grava novos arquivos, relativo à raiz do repo
+const DirMigracoes = "internal/adapters/mysql/migrations"
+
+func NovoMigrator(db *gorm.DB) (*migrate.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter conexão: %w", err)
+	}
+
+	migracoes, err := fs.Sub(arquivosMigracao, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.New(sqlDB, migrate.MySQL, migracoes)
+}
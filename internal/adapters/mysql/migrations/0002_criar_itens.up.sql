@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS itens (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    code VARCHAR(50) NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    descricao VARCHAR(500) NULL,
+    preco DECIMAL(10,2) NOT NULL,
+    estoque BIGINT NOT NULL DEFAULT 0,
+    status ENUM('active','inactive') NULL DEFAULT 'active',
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    deleted_at DATETIME(3) NULL,
+    created_by BIGINT NULL,
+    updated_by BIGINT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_itens_code (code),
+    INDEX idx_itens_deleted_at (deleted_at),
+    INDEX idx_itens_created_by (created_by),
+    INDEX idx_itens_updated_by (updated_by),
+    CONSTRAINT fk_itens_created_by_user FOREIGN KEY (created_by) REFERENCES users (id),
+    CONSTRAINT fk_itens_updated_by_user FOREIGN KEY (updated_by) REFERENCES users (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
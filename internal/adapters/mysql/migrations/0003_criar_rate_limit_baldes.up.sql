@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS rate_limit_baldes (
+    chave VARCHAR(191) NOT NULL,
+    tokens DOUBLE NOT NULL,
+    atualizado_em DATETIME(6) NOT NULL,
+    PRIMARY KEY (chave),
+    INDEX idx_rate_limit_baldes_atualizado_em (atualizado_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS idempotencia_chaves (
+    chave CHAR(64) NOT NULL,
+    fingerprint CHAR(64) NOT NULL,
+    concluido BOOLEAN NOT NULL DEFAULT FALSE,
+    status_code INT NOT NULL DEFAULT 0,
+    content_type VARCHAR(100) NOT NULL DEFAULT '',
+    corpo MEDIUMBLOB NULL,
+    criado_em DATETIME(6) NOT NULL,
+    expira_em DATETIME(6) NOT NULL,
+    PRIMARY KEY (chave),
+    INDEX idx_idempotencia_chaves_expira_em (expira_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS importacao_jobs (
+    id CHAR(32) NOT NULL,
+    status ENUM('pendente','processando','concluido','falhou') NOT NULL,
+    total INT NOT NULL,
+    processadas INT NOT NULL DEFAULT 0,
+    relatorio LONGTEXT NULL,
+    erro VARCHAR(500) NOT NULL DEFAULT '',
+    criado_por BIGINT NOT NULL,
+    criado_em DATETIME(6) NOT NULL,
+    atualizado_em DATETIME(6) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_importacao_jobs_criado_por (criado_por)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,4 @@
+-- Itens em outras moedas voltam com o valor nominal (a coluna antiga não tinha moeda)
+ALTER TABLE itens ADD COLUMN preco DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER descricao;
+UPDATE itens SET preco = preco_centavos / 100;
+ALTER TABLE itens DROP COLUMN moeda, DROP COLUMN preco_centavos;
@@ -0,0 +1,29 @@
+-- Preço passa a ser inteiro em centavos + moeda (ISO 4217). Os valores
+-- atuais (todos em reais) são convertidos sem perda: DECIMAL(10,2) × 100 é exato.
+-- Cada passo confere o schema antes (o MySQL faz commit a cada DDL): se a
+-- migration parar no meio, rodar de novo continua de onde parou.
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'preco_centavos') = 0,
+    'ALTER TABLE itens ADD COLUMN preco_centavos BIGINT NOT NULL DEFAULT 0 AFTER preco',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'moeda') = 0,
+    'ALTER TABLE itens ADD COLUMN moeda CHAR(3) NOT NULL DEFAULT ''BRL'' AFTER preco_centavos',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+-- A conversão só roda enquanto a coluna antiga existe
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'preco') = 1,
+    'UPDATE itens SET preco_centavos = CAST(preco * 100 AS SIGNED)',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'preco') = 1,
+    'ALTER TABLE itens DROP COLUMN preco',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
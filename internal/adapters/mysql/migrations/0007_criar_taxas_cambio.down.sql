@@ -0,0 +1 @@
+DROP TABLE IF EXISTS taxas_cambio;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS taxas_cambio (
+    moeda_origem CHAR(3) NOT NULL,
+    moeda_destino CHAR(3) NOT NULL,
+    taxa DECIMAL(24,10) NOT NULL,
+    atualizada_em DATETIME(6) NOT NULL,
+    atualizada_por BIGINT NULL,
+    PRIMARY KEY (moeda_origem, moeda_destino)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS precos_historico (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    item_id BIGINT NOT NULL,
+    preco_anterior_centavos BIGINT NULL,
+    moeda_anterior CHAR(3) NULL,
+    preco_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    alterado_em DATETIME(3) NOT NULL,
+    alterado_por BIGINT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_precos_historico_item_id (item_id, alterado_em),
+    CONSTRAINT fk_precos_historico_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS precos_agendados (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    item_id BIGINT NOT NULL,
+    preco_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    vigente_a_partir_de DATETIME(3) NOT NULL,
+    status ENUM('pendente','aplicado','cancelado','falhou') NOT NULL DEFAULT 'pendente',
+    erro VARCHAR(500) NOT NULL DEFAULT '',
+    criado_por BIGINT NOT NULL,
+    criado_em DATETIME(3) NOT NULL,
+    aplicado_em DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_precos_agendados_item_id (item_id),
+    INDEX idx_precos_agendados_vencidos (status, vigente_a_partir_de),
+    CONSTRAINT fk_precos_agendados_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+-- Preço atual de quem ainda não tem histórico: rodar de novo não duplica
+INSERT INTO precos_historico (item_id, preco_centavos, moeda, alterado_em, alterado_por)
+SELECT id, preco_centavos, moeda, COALESCE(updated_at, created_at, NOW(3)), COALESCE(updated_by, created_by)
+FROM itens
+WHERE NOT EXISTS (SELECT 1 FROM precos_historico h WHERE h.item_id = itens.id);
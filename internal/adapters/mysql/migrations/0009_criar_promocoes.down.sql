@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS promocoes;
+ALTER TABLE itens DROP INDEX idx_itens_categoria, DROP COLUMN tags, DROP COLUMN categoria;
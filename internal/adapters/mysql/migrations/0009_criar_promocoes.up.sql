@@ -0,0 +1,43 @@
+-- Categoria e tags do item são os alvos das promoções, além do próprio item.
+-- Colunas e índice só entram se ainda não existem: rodar de novo é seguro.
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'categoria') = 0,
+    'ALTER TABLE itens ADD COLUMN categoria VARCHAR(50) NOT NULL DEFAULT '''' AFTER descricao',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'tags') = 0,
+    'ALTER TABLE itens ADD COLUMN tags JSON NULL AFTER categoria',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = 'itens' AND index_name = 'idx_itens_categoria') = 0,
+    'ALTER TABLE itens ADD INDEX idx_itens_categoria (categoria)',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+CREATE TABLE IF NOT EXISTS promocoes (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    nome VARCHAR(100) NOT NULL,
+    tipo ENUM('percentual','valor_fixo') NOT NULL,
+    percentual BIGINT NOT NULL DEFAULT 0,
+    desconto_centavos BIGINT NOT NULL DEFAULT 0,
+    moeda CHAR(3) NULL,
+    alvo ENUM('item','categoria','tag') NOT NULL,
+    item_id BIGINT NULL,
+    categoria VARCHAR(50) NOT NULL DEFAULT '',
+    tag VARCHAR(50) NOT NULL DEFAULT '',
+    inicio DATETIME(3) NOT NULL,
+    fim DATETIME(3) NOT NULL,
+    prioridade INT NOT NULL DEFAULT 0,
+    cumulativa BOOLEAN NOT NULL DEFAULT FALSE,
+    ativa BOOLEAN NOT NULL DEFAULT TRUE,
+    criado_por BIGINT NOT NULL,
+    criado_em DATETIME(3) NOT NULL,
+    atualizado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_promocoes_item_id (item_id),
+    INDEX idx_promocoes_vigencia (ativa, fim, inicio)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,41 @@
+-- Estoque por depósito. itens.estoque continua sendo o total; o estoque
+-- atual de cada item vai para o depósito padrão.
+CREATE TABLE IF NOT EXISTS depositos (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    codigo VARCHAR(20) NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    padrao BOOLEAN NOT NULL DEFAULT FALSE,
+    criado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_depositos_codigo (codigo)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS saldos_estoque (
+    item_id BIGINT NOT NULL,
+    deposito_id BIGINT NOT NULL,
+    quantidade BIGINT NOT NULL DEFAULT 0,
+    atualizado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (item_id, deposito_id),
+    INDEX idx_saldos_estoque_deposito_id (deposito_id),
+    CONSTRAINT fk_saldos_estoque_item FOREIGN KEY (item_id) REFERENCES itens (id),
+    CONSTRAINT fk_saldos_estoque_deposito FOREIGN KEY (deposito_id) REFERENCES depositos (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS transferencias_estoque (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    item_id BIGINT NOT NULL,
+    deposito_origem BIGINT NOT NULL,
+    deposito_destino BIGINT NOT NULL,
+    quantidade BIGINT NOT NULL,
+    usuario_id BIGINT NULL,
+    criado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_transferencias_estoque_item_id (item_id, criado_em),
+    CONSTRAINT fk_transferencias_estoque_item FOREIGN KEY (item_id) REFERENCES itens (id),
+    CONSTRAINT fk_transferencias_estoque_origem FOREIGN KEY (deposito_origem) REFERENCES depositos (id),
+    CONSTRAINT fk_transferencias_estoque_destino FOREIGN KEY (deposito_destino) REFERENCES depositos (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+-- Rodar de novo não duplica nada: o código e a chave (item, depósito) são únicos
+INSERT IGNORE INTO depositos (codigo, nome, padrao, criado_em) VALUES ('PRINCIPAL', 'Depósito principal', TRUE, NOW(3));
+INSERT IGNORE INTO saldos_estoque (item_id, deposito_id, quantidade, atualizado_em)
+SELECT i.id, d.id, i.estoque, NOW(3)
+FROM itens i CROSS JOIN depositos d
+WHERE d.padrao AND i.estoque <> 0;
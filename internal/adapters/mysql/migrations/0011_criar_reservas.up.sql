@@ -0,0 +1,16 @@
+-- Reservas de estoque. Disponível = itens.estoque − reservas ativas que
+-- ainda não venceram.
+CREATE TABLE IF NOT EXISTS reservas (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    item_id BIGINT NOT NULL,
+    quantidade BIGINT NOT NULL,
+    status ENUM('ativa','confirmada','cancelada','expirada') NOT NULL DEFAULT 'ativa',
+    usuario_id BIGINT NOT NULL,
+    expira_em DATETIME(3) NOT NULL,
+    criado_em DATETIME(3) NOT NULL,
+    concluida_em DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_reservas_item_id (item_id, status),
+    INDEX idx_reservas_vencidas (status, expira_em),
+    CONSTRAINT fk_reservas_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE itens DROP COLUMN estoque_em_alerta, DROP COLUMN quantidade_reposicao, DROP COLUMN ponto_reposicao;
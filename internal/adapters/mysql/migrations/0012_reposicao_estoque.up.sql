@@ -0,0 +1,21 @@
+-- Ponto e quantidade de reposição; estoque_em_alerta guarda o último
+-- estado avisado para o alerta sair uma vez por cruzamento. Uma coluna por
+-- ALTER, cada uma só se ainda não existe: rodar de novo é seguro.
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'ponto_reposicao') = 0,
+    'ALTER TABLE itens ADD COLUMN ponto_reposicao BIGINT NOT NULL DEFAULT 0 AFTER status',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'quantidade_reposicao') = 0,
+    'ALTER TABLE itens ADD COLUMN quantidade_reposicao BIGINT NOT NULL DEFAULT 0 AFTER ponto_reposicao',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'estoque_em_alerta') = 0,
+    'ALTER TABLE itens ADD COLUMN estoque_em_alerta BOOLEAN NOT NULL DEFAULT FALSE AFTER quantidade_reposicao',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,67 @@
+-- Fornecedores, itens de cada fornecedor e pedidos de compra. Receber uma
+-- linha soma a quantidade ao estoque do item.
+CREATE TABLE IF NOT EXISTS fornecedores (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    nome VARCHAR(100) NOT NULL,
+    documento VARCHAR(30) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    telefone VARCHAR(30) NOT NULL DEFAULT '',
+    criado_em DATETIME(3) NOT NULL,
+    atualizado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS itens_fornecedores (
+    item_id BIGINT NOT NULL,
+    fornecedor_id BIGINT NOT NULL,
+    sku VARCHAR(50) NOT NULL DEFAULT '',
+    custo_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    atualizado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (item_id, fornecedor_id),
+    INDEX idx_itens_fornecedores_fornecedor_id (fornecedor_id),
+    CONSTRAINT fk_itens_fornecedores_item FOREIGN KEY (item_id) REFERENCES itens (id),
+    CONSTRAINT fk_itens_fornecedores_fornecedor FOREIGN KEY (fornecedor_id) REFERENCES fornecedores (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS pedidos_compra (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    fornecedor_id BIGINT NOT NULL,
+    status ENUM('rascunho','enviado','parcialmente_recebido','recebido','cancelado') NOT NULL DEFAULT 'rascunho',
+    observacao VARCHAR(500) NOT NULL DEFAULT '',
+    criado_por BIGINT NOT NULL,
+    criado_em DATETIME(3) NOT NULL,
+    atualizado_em DATETIME(3) NOT NULL,
+    enviado_em DATETIME(3) NULL,
+    recebido_em DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_pedidos_compra_fornecedor_id (fornecedor_id),
+    INDEX idx_pedidos_compra_status (status, criado_em),
+    CONSTRAINT fk_pedidos_compra_fornecedor FOREIGN KEY (fornecedor_id) REFERENCES fornecedores (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS pedidos_compra_linhas (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    pedido_id BIGINT NOT NULL,
+    item_id BIGINT NOT NULL,
+    quantidade BIGINT NOT NULL,
+    recebida BIGINT NOT NULL DEFAULT 0,
+    custo_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_pedidos_compra_linhas_item (pedido_id, item_id),
+    CONSTRAINT fk_pedidos_compra_linhas_pedido FOREIGN KEY (pedido_id) REFERENCES pedidos_compra (id),
+    CONSTRAINT fk_pedidos_compra_linhas_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS recebimentos_compra (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    pedido_id BIGINT NOT NULL,
+    linha_id BIGINT NOT NULL,
+    item_id BIGINT NOT NULL,
+    quantidade BIGINT NOT NULL,
+    recebido_por BIGINT NOT NULL,
+    recebido_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_recebimentos_compra_pedido_id (pedido_id),
+    INDEX idx_recebimentos_compra_item_id (item_id),
+    CONSTRAINT fk_recebimentos_compra_pedido FOREIGN KEY (pedido_id) REFERENCES pedidos_compra (id),
+    CONSTRAINT fk_recebimentos_compra_linha FOREIGN KEY (linha_id) REFERENCES pedidos_compra_linhas (id),
+    CONSTRAINT fk_recebimentos_compra_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
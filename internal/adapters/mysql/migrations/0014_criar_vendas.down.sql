@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS pedidos_venda_linhas;
+DROP TABLE IF EXISTS pedidos_venda;
@@ -0,0 +1,28 @@
+-- Pedidos de venda. A confirmação baixa itens.estoque; o cancelamento de
+-- um pedido confirmado devolve.
+CREATE TABLE IF NOT EXISTS pedidos_venda (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    usuario_id BIGINT NOT NULL,
+    status ENUM('pendente','confirmado','cancelado') NOT NULL DEFAULT 'pendente',
+    criado_em DATETIME(3) NOT NULL,
+    atualizado_em DATETIME(3) NOT NULL,
+    confirmado_em DATETIME(3) NULL,
+    cancelado_em DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_pedidos_venda_usuario_id (usuario_id, criado_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS pedidos_venda_linhas (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    pedido_id BIGINT NOT NULL,
+    item_id BIGINT NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    quantidade BIGINT NOT NULL,
+    preco_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_pedidos_venda_linhas_pedido_id (pedido_id),
+    INDEX idx_pedidos_venda_linhas_item_id (item_id),
+    CONSTRAINT fk_pedidos_venda_linhas_pedido FOREIGN KEY (pedido_id) REFERENCES pedidos_venda (id),
+    CONSTRAINT fk_pedidos_venda_linhas_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+ALTER TABLE itens DROP FOREIGN KEY fk_itens_produto;
+ALTER TABLE itens DROP INDEX idx_itens_produto_id, DROP COLUMN preco_proprio, DROP COLUMN opcoes, DROP COLUMN eixos, DROP COLUMN produto_id;
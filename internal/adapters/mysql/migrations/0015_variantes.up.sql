@@ -0,0 +1,39 @@
+-- Variantes: o produto guarda os eixos (tamanho, cor...) e cada variante é
+-- um item com produto_id, um valor por eixo e estoque próprio. Cada coluna,
+-- índice e FK só entra se ainda não existe: rodar de novo é seguro.
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'produto_id') = 0,
+    'ALTER TABLE itens ADD COLUMN produto_id BIGINT NULL AFTER code',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'eixos') = 0,
+    'ALTER TABLE itens ADD COLUMN eixos JSON NULL AFTER tags',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'opcoes') = 0,
+    'ALTER TABLE itens ADD COLUMN opcoes JSON NULL AFTER eixos',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'preco_proprio') = 0,
+    'ALTER TABLE itens ADD COLUMN preco_proprio BOOLEAN NOT NULL DEFAULT FALSE AFTER moeda',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = 'itens' AND index_name = 'idx_itens_produto_id') = 0,
+    'ALTER TABLE itens ADD INDEX idx_itens_produto_id (produto_id)',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.table_constraints WHERE table_schema = DATABASE() AND table_name = 'itens' AND constraint_name = 'fk_itens_produto') = 0,
+    'ALTER TABLE itens ADD CONSTRAINT fk_itens_produto FOREIGN KEY (produto_id) REFERENCES itens (id)',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,20 @@
+-- Imagens dos itens. Os arquivos (original e miniatura) ficam no BlobStore;
+-- aqui só as chaves, dimensões, ordem e qual é a principal.
+CREATE TABLE IF NOT EXISTS itens_imagens (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    item_id BIGINT NOT NULL,
+    chave VARCHAR(255) NOT NULL,
+    chave_miniatura VARCHAR(255) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    tamanho BIGINT NOT NULL,
+    largura INT NOT NULL,
+    altura INT NOT NULL,
+    ordem INT NOT NULL,
+    principal BOOLEAN NOT NULL DEFAULT FALSE,
+    criado_por BIGINT NULL,
+    criado_em DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_itens_imagens_chave (chave),
+    INDEX idx_itens_imagens_item_id (item_id, ordem),
+    CONSTRAINT fk_itens_imagens_item FOREIGN KEY (item_id) REFERENCES itens (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
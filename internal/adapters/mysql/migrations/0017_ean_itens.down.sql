@@ -0,0 +1 @@
+ALTER TABLE itens DROP INDEX idx_itens_ean, DROP COLUMN ean;
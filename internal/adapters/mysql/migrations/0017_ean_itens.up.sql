@@ -0,0 +1,14 @@
+-- EAN-13 opcional do fabricante; itens sem EAN usam o interno, derivado do
+-- id. Coluna e índice em passos separados, cada um só se ainda não existe.
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'itens' AND column_name = 'ean') = 0,
+    'ALTER TABLE itens ADD COLUMN ean VARCHAR(13) NULL AFTER code',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = 'itens' AND index_name = 'idx_itens_ean') = 0,
+    'ALTER TABLE itens ADD UNIQUE INDEX idx_itens_ean (ean)',
+    'DO 0');
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,172 @@
+package mysql
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/compra"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MySQLPedidoCompraRepository struct {
+	db *gorm.DB
+}
+
+func NewMySQLPedidoCompraRepository(db *gorm.DB) *MySQLPedidoCompraRepository {
+	return &MySQLPedidoCompraRepository{db: db}
+}
+
+func (r *MySQLPedidoCompraRepository) Listar(ctx context.Context, filtro compra.Filtro) ([]compra.Pedido, error) {
+	var models []PedidoCompraModel
+
+	query := conexao(ctx, r.db).Preload("Linhas", ordenarLinhas)
+	if filtro.Status != "" {
+		query = query.Where("status = ?", string(filtro.Status))
+	}
+	if filtro.FornecedorID != 0 {
+		query = query.Where("fornecedor_id = ?", filtro.FornecedorID)
+	}
+	if err := query.Order("criado_em DESC, id DESC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("Erro ao listar pedidos de compra: %w", err)
+	}
+
+	pedidos := make([]compra.Pedido, 0, len(models))
+	for _, model := range models {
+		pedidos = append(pedidos, model.toEntity())
+	}
+	return pedidos, nil
+}
+
+func (r *MySQLPedidoCompraRepository) GetByID(ctx context.Context, id int) (*compra.Pedido, error) {
+	return buscarPedidoCompra(conexao(ctx, r.db), id)
+}
+
+func (r *MySQLPedidoCompraRepository) Travar(ctx context.Context, id int) (*compra.Pedido, error) {
+	return buscarPedidoCompra(conexao(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *MySQLPedidoCompraRepository) Criar(ctx context.Context, p compra.Pedido) (compra.Pedido, error) {
+	model := fromPedidoCompraEntity(p)
+
+	// O GORM grava as linhas junto, na mesma transação
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return compra.Pedido{}, fmt.Errorf("Erro ao criar pedido de compra: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *MySQLPedidoCompraRepository) Atualizar(ctx context.Context, p compra.Pedido) (compra.Pedido, error) {
+	var atualizado *compra.Pedido
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PedidoCompraModel{ID: p.ID}).Updates(map[string]any{
+			"status":        string(p.Status),
+			"observacao":    p.Observacao,
+			"atualizado_em": p.AtualizadoEm,
+			"enviado_em":    p.EnviadoEm,
+			"recebido_em":   p.RecebidoEm,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return compra.ErrPedidoNaoEncontrado
+		}
+
+		if err := gravarLinhasCompra(tx, p); err != nil {
+			return err
+		}
+
+		var err error
+		atualizado, err = buscarPedidoCompra(tx, p.ID)
+		return err
+	})
+	if err != nil {
+		return compra.Pedido{}, fmt.Errorf("Erro ao atualizar pedido de compra: %w", err)
+	}
+	return *atualizado, nil
+}
+
+func (r *MySQLPedidoCompraRepository) RegistrarRecebimentos(ctx context.Context, recebimentos []compra.Recebimento) error {
+	if len(recebimentos) == 0 {
+		return nil
+	}
+
+	models := make([]RecebimentoCompraModel, 0, len(recebimentos))
+	for _, rec := range recebimentos {
+		models = append(models, RecebimentoCompraModel{
+			PedidoID:    rec.PedidoID,
+			LinhaID:     rec.LinhaID,
+			ItemID:      rec.ItemID,
+			Quantidade:  rec.Quantidade,
+			RecebidoPor: rec.RecebidoPor,
+			RecebidoEm:  rec.RecebidoEm,
+		})
+	}
+	if err := conexao(ctx, r.db).Create(&models).Error; err != nil {
+		return fmt.Errorf("Erro ao registrar recebimentos: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLPedidoCompraRepository) Recebimentos(ctx context.Context, pedidoID int) ([]compra.Recebimento, error) {
+	var models []RecebimentoCompraModel
+
+	err := conexao(ctx, r.db).Where("pedido_id = ?", pedidoID).Order("recebido_em, id").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar recebimentos: %w", err)
+	}
+
+	recebimentos := make([]compra.Recebimento, 0, len(models))
+	for _, model := range models {
+		recebimentos = append(recebimentos, model.toEntity())
+	}
+	return recebimentos, nil
+}
+
+func buscarPedidoCompra(db *gorm.DB, id int) (*compra.Pedido, error) {
+	var model PedidoCompraModel
+
+	err := db.Preload("Linhas", ordenarLinhas).First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, compra.ErrPedidoNaoEncontrado
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar pedido de compra: %w", err)
+	}
+
+	p := model.toEntity()
+	return &p, nil
+}
+
+// gravarLinhasCompra: o rascunho troca todas as linhas; depois de enviado
+// só a quantidade recebida muda
+func gravarLinhasCompra(tx *gorm.DB, p compra.Pedido) error {
+	if p.Status == compra.StatusRascunho {
+		if err := tx.Where("pedido_id = ?", p.ID).Delete(&PedidoCompraLinhaModel{}).Error; err != nil {
+			return err
+		}
+		linhas := make([]PedidoCompraLinhaModel, 0, len(p.Linhas))
+		for _, linha := range p.Linhas {
+			model := fromLinhaCompraEntity(p.ID, linha)
+			model.ID = 0
+			linhas = append(linhas, model)
+		}
+		if len(linhas) == 0 {
+			return nil
+		}
+		return tx.Create(&linhas).Error
+	}
+
+	for _, linha := range p.Linhas {
+		err := tx.Model(&PedidoCompraLinhaModel{ID: linha.ID}).Update("recebida", linha.Recebida).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ordenarLinhas(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/venda"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MySQLPedidoVendaRepository struct {
+	db *gorm.DB
+}
+
+func NewMySQLPedidoVendaRepository(db *gorm.DB) *MySQLPedidoVendaRepository {
+	return &MySQLPedidoVendaRepository{db: db}
+}
+
+func (r *MySQLPedidoVendaRepository) Criar(ctx context.Context, p venda.Pedido) (venda.Pedido, error) {
+	model := fromPedidoVendaEntity(p)
+
+	// O GORM grava as linhas junto, na mesma transação
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return venda.Pedido{}, fmt.Errorf("Erro ao criar pedido: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *MySQLPedidoVendaRepository) GetByID(ctx context.Context, id int) (*venda.Pedido, error) {
+	return buscarPedidoVenda(conexao(ctx, r.db), id)
+}
+
+func (r *MySQLPedidoVendaRepository) Travar(ctx context.Context, id int) (*venda.Pedido, error) {
+	return buscarPedidoVenda(conexao(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *MySQLPedidoVendaRepository) Atualizar(ctx context.Context, p venda.Pedido) error {
+	result := conexao(ctx, r.db).
+		Model(&PedidoVendaModel{ID: p.ID}).
+		Updates(map[string]any{
+			"status":        string(p.Status),
+			"atualizado_em": p.AtualizadoEm,
+			"confirmado_em": p.ConfirmadoEm,
+			"cancelado_em":  p.CanceladoEm,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao atualizar pedido: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return venda.ErrPedidoNaoEncontrado
+	}
+	return nil
+}
+
+func (r *MySQLPedidoVendaRepository) ListarPorUsuario(ctx context.Context, usuarioID, offset, limit int) ([]venda.Pedido, int, error) {
+	var (
+		models []PedidoVendaModel
+		total  int64
+	)
+
+	query := conexao(ctx, r.db).Model(&PedidoVendaModel{}).Where("usuario_id = ?", usuarioID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("Erro ao contar pedidos: %w", err)
+	}
+	err := query.
+		Preload("Linhas", ordenarLinhas).
+		Order("criado_em DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("Erro ao listar pedidos: %w", err)
+	}
+
+	pedidos := make([]venda.Pedido, 0, len(models))
+	for _, model := range models {
+		pedidos = append(pedidos, model.toEntity())
+	}
+	return pedidos, int(total), nil
+}
+
+func (r *MySQLPedidoVendaRepository) TravarItens(ctx context.Context, itemIDs []int) error {
+	return travarItens(conexao(ctx, r.db), itemIDs)
+}
+
+func buscarPedidoVenda(db *gorm.DB, id int) (*venda.Pedido, error) {
+	var model PedidoVendaModel
+
+	err := db.Preload("Linhas", ordenarLinhas).First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, venda.ErrPedidoNaoEncontrado
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar pedido: %w", err)
+	}
+
+	p := model.toEntity()
+	return &p, nil
+}
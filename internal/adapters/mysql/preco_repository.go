@@ -0,0 +1,111 @@
+package mysql
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/preco"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type MySQLPrecoRepository struct {
+	db *gorm.DB
+}
+
+func NewMySQLPrecoRepository(db *gorm.DB) *MySQLPrecoRepository {
+	return &MySQLPrecoRepository{db: db}
+}
+
+func (r *MySQLPrecoRepository) ListarHistorico(ctx context.Context, itemID int) ([]preco.Alteracao, error) {
+	var models []HistoricoPrecoModel
+
+	err := conexao(ctx, r.db).
+		Where("item_id = ?", itemID).
+		Order("alterado_em DESC, id DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar histórico de preços: %w", err)
+	}
+
+	historico := make([]preco.Alteracao, 0, len(models))
+	for _, model := range models {
+		historico = append(historico, model.toEntity())
+	}
+	return historico, nil
+}
+
+func (r *MySQLPrecoRepository) Agendar(ctx context.Context, agendamento preco.Agendamento) (preco.Agendamento, error) {
+	model := fromAgendamentoEntity(agendamento)
+
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return preco.Agendamento{}, fmt.Errorf("Erro ao agendar preço: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *MySQLPrecoRepository) ListarAgendamentos(ctx context.Context, itemID int) ([]preco.Agendamento, error) {
+	var models []PrecoAgendadoModel
+
+	err := conexao(ctx, r.db).
+		Where("item_id = ?", itemID).
+		Order("vigente_a_partir_de DESC, id DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar preços agendados: %w", err)
+	}
+
+	agendamentos := make([]preco.Agendamento, 0, len(models))
+	for _, model := range models {
+		agendamentos = append(agendamentos, model.toEntity())
+	}
+	return agendamentos, nil
+}
+
+func (r *MySQLPrecoRepository) CancelarAgendamento(ctx context.Context, itemID, id int) error {
+	result := conexao(ctx, r.db).
+		Model(&PrecoAgendadoModel{}).
+		Where("id = ? AND item_id = ? AND status = ?", id, itemID, string(preco.StatusPendente)).
+		Update("status", string(preco.StatusCancelado))
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao cancelar preço agendado: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return preco.ErrAgendamentoNaoEncontrado
+	}
+	return nil
+}
+
+func (r *MySQLPrecoRepository) Vencidos(ctx context.Context, ate time.Time, limite int) ([]preco.Agendamento, error) {
+	var models []PrecoAgendadoModel
+
+	err := conexao(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND vigente_a_partir_de <= ?", string(preco.StatusPendente), ate).
+		Order("vigente_a_partir_de, id").
+		Limit(limite).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar preços agendados vencidos: %w", err)
+	}
+
+	agendamentos := make([]preco.Agendamento, 0, len(models))
+	for _, model := range models {
+		agendamentos = append(agendamentos, model.toEntity())
+	}
+	return agendamentos, nil
+}
+
+func (r *MySQLPrecoRepository) Concluir(ctx context.Context, agendamento preco.Agendamento) error {
+	err := conexao(ctx, r.db).
+		Model(&PrecoAgendadoModel{ID: agendamento.ID}).
+		Updates(map[string]any{
+			"status":      string(agendamento.Status),
+			"erro":        agendamento.Erro,
+			"aplicado_em": agendamento.AplicadoEm,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("Erro ao concluir preço agendado: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/promocao"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"time"
+)
+
+type MySQLPromocaoRepository struct {
+	db *gorm.DB
+}
+
+func NewMySQLPromocaoRepository(db *gorm.DB) *MySQLPromocaoRepository {
+	return &MySQLPromocaoRepository{db: db}
+}
+
+func (r *MySQLPromocaoRepository) Listar(ctx context.Context) ([]promocao.Promocao, error) {
+	var models []PromocaoModel
+
+	err := conexao(ctx, r.db).Order("inicio DESC, id DESC").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar promoções: %w", err)
+	}
+	return promocoesDosModels(models), nil
+}
+
+func (r *MySQLPromocaoRepository) Vigentes(ctx context.Context, agora time.Time) ([]promocao.Promocao, error) {
+	var models []PromocaoModel
+
+	err := conexao(ctx, r.db).
+		Where("ativa = ? AND inicio <= ? AND fim > ?", true, agora, agora).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar promoções vigentes: %w", err)
+	}
+	return promocoesDosModels(models), nil
+}
+
+func (r *MySQLPromocaoRepository) GetByID(ctx context.Context, id int) (*promocao.Promocao, error) {
+	var model PromocaoModel
+
+	err := conexao(ctx, r.db).First(&model, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, promocao.ErrPromocaoNaoEncontrada
+		}
+		return nil, fmt.Errorf("Erro ao buscar promoção: %w", err)
+	}
+
+	p := model.toEntity()
+	return &p, nil
+}
+
+func (r *MySQLPromocaoRepository) Criar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error) {
+	model := fromPromocaoEntity(p)
+
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return promocao.Promocao{}, fmt.Errorf("Erro ao criar promoção: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *MySQLPromocaoRepository) Atualizar(ctx context.Context, p promocao.Promocao) error {
+	model := fromPromocaoEntity(p)
+
+	if err := conexao(ctx, r.db).Save(&model).Error; err != nil {
+		return fmt.Errorf("Erro ao atualizar promoção: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLPromocaoRepository) Remover(ctx context.Context, id int) error {
+	result := conexao(ctx, r.db).Delete(&PromocaoModel{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao remover promoção: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return promocao.ErrPromocaoNaoEncontrada
+	}
+	return nil
+}
+
+func promocoesDosModels(models []PromocaoModel) []promocao.Promocao {
+	promocoes := make([]promocao.Promocao, 0, len(models))
+	for _, model := range models {
+		promocoes = append(promocoes, model.toEntity())
+	}
+	return promocoes
+}
@@ -0,0 +1,206 @@
+package mysql
+
+import (
+	"context"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/reserva"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type MySQLReservaRepository struct {
+	db *gorm.DB
+}
+
+func NewMySQLReservaRepository(db *gorm.DB) *MySQLReservaRepository {
+	return &MySQLReservaRepository{db: db}
+}
+
+func (r *MySQLReservaRepository) Reservar(ctx context.Context, res reserva.Reserva) (reserva.Reserva, error) {
+	model := ReservaModel{
+		ItemID:     res.ItemID,
+		Quantidade: res.Quantidade,
+		Status:     string(res.Status),
+		UsuarioID:  res.UsuarioID,
+		ExpiraEm:   res.ExpiraEm,
+		CriadoEm:   res.CriadoEm,
+	}
+
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 🔒 Com o item travado, duas reservas não disputam o mesmo estoque
+		var item ItemModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "estoque").First(&item, res.ItemID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.ErrItemNaoEncontrado
+		}
+		if err != nil {
+			return err
+		}
+
+		// A soma vem depois da trava: no REPEATABLE READ a foto da transação
+		// é tirada na primeira leitura comum, e aí já vê as reservas de quem
+		// travou o item antes
+		reservados, err := somarReservados(tx, []int{res.ItemID}, res.CriadoEm)
+		if err != nil {
+			return err
+		}
+		disponivel := reserva.Disponivel(item.Estoque, reservados[res.ItemID])
+		if disponivel < res.Quantidade {
+			return fmt.Errorf("%w: disponível %d", reserva.ErrEstoqueIndisponivel, disponivel)
+		}
+		return tx.Create(&model).Error
+	})
+	if err != nil {
+		return reserva.Reserva{}, fmt.Errorf("Erro ao reservar estoque: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *MySQLReservaRepository) GetByID(ctx context.Context, id int) (*reserva.Reserva, error) {
+	return buscarReserva(conexao(ctx, r.db), id)
+}
+
+func (r *MySQLReservaRepository) Travar(ctx context.Context, id int) (*reserva.Reserva, error) {
+	return buscarReserva(conexao(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *MySQLReservaRepository) Concluir(ctx context.Context, res reserva.Reserva) error {
+	err := conexao(ctx, r.db).
+		Model(&ReservaModel{ID: res.ID}).
+		Updates(map[string]any{
+			"status":       string(res.Status),
+			"concluida_em": res.ConcluidaEm,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("Erro ao concluir reserva: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLReservaRepository) Reservados(ctx context.Context, itemIDs []int, agora time.Time) (map[int]int, error) {
+	if len(itemIDs) == 0 {
+		return map[int]int{}, nil
+	}
+
+	reservados, err := somarReservados(conexao(ctx, r.db), itemIDs, agora)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao somar reservas: %w", err)
+	}
+	return reservados, nil
+}
+
+func (r *MySQLReservaRepository) ReservadosPorOutros(ctx context.Context, itemIDs []int, usuarioID int, agora time.Time) (map[int]int, error) {
+	if len(itemIDs) == 0 {
+		return map[int]int{}, nil
+	}
+
+	reservados, err := somarReservados(conexao(ctx, r.db).Where("usuario_id <> ?", usuarioID), itemIDs, agora)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao somar reservas: %w", err)
+	}
+	return reservados, nil
+}
+
+func (r *MySQLReservaRepository) VigentesDoUsuario(ctx context.Context, itemIDs []int, usuarioID int, agora time.Time) ([]reserva.Reserva, error) {
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+
+	var models []ReservaModel
+	err := conexao(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("item_id IN ? AND usuario_id = ? AND status = ? AND expira_em > ?", itemIDs, usuarioID, string(reserva.StatusAtiva), agora).
+		Order("item_id, expira_em, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar reservas do usuário: %w", err)
+	}
+
+	reservas := make([]reserva.Reserva, len(models))
+	for i, model := range models {
+		reservas[i] = model.toEntity()
+	}
+	return reservas, nil
+}
+
+func (r *MySQLReservaRepository) Dividir(ctx context.Context, restante, parte reserva.Reserva) (reserva.Reserva, error) {
+	model := ReservaModel{
+		ItemID:      parte.ItemID,
+		Quantidade:  parte.Quantidade,
+		Status:      string(parte.Status),
+		UsuarioID:   parte.UsuarioID,
+		ExpiraEm:    parte.ExpiraEm,
+		CriadoEm:    parte.CriadoEm,
+		ConcluidaEm: parte.ConcluidaEm,
+	}
+
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ReservaModel{ID: restante.ID}).Update("quantidade", restante.Quantidade).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&model).Error
+	})
+	if err != nil {
+		return reserva.Reserva{}, fmt.Errorf("Erro ao dividir reserva: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// Expirar é um UPDATE só: uma reserva travada por quem está confirmando
+// espera o commit e é conferida de novo, então não expira depois de
+// confirmada
+func (r *MySQLReservaRepository) Expirar(ctx context.Context, agora time.Time) (int64, error) {
+	result := conexao(ctx, r.db).
+		Model(&ReservaModel{}).
+		Where("status = ? AND expira_em <= ?", string(reserva.StatusAtiva), agora).
+		Updates(map[string]any{
+			"status":       string(reserva.StatusExpirada),
+			"concluida_em": agora.UTC(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("Erro ao expirar reservas: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func buscarReserva(db *gorm.DB, id int) (*reserva.Reserva, error) {
+	var model ReservaModel
+
+	err := db.First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, reserva.ErrReservaNaoEncontrada
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar reserva: %w", err)
+	}
+
+	res := model.toEntity()
+	return &res, nil
+}
+
+// somarReservados soma por item as reservas ativas que ainda não venceram
+func somarReservados(db *gorm.DB, itemIDs []int, agora time.Time) (map[int]int, error) {
+	var linhas []struct {
+		ItemID int
+		Total  int
+	}
+
+	err := db.Model(&ReservaModel{}).
+		Select("item_id, SUM(quantidade) AS total").
+		Where("item_id IN ? AND status = ? AND expira_em > ?", itemIDs, string(reserva.StatusAtiva), agora).
+		Group("item_id").
+		Scan(&linhas).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reservados := make(map[int]int, len(linhas))
+	for _, linha := range linhas {
+		reservados[linha.ItemID] = linha.Total
+	}
+	return reservados, nil
+}
@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"gorm.io/gorm"
+)
+
+type (
+	chaveTransacao  struct{}
+	chaveAposCommit struct{}
+)
+
+// MySQLTransacionador abre a transação e a carrega no contexto; conexao()
+// usa essa transação em vez do pool enquanto ela existir. A transação é READ
+// COMMITTED, como no Postgres: os services travam a linha e depois leem, e
+// no REPEATABLE READ a leitura depois da trava ainda podia vir da foto
+// tirada na primeira leitura da transação, antes do commit de quem segurava
+// a trava.
+type MySQLTransacionador struct {
+	db *gorm.DB
+}
+
+func NewMySQLTransacionador(db *gorm.DB) *MySQLTransacionador {
+	return &MySQLTransacionador{db: db}
+}
+
+func (t *MySQLTransacionador) EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error {
+	if transacaoDoContexto(ctx) != nil {
+		return fn(ctx) // Já dentro de uma transação: participa dela
+	}
+
+	var aposCommit []func()
+	ctx = context.WithValue(ctx, chaveAposCommit{}, &aposCommit)
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, chaveTransacao{}, tx))
+	}, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range aposCommit {
+		f()
+	}
+	return nil
+}
+
+func (t *MySQLTransacionador) AposCommit(ctx context.Context, fn func()) {
+	pendentes, ok := ctx.Value(chaveAposCommit{}).(*[]func())
+	if !ok || transacaoDoContexto(ctx) == nil {
+		fn() // Fora de transação: nada a esperar
+		return
+	}
+	*pendentes = append(*pendentes, fn)
+}
+
+func transacaoDoContexto(ctx context.Context) *gorm.DB {
+	tx, _ := ctx.Value(chaveTransacao{}).(*gorm.DB)
+	return tx
+}
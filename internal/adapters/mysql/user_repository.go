@@ -6,35 +6,38 @@ import (
 	userDomain "desafio-itens-app/internal/domain/user"
 	"fmt"
 	"gorm.io/gorm"
+	"log/slog"
 )
 
 type MySQLUserRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
 var _ repositories.UserRepository = (*MySQLUserRepository)(nil)
 
-func NewMySQLUserRepository(db *gorm.DB) *MySQLUserRepository {
-	return &MySQLUserRepository{db: db}
+func NewMySQLUserRepository(db *gorm.DB, logger *slog.Logger) *MySQLUserRepository {
+	return &MySQLUserRepository{db: db, logger: logger}
 }
 
-func (r *MySQLUserRepository) Create(user userDomain.User) (userDomain.User, error) {
+func (r *MySQLUserRepository) Create(ctx context.Context, user userDomain.User) (userDomain.User, error) {
 	model := fromUserEntity(user)
 
-	err := r.db.Create(&model).Error
+	err := conexao(ctx, r.db).Create(&model).Error
 	if err != nil {
-		return userDomain.User{}, fmt.Errorf("erro ao criar usuário: %w", err)
+		return userDomain.User{}, fmt.Errorf("erro ao criar usuário: %w", traduzirErro(err))
 	}
 
 	return model.toEntity(), nil
 }
 
-func (r *MySQLUserRepository) GetById(id int) (*userDomain.User, error) {
+func (r *MySQLUserRepository) GetById(ctx context.Context, id int) (*userDomain.User, error) {
 	var model UserModel
 
-	err := r.db.First(&model, id).Error
+	err := conexao(ctx, r.db).First(&model, id).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
+			r.logger.DebugContext(ctx, "usuário não encontrado", "user_id", id)
 			return nil, fmt.Errorf("usuário com ID %d não encontrado", id)
 		}
 		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
@@ -47,12 +50,12 @@ func (r *MySQLUserRepository) List(ctx context.Context, limit, offset int) ([]*u
 	var models []UserModel
 	var totalCount int64
 
-	err := r.db.WithContext(ctx).Model(&UserModel{}).Count(&totalCount).Error
+	err := conexao(ctx, r.db).Model(&UserModel{}).Count(&totalCount).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("erro ao contar os usuários: %w", err)
 	}
 
-	err = r.db.WithContext(ctx).Limit(limit).Offset(offset).Order("created_at DESC").Find(&models).Error
+	err = conexao(ctx, r.db).Limit(limit).Offset(offset).Order("created_at DESC").Find(&models).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("erro ao buscar usuários: %w", err)
 	}
@@ -66,11 +69,12 @@ func (r *MySQLUserRepository) List(ctx context.Context, limit, offset int) ([]*u
 	return users, totalCount, nil
 }
 
-func (r *MySQLUserRepository) GetByUsername(username string) (*userDomain.User, error) {
+func (r *MySQLUserRepository) GetByUsername(ctx context.Context, username string) (*userDomain.User, error) {
 	var model UserModel
-	err := r.db.Where("username = ?", username).First(&model).Error
+	err := conexao(ctx, r.db).Where("username = ?", username).First(&model).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
+			r.logger.DebugContext(ctx, "usuário não encontrado", "username", username)
 			return nil, fmt.Errorf("usuário %s não encontrado", username)
 		}
 		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
@@ -79,12 +83,13 @@ func (r *MySQLUserRepository) GetByUsername(username string) (*userDomain.User,
 	return &user, nil
 }
 
-func (r *MySQLUserRepository) GetByEmail(email string) (*userDomain.User, error) {
+func (r *MySQLUserRepository) GetByEmail(ctx context.Context, email string) (*userDomain.User, error) {
 	var model UserModel
 
-	err := r.db.Where("email = ?", email).First(&model).Error
+	err := conexao(ctx, r.db).Where("email = ?", email).First(&model).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
+			r.logger.DebugContext(ctx, "usuário não encontrado por email")
 			return nil, fmt.Errorf("usuário com email %s não encontrado", email)
 		}
 		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
@@ -93,32 +98,33 @@ func (r *MySQLUserRepository) GetByEmail(email string) (*userDomain.User, error)
 	return &user, nil
 }
 
-func (r *MySQLUserRepository) Update(user userDomain.User) error {
+func (r *MySQLUserRepository) Update(ctx context.Context, user userDomain.User) error {
 	model := fromUserEntity(user)
 
-	err := r.db.Save(&model).Error
+	err := conexao(ctx, r.db).Save(&model).Error
 	if err != nil {
-		return fmt.Errorf("erro ao atualizar usuário: %w", err)
+		return fmt.Errorf("erro ao atualizar usuário: %w", traduzirErro(err))
 	}
 	return nil
 }
 
-func (r *MySQLUserRepository) Delete(id int) error {
-	result := r.db.Delete(&UserModel{}, id)
+func (r *MySQLUserRepository) Delete(ctx context.Context, id int) error {
+	result := conexao(ctx, r.db).Delete(&UserModel{}, id)
 
 	if result.Error != nil {
 		return fmt.Errorf("erro ao deletar usuário: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		r.logger.WarnContext(ctx, "delete não afetou nenhuma linha", "user_id", id)
 		return fmt.Errorf("usuário com ID %d não encontrado", id)
 	}
 	return nil
 }
 
-func (r *MySQLUserRepository) UserNameExists(username string) (bool, error) {
+func (r *MySQLUserRepository) UserNameExists(ctx context.Context, username string) (bool, error) {
 	var count int64
 
-	err := r.db.Model(&UserModel{}).Where("username = ?", username).Count(&count).Error
+	err := conexao(ctx, r.db).Model(&UserModel{}).Where("username = ?", username).Count(&count).Error
 	if err != nil {
 		return false, fmt.Errorf("erro ao verificar username: %w", err)
 	}
@@ -126,10 +132,10 @@ func (r *MySQLUserRepository) UserNameExists(username string) (bool, error) {
 	return count > 0, nil
 }
 
-func (r *MySQLUserRepository) EmailExists(email string) (bool, error) {
+func (r *MySQLUserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
 	var count int64
 
-	err := r.db.Model(&UserModel{}).Where("email = ?", email).Count(&count).Error
+	err := conexao(ctx, r.db).Model(&UserModel{}).Where("email = ?", email).Count(&count).Error
 	if err != nil {
 		return false, fmt.Errorf("erro ao verificar email: %w", err)
 	}
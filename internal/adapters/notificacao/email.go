@@ -0,0 +1,62 @@
+package notificacao
+
+import (
+	"bytes"
+	"context"
+	"desafio-itens-app/internal/domain/item"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Email manda o alerta em texto puro por SMTP
+type Email struct {
+	addr string
+	auth smtp.Auth
+	de   string
+	para []string
+
+	enviar func(addr string, auth smtp.Auth, de string, para []string, msg []byte) error // smtp.SendMail; trocado nos testes
+}
+
+func NovoEmail(addr, usuario, senha, de string, para []string) *Email {
+	var auth smtp.Auth
+	if usuario != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		auth = smtp.PlainAuth("", usuario, senha, host)
+	}
+	return &Email{addr: addr, auth: auth, de: de, para: para, enviar: smtp.SendMail}
+}
+
+// smtp.SendMail não aceita context; o cancelamento só vale antes do envio
+func (e *Email) AlertaEstoque(ctx context.Context, alerta item.AlertaEstoque) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := e.enviar(e.addr, e.auth, e.de, e.para, e.mensagem(alerta)); err != nil {
+		return fmt.Errorf("email: %w", err)
+	}
+	return nil
+}
+
+func (e *Email) mensagem(alerta item.AlertaEstoque) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.de)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(e.para, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", assunto(alerta)))
+	fmt.Fprintf(&msg, "Date: %s\r\n", alerta.Em.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+
+	fmt.Fprintf(&msg, "Item: %s (%s, id %d)\r\n", alerta.Nome, alerta.Code, alerta.ItemID)
+	fmt.Fprintf(&msg, "Estoque atual: %d\r\n", alerta.Estoque)
+	fmt.Fprintf(&msg, "Ponto de reposição: %d\r\n", alerta.PontoReposicao)
+	if alerta.Tipo == item.AlertaEstoqueBaixo && alerta.QuantidadeReposicao > 0 {
+		fmt.Fprintf(&msg, "Quantidade sugerida para compra: %d\r\n", alerta.QuantidadeReposicao)
+	}
+	return msg.Bytes()
+}
@@ -0,0 +1,30 @@
+package notificacao
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/smtp"
+	"testing"
+)
+
+func TestEmail_MontaMensagem(t *testing.T) {
+	//ARRANGE
+	email := NovoEmail("smtp.exemplo.com:587", "", "", "estoque@exemplo.com", []string{"compras@exemplo.com", "gerente@exemplo.com"})
+	var enviada []byte
+	var destinatarios []string
+	email.enviar = func(addr string, auth smtp.Auth, de string, para []string, msg []byte) error {
+		destinatarios, enviada = para, msg
+		return nil
+	}
+
+	//ACT
+	err := email.AlertaEstoque(context.Background(), alertaTeste())
+
+	//ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, []string{"compras@exemplo.com", "gerente@exemplo.com"}, destinatarios)
+	assert.Contains(t, string(enviada), "To: compras@exemplo.com, gerente@exemplo.com\r\n")
+	assert.Contains(t, string(enviada), "Subject: Estoque baixo: Mouse (MOU-001)\r\n")
+	assert.Contains(t, string(enviada), "Quantidade sugerida para compra: 50\r\n")
+}
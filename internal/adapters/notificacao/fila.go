@@ -0,0 +1,74 @@
+package notificacao
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/notificacoes"
+	"desafio-itens-app/internal/domain/item"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// prazoEsvaziar: no encerramento, quanto tempo os alertas na fila ainda têm
+// para sair
+const prazoEsvaziar = 5 * time.Second
+
+var ErrFilaCheia = errors.New("fila de notificações cheia")
+
+// Fila desacopla a requisição do envio: AlertaEstoque só enfileira e Rodar
+// entrega em segundo plano, um alerta por vez e na ordem
+type Fila struct {
+	destino notificacoes.Notificador
+	alertas chan item.AlertaEstoque
+	logger  *slog.Logger
+}
+
+func NovaFila(destino notificacoes.Notificador, tamanho int, logger *slog.Logger) *Fila {
+	return &Fila{destino: destino, alertas: make(chan item.AlertaEstoque, tamanho), logger: logger}
+}
+
+// AlertaEstoque nunca bloqueia: com a fila cheia devolve ErrFilaCheia
+func (f *Fila) AlertaEstoque(_ context.Context, alerta item.AlertaEstoque) error {
+	select {
+	case f.alertas <- alerta:
+		return nil
+	default:
+		return ErrFilaCheia
+	}
+}
+
+// Rodar entrega até ctx ser cancelado e então tenta esvaziar a fila
+func (f *Fila) Rodar(ctx context.Context) error {
+	for {
+		select {
+		case alerta := <-f.alertas:
+			f.entregar(ctx, alerta)
+		case <-ctx.Done():
+			f.esvaziar(context.WithoutCancel(ctx))
+			return nil
+		}
+	}
+}
+
+func (f *Fila) esvaziar(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, prazoEsvaziar)
+	defer cancel()
+
+	for {
+		select {
+		case alerta := <-f.alertas:
+			f.entregar(ctx, alerta)
+		default:
+			return
+		}
+	}
+}
+
+func (f *Fila) entregar(ctx context.Context, alerta item.AlertaEstoque) {
+	if err := f.destino.AlertaEstoque(ctx, alerta); err != nil {
+		f.logger.WarnContext(ctx, "falha ao entregar alerta de estoque",
+			"item_id", alerta.ItemID,
+			"tipo", alerta.Tipo,
+			"erro", err)
+	}
+}
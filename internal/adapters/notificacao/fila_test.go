@@ -0,0 +1,53 @@
+package notificacao
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"sync"
+	"testing"
+)
+
+type notificadorMemoria struct {
+	mu      sync.Mutex
+	alertas []item.AlertaEstoque
+}
+
+func (n *notificadorMemoria) AlertaEstoque(_ context.Context, alerta item.AlertaEstoque) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alertas = append(n.alertas, alerta)
+	return nil
+}
+
+func TestFila_CheiaNaoBloqueia(t *testing.T) {
+	//ARRANGE
+	fila := NovaFila(&notificadorMemoria{}, 1, slog.New(slog.DiscardHandler))
+
+	//ACT
+	primeiro := fila.AlertaEstoque(context.Background(), alertaTeste())
+	segundo := fila.AlertaEstoque(context.Background(), alertaTeste())
+
+	//ASSERT
+	assert.NoError(t, primeiro)
+	assert.ErrorIs(t, segundo, ErrFilaCheia)
+}
+
+func TestFila_EsvaziaAoEncerrar(t *testing.T) {
+	//ARRANGE
+	destino := &notificadorMemoria{}
+	fila := NovaFila(destino, 10, slog.New(slog.DiscardHandler))
+	for range 3 {
+		assert.NoError(t, fila.AlertaEstoque(context.Background(), alertaTeste()))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	//ACT
+	err := fila.Rodar(ctx)
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, destino.alertas, 3)
+}
@@ -0,0 +1,85 @@
+package notificacao
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/notificacoes"
+	"desafio-itens-app/internal/config"
+	"desafio-itens-app/internal/domain/item"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// NovoNotificador monta os canais de NOTIFY_CHANNELS. Configuração faltando
+// em um canal pedido é erro de boot, não alerta perdido em produção.
+func NovoNotificador(cfg config.Notificacoes, logger *slog.Logger) (notificacoes.Notificador, error) {
+	var canais Varios
+	for _, canal := range cfg.Canais {
+		switch strings.ToLower(canal) {
+		case "log":
+			canais = append(canais, NovoLog(logger))
+		case "webhook":
+			if cfg.WebhookURL == "" {
+				return nil, errors.New("NOTIFY_WEBHOOK_URL é obrigatório para o canal webhook")
+			}
+			canais = append(canais, NovoWebhook(cfg.WebhookURL, cfg.WebhookSegredo, cfg.WebhookTimeout))
+		case "email":
+			if cfg.SMTPAddr == "" || cfg.EmailDe == "" || len(cfg.EmailPara) == 0 {
+				return nil, errors.New("NOTIFY_SMTP_ADDR, NOTIFY_EMAIL_FROM e NOTIFY_EMAIL_TO são obrigatórios para o canal email")
+			}
+			canais = append(canais, NovoEmail(cfg.SMTPAddr, cfg.SMTPUsuario, cfg.SMTPSenha, cfg.EmailDe, cfg.EmailPara))
+		case "none":
+		default:
+			return nil, fmt.Errorf("NOTIFY_CHANNELS: canal desconhecido %q", canal)
+		}
+	}
+	return canais, nil
+}
+
+// Varios entrega o alerta em todos os canais; a falha de um não impede os
+// outros
+type Varios []notificacoes.Notificador
+
+func (v Varios) AlertaEstoque(ctx context.Context, alerta item.AlertaEstoque) error {
+	var erros []error
+	for _, n := range v {
+		if err := n.AlertaEstoque(ctx, alerta); err != nil {
+			erros = append(erros, err)
+		}
+	}
+	return errors.Join(erros...)
+}
+
+// Log só registra o alerta (canal padrão)
+type Log struct {
+	logger *slog.Logger
+}
+
+func NovoLog(logger *slog.Logger) *Log {
+	return &Log{logger: logger}
+}
+
+func (l *Log) AlertaEstoque(ctx context.Context, alerta item.AlertaEstoque) error {
+	nivel := slog.LevelWarn
+	if alerta.Tipo == item.AlertaEstoqueNormalizado {
+		nivel = slog.LevelInfo
+	}
+	l.logger.Log(ctx, nivel, "alerta de estoque",
+		"tipo", alerta.Tipo,
+		"item_id", alerta.ItemID,
+		"code", alerta.Code,
+		"nome", alerta.Nome,
+		"estoque", alerta.Estoque,
+		"ponto_reposicao", alerta.PontoReposicao,
+		"quantidade_reposicao", alerta.QuantidadeReposicao)
+	return nil
+}
+
+// assunto é usado no e-mail e no log do webhook
+func assunto(alerta item.AlertaEstoque) string {
+	if alerta.Tipo == item.AlertaEstoqueNormalizado {
+		return fmt.Sprintf("Estoque normalizado: %s (%s)", alerta.Nome, alerta.Code)
+	}
+	return fmt.Sprintf("Estoque baixo: %s (%s)", alerta.Nome, alerta.Code)
+}
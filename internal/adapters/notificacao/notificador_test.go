@@ -0,0 +1,30 @@
+package notificacao
+
+import (
+	"desafio-itens-app/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestNovoNotificador(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+
+	t.Run("canais configurados", func(t *testing.T) {
+		n, err := NovoNotificador(config.Notificacoes{Canais: []string{"log", "webhook"}, WebhookURL: "http://localhost/alertas"}, logger)
+
+		require.NoError(t, err)
+		assert.Len(t, n, 2)
+	})
+
+	t.Run("webhook sem url", func(t *testing.T) {
+		_, err := NovoNotificador(config.Notificacoes{Canais: []string{"webhook"}}, logger)
+		assert.ErrorContains(t, err, "NOTIFY_WEBHOOK_URL")
+	})
+
+	t.Run("canal desconhecido", func(t *testing.T) {
+		_, err := NovoNotificador(config.Notificacoes{Canais: []string{"sms"}}, logger)
+		assert.ErrorContains(t, err, "sms")
+	})
+}
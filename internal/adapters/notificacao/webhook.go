@@ -0,0 +1,81 @@
+package notificacao
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"desafio-itens-app/internal/domain/item"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Webhook faz POST do alerta em JSON. Com segredo, o corpo vai assinado em
+// X-Assinatura ("sha256=" + HMAC-SHA256 em hexadecimal) para o destino
+// conferir a origem.
+type Webhook struct {
+	url     string
+	segredo string
+	cliente *http.Client
+}
+
+func NovoWebhook(url, segredo string, timeout time.Duration) *Webhook {
+	return &Webhook{url: url, segredo: segredo, cliente: &http.Client{Timeout: timeout}}
+}
+
+type corpoWebhook struct {
+	Evento              item.TipoAlerta `json:"evento"`
+	ItemID              int             `json:"item_id"`
+	Code                string          `json:"code"`
+	Nome                string          `json:"nome"`
+	Estoque             int             `json:"estoque"`
+	PontoReposicao      int             `json:"ponto_reposicao"`
+	QuantidadeReposicao int             `json:"quantidade_reposicao"`
+	Em                  time.Time       `json:"em"`
+}
+
+func (w *Webhook) AlertaEstoque(ctx context.Context, alerta item.AlertaEstoque) error {
+	corpo, err := json.Marshal(corpoWebhook{
+		Evento:              alerta.Tipo,
+		ItemID:              alerta.ItemID,
+		Code:                alerta.Code,
+		Nome:                alerta.Nome,
+		Estoque:             alerta.Estoque,
+		PontoReposicao:      alerta.PontoReposicao,
+		QuantidadeReposicao: alerta.QuantidadeReposicao,
+		Em:                  alerta.Em,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(corpo))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.segredo != "" {
+		req.Header.Set("X-Assinatura", "sha256="+Assinar(w.segredo, corpo))
+	}
+
+	resp, err := w.cliente.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook respondeu %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Assinar calcula o HMAC-SHA256 do corpo com o segredo, em hexadecimal
+func Assinar(segredo string, corpo []byte) string {
+	mac := hmac.New(sha256.New, []byte(segredo))
+	mac.Write(corpo)
+	return hex.EncodeToString(mac.Sum(nil))
+}
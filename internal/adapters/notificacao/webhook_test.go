@@ -0,0 +1,66 @@
+package notificacao
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/item"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func alertaTeste() item.AlertaEstoque {
+	return item.AlertaEstoque{
+		Tipo:                item.AlertaEstoqueBaixo,
+		ItemID:              7,
+		Code:                "MOU-001",
+		Nome:                "Mouse",
+		Estoque:             2,
+		PontoReposicao:      5,
+		QuantidadeReposicao: 50,
+		Em:                  time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestWebhook_EnviaCorpoAssinado(t *testing.T) {
+	//ARRANGE
+	var corpo []byte
+	var assinatura string
+	servidor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		corpo, _ = io.ReadAll(r.Body)
+		assinatura = r.Header.Get("X-Assinatura")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer servidor.Close()
+
+	//ACT
+	err := NovoWebhook(servidor.URL, "segredo", time.Second).AlertaEstoque(context.Background(), alertaTeste())
+
+	//ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, "sha256="+Assinar("segredo", corpo), assinatura)
+
+	var recebido map[string]any
+	require.NoError(t, json.Unmarshal(corpo, &recebido))
+	assert.Equal(t, "estoque_baixo", recebido["evento"])
+	assert.Equal(t, 7.0, recebido["item_id"])
+	assert.Equal(t, 50.0, recebido["quantidade_reposicao"])
+}
+
+func TestWebhook_StatusDeErro(t *testing.T) {
+	//ARRANGE
+	servidor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer servidor.Close()
+
+	//ACT
+	err := NovoWebhook(servidor.URL, "", time.Second).AlertaEstoque(context.Background(), alertaTeste())
+
+	//ASSERT
+	assert.EqualError(t, err, "webhook respondeu 502")
+}
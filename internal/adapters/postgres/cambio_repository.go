@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgresCambioRepository struct {
+	db *gorm.DB
+}
+
+var _ repositories.CambioRepository = (*PostgresCambioRepository)(nil)
+
+func NewPostgresCambioRepository(db *gorm.DB) *PostgresCambioRepository {
+	return &PostgresCambioRepository{db: db}
+}
+
+func (r *PostgresCambioRepository) Listar(ctx context.Context) ([]cambio.Taxa, error) {
+	var models []TaxaCambioModel
+
+	err := conexao(ctx, r.db).Order("moeda_origem, moeda_destino").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar taxas de câmbio: %w", err)
+	}
+
+	taxas := make([]cambio.Taxa, 0, len(models))
+	for _, model := range models {
+		taxa, err := model.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("Taxa de câmbio inválida no banco (%s → %s): %w", model.MoedaOrigem, model.MoedaDestino, err)
+		}
+		taxas = append(taxas, taxa)
+	}
+	return taxas, nil
+}
+
+func (r *PostgresCambioRepository) Salvar(ctx context.Context, taxa cambio.Taxa) error {
+	model := fromTaxaCambioEntity(taxa)
+
+	err := conexao(ctx, r.db).Clauses(clause.OnConflict{UpdateAll: true}).Create(&model).Error
+	if err != nil {
+		return fmt.Errorf("Erro ao salvar taxa de câmbio: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresCambioRepository) Remover(ctx context.Context, de, para dinheiro.Moeda) error {
+	result := conexao(ctx, r.db).
+		Where("moeda_origem = ? AND moeda_destino = ?", string(de), string(para)).
+		Delete(&TaxaCambioModel{})
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao remover taxa de câmbio: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s → %s", cambio.ErrTaxaNaoEncontrada, de, para)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/config"
+	"desafio-itens-app/utils"
+	"fmt"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+	"log/slog"
+)
+
+const dsnPadrao = "host=localhost user=postgres password=postgres dbname=itens_db port=5432 sslmode=disable TimeZone=UTC"
+
+func ConectarGORM(cfg config.Database, log *slog.Logger, gormLogger logger.Interface) (*gorm.DB, error) {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = dsnPadrao
+	}
+
+	// 🔁 O banco pode ainda estar subindo (docker-compose): tenta com backoff
+	var db *gorm.DB
+	tentativa := 0
+	err := utils.Retry(context.Background(), cfg.TentativasConexao, cfg.EsperaConexao, func() error {
+		tentativa++
+		var err error
+		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+			Logger: gormLogger,
+		})
+		if err != nil {
+			log.Warn("banco indisponível",
+				"tentativa", tentativa,
+				"max_tentativas", cfg.TentativasConexao,
+				"erro", err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao conectar com GORM: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter pool de conexões: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	// 📚 Réplicas: SELECTs vão para elas, escritas e transações para o primário
+	if len(cfg.ReplicaDSNs) > 0 {
+		replicas := make([]gorm.Dialector, 0, len(cfg.ReplicaDSNs))
+		for _, replica := range cfg.ReplicaDSNs {
+			replicas = append(replicas, postgres.Open(replica))
+		}
+
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: replicas,
+			Policy:   dbresolver.RandomPolicy{},
+		}).
+			SetMaxOpenConns(cfg.MaxOpenConns).
+			SetMaxIdleConns(cfg.MaxIdleConns).
+			SetConnMaxLifetime(cfg.ConnMaxLifetime).
+			SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+		if err := db.Use(resolver); err != nil {
+			return nil, fmt.Errorf("erro ao configurar réplicas de leitura: %w", err)
+		}
+	}
+
+	log.Info("conectado ao banco", "driver", "postgres", "replicas", len(cfg.ReplicaDSNs))
+	return db, nil
+}
+
+// conexao prepara o *gorm.DB de uma operação: propaga o contexto, usa a
+// transação aberta pelo Transacionador (se houver) e, se a requisição
+// pediu "read your writes", força as leituras no primário.
+func conexao(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx := transacaoDoContexto(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
+	db = db.WithContext(ctx)
+	if repositories.LeituraNoPrimario(ctx) {
+		db = db.Clauses(dbresolver.Write)
+	}
+	return db
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"desafio-itens-app/internal/domain/deposito"
+	entity "desafio-itens-app/internal/domain/item"
+	userDomain "desafio-itens-app/internal/domain/user"
+	"errors"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation é o SQLSTATE do PostgreSQL para chave única duplicada
+const uniqueViolation = "23505"
+
+// traduzirErro converte violações de unicidade nos erros de conflito do
+// domínio, usando o nome do índice que o GORM gera (idx_<tabela>_<coluna>).
+func traduzirErro(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolation {
+		return err
+	}
+
+	switch pgErr.ConstraintName {
+	case "idx_itens_code":
+		return entity.ErrCodeDuplicado
+	case "idx_itens_ean":
+		return entity.ErrEANDuplicado
+	case "idx_users_username":
+		return userDomain.ErrUsernameEmUso
+	case "idx_users_email":
+		return userDomain.ErrEmailEmUso
+	case "idx_depositos_codigo":
+		return deposito.ErrCodigoDuplicado
+	}
+	return err
+}
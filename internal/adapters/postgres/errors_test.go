@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"desafio-itens-app/internal/domain/deposito"
+	entity "desafio-itens-app/internal/domain/item"
+	userDomain "desafio-itens-app/internal/domain/user"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTraduzirErro_UniqueViolation_RetornaErroDeDominio(t *testing.T) {
+	casos := map[string]error{
+		"idx_itens_code":       entity.ErrCodeDuplicado,
+		"idx_itens_ean":        entity.ErrEANDuplicado,
+		"idx_users_username":   userDomain.ErrUsernameEmUso,
+		"idx_users_email":      userDomain.ErrEmailEmUso,
+		"idx_depositos_codigo": deposito.ErrCodigoDuplicado,
+	}
+
+	for constraint, esperado := range casos {
+		//ARRANGE
+		err := fmt.Errorf("insert: %w", &pgconn.PgError{Code: uniqueViolation, ConstraintName: constraint})
+
+		//ACT
+		traduzido := traduzirErro(err)
+
+		//ASSERT
+		assert.ErrorIs(t, traduzido, esperado, constraint)
+	}
+}
+
+func TestTraduzirErro_OutroErro_MantemOriginal(t *testing.T) {
+	//ARRANGE
+	original := &pgconn.PgError{Code: "23503", ConstraintName: "fk_itens_created_by"}
+	generico := errors.New("conexão recusada")
+
+	//ACT + ASSERT
+	assert.Equal(t, error(original), traduzirErro(original))
+	assert.Equal(t, generico, traduzirErro(generico))
+}
@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/deposito"
+	entity "desafio-itens-app/internal/domain/item"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type PostgresEstoqueRepository struct {
+	db *gorm.DB
+}
+
+var _ repositories.EstoqueRepository = (*PostgresEstoqueRepository)(nil)
+
+func NewPostgresEstoqueRepository(db *gorm.DB) *PostgresEstoqueRepository {
+	return &PostgresEstoqueRepository{db: db}
+}
+
+func (r *PostgresEstoqueRepository) ListarDepositos(ctx context.Context) ([]deposito.Deposito, error) {
+	var models []DepositoModel
+
+	if err := conexao(ctx, r.db).Order("padrao DESC, codigo").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("Erro ao listar depósitos: %w", err)
+	}
+
+	depositos := make([]deposito.Deposito, 0, len(models))
+	for _, model := range models {
+		depositos = append(depositos, model.toEntity())
+	}
+	return depositos, nil
+}
+
+func (r *PostgresEstoqueRepository) CriarDeposito(ctx context.Context, d deposito.Deposito) (deposito.Deposito, error) {
+	model := DepositoModel{Codigo: d.Codigo, Nome: d.Nome, CriadoEm: time.Now().UTC()}
+
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return deposito.Deposito{}, fmt.Errorf("Erro ao criar depósito: %w", traduzirErro(err))
+	}
+	return model.toEntity(), nil
+}
+
+func (r *PostgresEstoqueRepository) Saldos(ctx context.Context, itemID int) ([]deposito.Saldo, error) {
+	var linhas []struct {
+		DepositoModel
+		Quantidade int
+	}
+
+	err := conexao(ctx, r.db).
+		Table("depositos d").
+		Select("d.*, COALESCE(s.quantidade, 0) AS quantidade").
+		Joins("LEFT JOIN saldos_estoque s ON s.deposito_id = d.id AND s.item_id = ?", itemID).
+		Order("d.padrao DESC, d.codigo").
+		Scan(&linhas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar saldos do item: %w", err)
+	}
+
+	saldos := make([]deposito.Saldo, 0, len(linhas))
+	for _, linha := range linhas {
+		saldos = append(saldos, deposito.Saldo{Deposito: linha.DepositoModel.toEntity(), Quantidade: linha.Quantidade})
+	}
+	return saldos, nil
+}
+
+func (r *PostgresEstoqueRepository) DefinirSaldo(ctx context.Context, itemID, depositoID, quantidade int) (int, error) {
+	total := 0
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := travarItem(tx, itemID); err != nil {
+			return err
+		}
+		if err := depositoExiste(tx, depositoID); err != nil {
+			return err
+		}
+		if err := gravarSaldo(tx, itemID, depositoID, quantidade); err != nil {
+			return err
+		}
+
+		var err error
+		total, err = somarSaldos(tx, itemID)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Erro ao definir saldo: %w", err)
+	}
+	return total, nil
+}
+
+func (r *PostgresEstoqueRepository) Transferir(ctx context.Context, t deposito.Transferencia) (deposito.Transferencia, error) {
+	model := TransferenciaEstoqueModel{
+		ItemID:     t.ItemID,
+		Origem:     t.Origem,
+		Destino:    t.Destino,
+		Quantidade: t.Quantidade,
+		CriadoEm:   time.Now().UTC(),
+	}
+	if t.UsuarioID != 0 {
+		model.UsuarioID = &t.UsuarioID
+	}
+
+	err := conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 🔒 Com o item travado, ninguém mexe nos saldos dele até o commit
+		if err := travarItem(tx, t.ItemID); err != nil {
+			return err
+		}
+		for _, id := range []int{t.Origem, t.Destino} {
+			if err := depositoExiste(tx, id); err != nil {
+				return err
+			}
+		}
+
+		origem, err := saldoNoDeposito(tx, t.ItemID, t.Origem)
+		if err != nil {
+			return err
+		}
+		if origem < t.Quantidade {
+			return fmt.Errorf("%w: disponível %d", deposito.ErrEstoqueInsuficiente, origem)
+		}
+		destino, err := saldoNoDeposito(tx, t.ItemID, t.Destino)
+		if err != nil {
+			return err
+		}
+
+		if err := gravarSaldo(tx, t.ItemID, t.Origem, origem-t.Quantidade); err != nil {
+			return err
+		}
+		if err := gravarSaldo(tx, t.ItemID, t.Destino, destino+t.Quantidade); err != nil {
+			return err
+		}
+		return tx.Create(&model).Error
+	})
+	if err != nil {
+		return deposito.Transferencia{}, fmt.Errorf("Erro ao transferir estoque: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *PostgresEstoqueRepository) Transferencias(ctx context.Context, itemID, limite int) ([]deposito.Transferencia, error) {
+	var models []TransferenciaEstoqueModel
+
+	err := conexao(ctx, r.db).
+		Where("item_id = ?", itemID).
+		Order("criado_em DESC, id DESC").
+		Limit(limite).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar transferências: %w", err)
+	}
+
+	transferencias := make([]deposito.Transferencia, 0, len(models))
+	for _, model := range models {
+		transferencias = append(transferencias, model.toEntity())
+	}
+	return transferencias, nil
+}
+
+// sincronizarDepositoPadrao faz a soma dos saldos bater com itens.estoque.
+// Quem só conhece o total (PUT /itens/:id, lote, importação, vendas) põe o
+// que entra no depósito padrão e tira o que sai do padrão e, se não
+// bastar, dos outros depósitos (deposito.AjustarTotal).
+func sincronizarDepositoPadrao(tx *gorm.DB, itemID, estoque int) error {
+	var models []SaldoEstoqueModel
+	if err := tx.Where("item_id = ?", itemID).Order("deposito_id").Find(&models).Error; err != nil {
+		return err
+	}
+	saldos := make([]deposito.Saldo, len(models))
+	for i, model := range models {
+		saldos[i] = deposito.Saldo{Deposito: deposito.Deposito{ID: model.DepositoID}, Quantidade: model.Quantidade}
+	}
+	if deposito.Total(saldos) == estoque {
+		return nil
+	}
+
+	var padrao DepositoModel
+	if err := tx.Where("padrao = ?", true).First(&padrao).Error; err != nil {
+		return fmt.Errorf("depósito padrão não encontrado: %w", err)
+	}
+	alterados, err := deposito.AjustarTotal(saldos, padrao.ID, estoque)
+	if err != nil {
+		return err
+	}
+	for _, saldo := range alterados {
+		if err := gravarSaldo(tx, itemID, saldo.Deposito.ID, saldo.Quantidade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func travarItens(tx *gorm.DB, itemIDs []int) error {
+	for _, id := range itemIDs {
+		if err := travarItem(tx, id); err != nil {
+			return fmt.Errorf("item %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func travarItem(tx *gorm.DB, itemID int) error {
+	var item ItemModel
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&item, itemID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.ErrItemNaoEncontrado
+	}
+	return err
+}
+
+func depositoExiste(tx *gorm.DB, depositoID int) error {
+	var total int64
+	if err := tx.Model(&DepositoModel{}).Where("id = ?", depositoID).Count(&total).Error; err != nil {
+		return err
+	}
+	if total == 0 {
+		return fmt.Errorf("%w: %d", deposito.ErrDepositoNaoEncontrado, depositoID)
+	}
+	return nil
+}
+
+func saldoNoDeposito(tx *gorm.DB, itemID, depositoID int) (int, error) {
+	var saldo SaldoEstoqueModel
+	err := tx.Where("item_id = ? AND deposito_id = ?", itemID, depositoID).Limit(1).Find(&saldo).Error
+	return saldo.Quantidade, err
+}
+
+func somarSaldos(tx *gorm.DB, itemID int) (int, error) {
+	var soma int64
+	err := tx.Model(&SaldoEstoqueModel{}).
+		Where("item_id = ?", itemID).
+		Select("COALESCE(SUM(quantidade), 0)").
+		Scan(&soma).Error
+	return int(soma), err
+}
+
+func gravarSaldo(tx *gorm.DB, itemID, depositoID, quantidade int) error {
+	saldo := SaldoEstoqueModel{ItemID: itemID, DepositoID: depositoID, Quantidade: quantidade, AtualizadoEm: time.Now().UTC()}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "deposito_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantidade", "atualizado_em"}),
+	}).Create(&saldo).Error
+}
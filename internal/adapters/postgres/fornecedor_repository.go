@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/fornecedor"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type PostgresFornecedorRepository struct {
+	db *gorm.DB
+}
+
+var _ repositories.FornecedorRepository = (*PostgresFornecedorRepository)(nil)
+
+func NewPostgresFornecedorRepository(db *gorm.DB) *PostgresFornecedorRepository {
+	return &PostgresFornecedorRepository{db: db}
+}
+
+func (r *PostgresFornecedorRepository) Listar(ctx context.Context) ([]fornecedor.Fornecedor, error) {
+	var models []FornecedorModel
+
+	if err := conexao(ctx, r.db).Order("nome, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("Erro ao listar fornecedores: %w", err)
+	}
+
+	fornecedores := make([]fornecedor.Fornecedor, 0, len(models))
+	for _, model := range models {
+		fornecedores = append(fornecedores, model.toEntity())
+	}
+	return fornecedores, nil
+}
+
+func (r *PostgresFornecedorRepository) GetByID(ctx context.Context, id int) (*fornecedor.Fornecedor, error) {
+	var model FornecedorModel
+
+	err := conexao(ctx, r.db).First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fornecedor.ErrFornecedorNaoEncontrado
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar fornecedor: %w", err)
+	}
+
+	f := model.toEntity()
+	return &f, nil
+}
+
+func (r *PostgresFornecedorRepository) Criar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+	model := fromFornecedorEntity(f)
+
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return fornecedor.Fornecedor{}, fmt.Errorf("Erro ao criar fornecedor: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+func (r *PostgresFornecedorRepository) Atualizar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+	result := conexao(ctx, r.db).
+		Model(&FornecedorModel{ID: f.ID}).
+		Updates(map[string]any{
+			"nome":          f.Nome,
+			"documento":     f.Documento,
+			"email":         f.Email,
+			"telefone":      f.Telefone,
+			"atualizado_em": f.AtualizadoEm,
+		})
+	if result.Error != nil {
+		return fornecedor.Fornecedor{}, fmt.Errorf("Erro ao atualizar fornecedor: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fornecedor.Fornecedor{}, fornecedor.ErrFornecedorNaoEncontrado
+	}
+
+	atualizado, err := r.GetByID(ctx, f.ID)
+	if err != nil {
+		return fornecedor.Fornecedor{}, err
+	}
+	return *atualizado, nil
+}
+
+func (r *PostgresFornecedorRepository) Vinculos(ctx context.Context, itemID int) ([]fornecedor.ItemFornecedor, error) {
+	var linhas []struct {
+		ItemFornecedorModel
+		FornecedorNome string
+	}
+
+	err := conexao(ctx, r.db).
+		Table("itens_fornecedores v").
+		Select("v.*, f.nome AS fornecedor_nome").
+		Joins("JOIN fornecedores f ON f.id = v.fornecedor_id").
+		Where("v.item_id = ?", itemID).
+		Order("f.nome, f.id").
+		Scan(&linhas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar fornecedores do item: %w", err)
+	}
+
+	vinculos := make([]fornecedor.ItemFornecedor, 0, len(linhas))
+	for _, linha := range linhas {
+		v := linha.ItemFornecedorModel.toEntity()
+		v.FornecedorNome = linha.FornecedorNome
+		vinculos = append(vinculos, v)
+	}
+	return vinculos, nil
+}
+
+func (r *PostgresFornecedorRepository) Vinculo(ctx context.Context, itemID, fornecedorID int) (*fornecedor.ItemFornecedor, error) {
+	var model ItemFornecedorModel
+
+	err := conexao(ctx, r.db).Where("item_id = ? AND fornecedor_id = ?", itemID, fornecedorID).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fornecedor.ErrVinculoNaoEncontrado
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar vínculo com fornecedor: %w", err)
+	}
+
+	v := model.toEntity()
+	return &v, nil
+}
+
+func (r *PostgresFornecedorRepository) Vincular(ctx context.Context, v fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error) {
+	model := ItemFornecedorModel{
+		ItemID:        v.ItemID,
+		FornecedorID:  v.FornecedorID,
+		SKU:           v.SKU,
+		CustoCentavos: v.Custo.Centavos,
+		Moeda:         string(v.Custo.Moeda),
+		AtualizadoEm:  time.Now().UTC(),
+	}
+
+	err := conexao(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "fornecedor_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sku", "custo_centavos", "moeda", "atualizado_em"}),
+	}).Create(&model).Error
+	if err != nil {
+		return fornecedor.ItemFornecedor{}, fmt.Errorf("Erro ao vincular fornecedor: %w", err)
+	}
+
+	vinculo := model.toEntity()
+	vinculo.FornecedorNome = v.FornecedorNome
+	return vinculo, nil
+}
+
+func (r *PostgresFornecedorRepository) Desvincular(ctx context.Context, itemID, fornecedorID int) error {
+	result := conexao(ctx, r.db).
+		Where("item_id = ? AND fornecedor_id = ?", itemID, fornecedorID).
+		Delete(&ItemFornecedorModel{})
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao desvincular fornecedor: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fornecedor.ErrVinculoNaoEncontrado
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/idempotencia"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type PostgresIdempotenciaRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresIdempotenciaRepository(db *gorm.DB) *PostgresIdempotenciaRepository {
+	return &PostgresIdempotenciaRepository{db: db}
+}
+
+func (r *PostgresIdempotenciaRepository) Reservar(ctx context.Context, registro idempotencia.Registro) (*idempotencia.Registro, error) {
+	var existente *idempotencia.Registro
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// A chave primária decide quem chegou primeiro entre requisições concorrentes
+		model := fromIdempotenciaEntity(registro)
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			return nil
+		}
+
+		var atual IdempotenciaModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("chave = ?", registro.Chave).
+			First(&atual).Error; err != nil {
+			return err
+		}
+
+		// Chave expirada que a limpeza ainda não apagou: reaproveita
+		if atual.toEntity().Expirado(registro.CriadoEm) {
+			return tx.Save(&model).Error
+		}
+
+		entity := atual.toEntity()
+		existente = &entity
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao reservar chave de idempotência: %w", err)
+	}
+
+	return existente, nil
+}
+
+func (r *PostgresIdempotenciaRepository) Concluir(ctx context.Context, chave string, statusCode int, contentType string, corpo []byte) error {
+	err := r.db.WithContext(ctx).
+		Model(&IdempotenciaModel{}).
+		Where("chave = ?", chave).
+		Updates(map[string]any{
+			"concluido":    true,
+			"status_code":  statusCode,
+			"content_type": contentType,
+			"corpo":        corpo,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("Erro ao salvar resposta idempotente: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresIdempotenciaRepository) Liberar(ctx context.Context, chave string) error {
+	err := r.db.WithContext(ctx).Where("chave = ?", chave).Delete(&IdempotenciaModel{}).Error
+	if err != nil {
+		return fmt.Errorf("Erro ao liberar chave de idempotência: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresIdempotenciaRepository) LimparExpirados(ctx context.Context, agora time.Time) (int, error) {
+	result := r.db.WithContext(ctx).Where("expira_em <= ?", agora).Delete(&IdempotenciaModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("Erro ao limpar chaves de idempotência: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/imagem"
+	"fmt"
+	"gorm.io/gorm"
+)
+
+type PostgresImagemRepository struct {
+	db *gorm.DB
+}
+
+var _ repositories.ImagemRepository = (*PostgresImagemRepository)(nil)
+
+func NewPostgresImagemRepository(db *gorm.DB) *PostgresImagemRepository {
+	return &PostgresImagemRepository{db: db}
+}
+
+func (r *PostgresImagemRepository) Listar(ctx context.Context, itemID int) ([]imagem.Imagem, error) {
+	porItem, err := r.PorItens(ctx, []int{itemID})
+	if err != nil {
+		return nil, err
+	}
+	return porItem[itemID], nil
+}
+
+func (r *PostgresImagemRepository) PorItens(ctx context.Context, itemIDs []int) (map[int][]imagem.Imagem, error) {
+	porItem := make(map[int][]imagem.Imagem, len(itemIDs))
+	if len(itemIDs) == 0 {
+		return porItem, nil
+	}
+
+	var models []ImagemModel
+	err := conexao(ctx, r.db).
+		Where("item_id IN ?", itemIDs).
+		Order("item_id, ordem, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar imagens: %w", err)
+	}
+
+	for _, m := range models {
+		porItem[m.ItemID] = append(porItem[m.ItemID], m.toEntity())
+	}
+	return porItem, nil
+}
+
+func (r *PostgresImagemRepository) Criar(ctx context.Context, imagens []imagem.Imagem) ([]imagem.Imagem, error) {
+	if len(imagens) == 0 {
+		return nil, nil
+	}
+
+	models := make([]ImagemModel, 0, len(imagens))
+	for _, img := range imagens {
+		models = append(models, fromImagemEntity(img))
+	}
+	if err := conexao(ctx, r.db).Create(&models).Error; err != nil {
+		return nil, fmt.Errorf("Erro ao gravar imagens: %w", err)
+	}
+
+	criadas := make([]imagem.Imagem, 0, len(models))
+	for _, m := range models {
+		criadas = append(criadas, m.toEntity())
+	}
+	return criadas, nil
+}
+
+func (r *PostgresImagemRepository) Atualizar(ctx context.Context, imagens []imagem.Imagem) error {
+	return conexao(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, img := range imagens {
+			err := tx.Model(&ImagemModel{ID: img.ID}).
+				Updates(map[string]any{"ordem": img.Ordem, "principal": img.Principal}).Error
+			if err != nil {
+				return fmt.Errorf("Erro ao atualizar imagem %d: %w", img.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *PostgresImagemRepository) Remover(ctx context.Context, id int) error {
+	result := conexao(ctx, r.db).Delete(&ImagemModel{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Erro ao remover imagem: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return imagem.ErrImagemNaoEncontrada
+	}
+	return nil
+}
+
+func (r *PostgresImagemRepository) TravarItem(ctx context.Context, itemID int) error {
+	return travarItem(conexao(ctx, r.db), itemID)
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/importacao"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+)
+
+type PostgresImportacaoJobRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresImportacaoJobRepository(db *gorm.DB) *PostgresImportacaoJobRepository {
+	return &PostgresImportacaoJobRepository{db: db}
+}
+
+func (r *PostgresImportacaoJobRepository) Create(ctx context.Context, job importacao.Job) error {
+	model, err := fromImportacaoJobEntity(job)
+	if err != nil {
+		return fmt.Errorf("Erro ao serializar importação: %w", err)
+	}
+
+	if err := conexao(ctx, r.db).Create(&model).Error; err != nil {
+		return fmt.Errorf("Erro ao criar importação: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresImportacaoJobRepository) Update(ctx context.Context, job importacao.Job) error {
+	model, err := fromImportacaoJobEntity(job)
+	if err != nil {
+		return fmt.Errorf("Erro ao serializar importação: %w", err)
+	}
+
+	if err := conexao(ctx, r.db).Save(&model).Error; err != nil {
+		return fmt.Errorf("Erro ao atualizar importação: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresImportacaoJobRepository) GetByID(ctx context.Context, id string) (*importacao.Job, error) {
+	var model ImportacaoJobModel
+
+	err := conexao(ctx, r.db).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, importacao.ErrJobNaoEncontrado
+		}
+		return nil, fmt.Errorf("Erro ao buscar importação: %w", err)
+	}
+
+	job, err := model.toEntity()
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao ler relatório da importação: %w", err)
+	}
+	return &job, nil
+}
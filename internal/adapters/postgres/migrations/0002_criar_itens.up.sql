@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS itens (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    descricao VARCHAR(500) NULL,
+    preco NUMERIC(10,2) NOT NULL,
+    estoque BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at TIMESTAMPTZ NULL,
+    updated_at TIMESTAMPTZ NULL,
+    deleted_at TIMESTAMPTZ NULL,
+    created_by BIGINT NULL REFERENCES users (id),
+    updated_by BIGINT NULL REFERENCES users (id),
+    CONSTRAINT chk_itens_status CHECK (status IN ('active','inactive'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_itens_code ON itens (code);
+CREATE INDEX IF NOT EXISTS idx_itens_deleted_at ON itens (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_itens_created_by ON itens (created_by);
+CREATE INDEX IF NOT EXISTS idx_itens_updated_by ON itens (updated_by);
+CREATE INDEX IF NOT EXISTS idx_itens_busca ON itens
+    USING GIN (to_tsvector('portuguese', nome || ' ' || coalesce(descricao, '')));
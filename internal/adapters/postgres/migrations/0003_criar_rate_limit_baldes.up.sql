@@ -0,0 +1,6 @@
+CREATE TABLE rate_limit_baldes (
+    chave VARCHAR(191) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_rate_limit_baldes_atualizado_em ON rate_limit_baldes (atualizado_em);
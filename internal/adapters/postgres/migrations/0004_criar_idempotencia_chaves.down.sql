@@ -0,0 +1 @@
+DROP TABLE IF EXISTS idempotencia_chaves;
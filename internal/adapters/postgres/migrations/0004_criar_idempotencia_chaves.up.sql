@@ -0,0 +1,11 @@
+CREATE TABLE idempotencia_chaves (
+    chave CHAR(64) PRIMARY KEY,
+    fingerprint CHAR(64) NOT NULL,
+    concluido BOOLEAN NOT NULL DEFAULT FALSE,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    content_type VARCHAR(100) NOT NULL DEFAULT '',
+    corpo BYTEA NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    expira_em TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_idempotencia_chaves_expira_em ON idempotencia_chaves (expira_em);
@@ -0,0 +1,13 @@
+CREATE TABLE importacao_jobs (
+    id CHAR(32) PRIMARY KEY,
+    status VARCHAR(20) NOT NULL,
+    total INTEGER NOT NULL,
+    processadas INTEGER NOT NULL DEFAULT 0,
+    relatorio JSONB NULL,
+    erro VARCHAR(500) NOT NULL DEFAULT '',
+    criado_por BIGINT NOT NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_importacao_jobs_status CHECK (status IN ('pendente','processando','concluido','falhou'))
+);
+CREATE INDEX idx_importacao_jobs_criado_por ON importacao_jobs (criado_por);
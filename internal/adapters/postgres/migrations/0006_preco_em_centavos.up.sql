@@ -0,0 +1,7 @@
+-- Preço passa a ser inteiro em centavos + moeda (ISO 4217). Os valores
+-- atuais (todos em reais) são convertidos sem perda: NUMERIC(10,2) × 100 é exato.
+ALTER TABLE itens
+    ADD COLUMN preco_centavos BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN moeda CHAR(3) NOT NULL DEFAULT 'BRL';
+UPDATE itens SET preco_centavos = (preco * 100)::BIGINT;
+ALTER TABLE itens DROP COLUMN preco;
@@ -0,0 +1,9 @@
+CREATE TABLE taxas_cambio (
+    moeda_origem CHAR(3) NOT NULL,
+    moeda_destino CHAR(3) NOT NULL,
+    taxa NUMERIC(24,10) NOT NULL,
+    atualizada_em TIMESTAMPTZ NOT NULL,
+    atualizada_por BIGINT NULL,
+    PRIMARY KEY (moeda_origem, moeda_destino),
+    CONSTRAINT chk_taxas_cambio_taxa CHECK (taxa > 0)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS precos_agendados;
+DROP TABLE IF EXISTS precos_historico;
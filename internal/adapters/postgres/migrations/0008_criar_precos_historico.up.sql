@@ -0,0 +1,30 @@
+CREATE TABLE precos_historico (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    preco_anterior_centavos BIGINT NULL,
+    moeda_anterior CHAR(3) NULL,
+    preco_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    alterado_em TIMESTAMPTZ NOT NULL,
+    alterado_por BIGINT NULL
+);
+CREATE INDEX idx_precos_historico_item_id ON precos_historico (item_id, alterado_em);
+CREATE TABLE precos_agendados (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    preco_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    vigente_a_partir_de TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pendente',
+    erro VARCHAR(500) NOT NULL DEFAULT '',
+    criado_por BIGINT NOT NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    aplicado_em TIMESTAMPTZ NULL,
+    CONSTRAINT chk_precos_agendados_status CHECK (status IN ('pendente','aplicado','cancelado','falhou')),
+    CONSTRAINT chk_precos_agendados_preco CHECK (preco_centavos > 0)
+);
+CREATE INDEX idx_precos_agendados_item_id ON precos_agendados (item_id);
+CREATE INDEX idx_precos_agendados_vencidos ON precos_agendados (vigente_a_partir_de) WHERE status = 'pendente';
+INSERT INTO precos_historico (item_id, preco_centavos, moeda, alterado_em, alterado_por)
+SELECT id, preco_centavos, moeda, COALESCE(updated_at, created_at, NOW()), COALESCE(updated_by, created_by)
+FROM itens;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS promocoes;
+DROP INDEX IF EXISTS idx_itens_categoria;
+ALTER TABLE itens DROP COLUMN tags, DROP COLUMN categoria;
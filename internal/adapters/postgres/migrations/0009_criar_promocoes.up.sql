@@ -0,0 +1,30 @@
+-- Categoria e tags do item são os alvos das promoções, além do próprio item
+ALTER TABLE itens
+    ADD COLUMN categoria VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN tags JSONB NULL;
+CREATE INDEX idx_itens_categoria ON itens (categoria);
+CREATE TABLE promocoes (
+    id BIGSERIAL PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL,
+    tipo VARCHAR(20) NOT NULL,
+    percentual BIGINT NOT NULL DEFAULT 0,
+    desconto_centavos BIGINT NOT NULL DEFAULT 0,
+    moeda CHAR(3) NULL,
+    alvo VARCHAR(20) NOT NULL,
+    item_id BIGINT NULL,
+    categoria VARCHAR(50) NOT NULL DEFAULT '',
+    tag VARCHAR(50) NOT NULL DEFAULT '',
+    inicio TIMESTAMPTZ NOT NULL,
+    fim TIMESTAMPTZ NOT NULL,
+    prioridade INTEGER NOT NULL DEFAULT 0,
+    cumulativa BOOLEAN NOT NULL DEFAULT FALSE,
+    ativa BOOLEAN NOT NULL DEFAULT TRUE,
+    criado_por BIGINT NOT NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_promocoes_tipo CHECK (tipo IN ('percentual','valor_fixo')),
+    CONSTRAINT chk_promocoes_alvo CHECK (alvo IN ('item','categoria','tag')),
+    CONSTRAINT chk_promocoes_periodo CHECK (fim > inicio)
+);
+CREATE INDEX idx_promocoes_item_id ON promocoes (item_id);
+CREATE INDEX idx_promocoes_vigencia ON promocoes (fim, inicio) WHERE ativa;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS transferencias_estoque;
+DROP TABLE IF EXISTS saldos_estoque;
+DROP TABLE IF EXISTS depositos;
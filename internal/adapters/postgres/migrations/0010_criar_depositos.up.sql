@@ -0,0 +1,36 @@
+-- Estoque por depósito. itens.estoque continua sendo o total; o estoque
+-- atual de cada item vai para o depósito padrão.
+CREATE TABLE depositos (
+    id BIGSERIAL PRIMARY KEY,
+    codigo VARCHAR(20) NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    padrao BOOLEAN NOT NULL DEFAULT FALSE,
+    criado_em TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX idx_depositos_codigo ON depositos (codigo);
+CREATE UNIQUE INDEX idx_depositos_padrao ON depositos (padrao) WHERE padrao;
+CREATE TABLE saldos_estoque (
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    deposito_id BIGINT NOT NULL REFERENCES depositos (id),
+    quantidade BIGINT NOT NULL DEFAULT 0,
+    atualizado_em TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (item_id, deposito_id),
+    CONSTRAINT chk_saldos_estoque_quantidade CHECK (quantidade >= 0)
+);
+CREATE INDEX idx_saldos_estoque_deposito_id ON saldos_estoque (deposito_id);
+CREATE TABLE transferencias_estoque (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    deposito_origem BIGINT NOT NULL REFERENCES depositos (id),
+    deposito_destino BIGINT NOT NULL REFERENCES depositos (id),
+    quantidade BIGINT NOT NULL,
+    usuario_id BIGINT NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_transferencias_estoque_quantidade CHECK (quantidade > 0)
+);
+CREATE INDEX idx_transferencias_estoque_item_id ON transferencias_estoque (item_id, criado_em);
+INSERT INTO depositos (codigo, nome, padrao, criado_em) VALUES ('PRINCIPAL', 'Depósito principal', TRUE, NOW());
+INSERT INTO saldos_estoque (item_id, deposito_id, quantidade, atualizado_em)
+SELECT i.id, d.id, i.estoque, NOW()
+FROM itens i CROSS JOIN depositos d
+WHERE d.padrao AND i.estoque <> 0;
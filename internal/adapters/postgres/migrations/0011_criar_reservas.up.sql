@@ -0,0 +1,16 @@
+-- Reservas de estoque. Disponível = itens.estoque − reservas ativas que
+-- ainda não venceram.
+CREATE TABLE reservas (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    quantidade BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ativa',
+    usuario_id BIGINT NOT NULL,
+    expira_em TIMESTAMPTZ NOT NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    concluida_em TIMESTAMPTZ NULL,
+    CONSTRAINT chk_reservas_status CHECK (status IN ('ativa','confirmada','cancelada','expirada')),
+    CONSTRAINT chk_reservas_quantidade CHECK (quantidade > 0)
+);
+CREATE INDEX idx_reservas_item_id ON reservas (item_id, status);
+CREATE INDEX idx_reservas_vencidas ON reservas (expira_em) WHERE status = 'ativa';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_itens_estoque_baixo;
+ALTER TABLE itens DROP COLUMN estoque_em_alerta, DROP COLUMN quantidade_reposicao, DROP COLUMN ponto_reposicao;
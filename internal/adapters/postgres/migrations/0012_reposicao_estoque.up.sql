@@ -0,0 +1,8 @@
+-- Ponto e quantidade de reposição; estoque_em_alerta guarda o último
+-- estado avisado para o alerta sair uma vez por cruzamento
+ALTER TABLE itens
+    ADD COLUMN ponto_reposicao BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN quantidade_reposicao BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN estoque_em_alerta BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT chk_itens_reposicao CHECK (ponto_reposicao >= 0 AND quantidade_reposicao >= 0);
+CREATE INDEX idx_itens_estoque_baixo ON itens (id) WHERE ponto_reposicao > 0 AND estoque <= ponto_reposicao;
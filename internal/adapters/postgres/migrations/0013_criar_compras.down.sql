@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS recebimentos_compra;
+DROP TABLE IF EXISTS pedidos_compra_linhas;
+DROP TABLE IF EXISTS pedidos_compra;
+DROP TABLE IF EXISTS itens_fornecedores;
+DROP TABLE IF EXISTS fornecedores;
@@ -0,0 +1,59 @@
+-- Fornecedores, itens de cada fornecedor e pedidos de compra. Receber uma
+-- linha soma a quantidade ao estoque do item.
+CREATE TABLE fornecedores (
+    id BIGSERIAL PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL,
+    documento VARCHAR(30) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    telefone VARCHAR(30) NOT NULL DEFAULT '',
+    criado_em TIMESTAMPTZ NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL
+);
+CREATE TABLE itens_fornecedores (
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    fornecedor_id BIGINT NOT NULL REFERENCES fornecedores (id),
+    sku VARCHAR(50) NOT NULL DEFAULT '',
+    custo_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (item_id, fornecedor_id),
+    CONSTRAINT chk_itens_fornecedores_custo CHECK (custo_centavos > 0)
+);
+CREATE INDEX idx_itens_fornecedores_fornecedor_id ON itens_fornecedores (fornecedor_id);
+CREATE TABLE pedidos_compra (
+    id BIGSERIAL PRIMARY KEY,
+    fornecedor_id BIGINT NOT NULL REFERENCES fornecedores (id),
+    status VARCHAR(30) NOT NULL DEFAULT 'rascunho',
+    observacao VARCHAR(500) NOT NULL DEFAULT '',
+    criado_por BIGINT NOT NULL,
+    criado_em TIMESTAMPTZ NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL,
+    enviado_em TIMESTAMPTZ NULL,
+    recebido_em TIMESTAMPTZ NULL,
+    CONSTRAINT chk_pedidos_compra_status CHECK (status IN ('rascunho','enviado','parcialmente_recebido','recebido','cancelado'))
+);
+CREATE INDEX idx_pedidos_compra_fornecedor_id ON pedidos_compra (fornecedor_id);
+CREATE INDEX idx_pedidos_compra_status ON pedidos_compra (status, criado_em);
+CREATE TABLE pedidos_compra_linhas (
+    id BIGSERIAL PRIMARY KEY,
+    pedido_id BIGINT NOT NULL REFERENCES pedidos_compra (id),
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    quantidade BIGINT NOT NULL,
+    recebida BIGINT NOT NULL DEFAULT 0,
+    custo_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    CONSTRAINT chk_pedidos_compra_linhas_recebida CHECK (recebida >= 0 AND recebida <= quantidade)
+);
+CREATE UNIQUE INDEX idx_pedidos_compra_linhas_item ON pedidos_compra_linhas (pedido_id, item_id);
+CREATE TABLE recebimentos_compra (
+    id BIGSERIAL PRIMARY KEY,
+    pedido_id BIGINT NOT NULL REFERENCES pedidos_compra (id),
+    linha_id BIGINT NOT NULL REFERENCES pedidos_compra_linhas (id),
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    quantidade BIGINT NOT NULL,
+    recebido_por BIGINT NOT NULL,
+    recebido_em TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_recebimentos_compra_quantidade CHECK (quantidade > 0)
+);
+CREATE INDEX idx_recebimentos_compra_pedido_id ON recebimentos_compra (pedido_id);
+CREATE INDEX idx_recebimentos_compra_item_id ON recebimentos_compra (item_id);
@@ -0,0 +1,26 @@
+-- Pedidos de venda. A confirmação baixa itens.estoque; o cancelamento de
+-- um pedido confirmado devolve.
+CREATE TABLE pedidos_venda (
+    id BIGSERIAL PRIMARY KEY,
+    usuario_id BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pendente',
+    criado_em TIMESTAMPTZ NOT NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL,
+    confirmado_em TIMESTAMPTZ NULL,
+    cancelado_em TIMESTAMPTZ NULL,
+    CONSTRAINT chk_pedidos_venda_status CHECK (status IN ('pendente','confirmado','cancelado'))
+);
+CREATE INDEX idx_pedidos_venda_usuario_id ON pedidos_venda (usuario_id, criado_em);
+CREATE TABLE pedidos_venda_linhas (
+    id BIGSERIAL PRIMARY KEY,
+    pedido_id BIGINT NOT NULL REFERENCES pedidos_venda (id),
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    code VARCHAR(50) NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    quantidade BIGINT NOT NULL,
+    preco_centavos BIGINT NOT NULL,
+    moeda CHAR(3) NOT NULL,
+    CONSTRAINT chk_pedidos_venda_linhas_quantidade CHECK (quantidade > 0)
+);
+CREATE INDEX idx_pedidos_venda_linhas_pedido_id ON pedidos_venda_linhas (pedido_id);
+CREATE INDEX idx_pedidos_venda_linhas_item_id ON pedidos_venda_linhas (item_id);
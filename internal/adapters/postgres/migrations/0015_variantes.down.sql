@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_itens_produto_id;
+ALTER TABLE itens
+    DROP CONSTRAINT IF EXISTS fk_itens_produto,
+    DROP COLUMN preco_proprio,
+    DROP COLUMN opcoes,
+    DROP COLUMN eixos,
+    DROP COLUMN produto_id;
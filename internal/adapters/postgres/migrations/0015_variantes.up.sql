@@ -0,0 +1,9 @@
+-- Variantes: o produto guarda os eixos (tamanho, cor...) e cada variante é
+-- um item com produto_id, um valor por eixo e estoque próprio
+ALTER TABLE itens
+    ADD COLUMN produto_id BIGINT NULL,
+    ADD COLUMN eixos JSONB NULL,
+    ADD COLUMN opcoes JSONB NULL,
+    ADD COLUMN preco_proprio BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT fk_itens_produto FOREIGN KEY (produto_id) REFERENCES itens (id);
+CREATE INDEX idx_itens_produto_id ON itens (produto_id);
@@ -0,0 +1,18 @@
+-- Imagens dos itens. Os arquivos (original e miniatura) ficam no BlobStore;
+-- aqui só as chaves, dimensões, ordem e qual é a principal.
+CREATE TABLE itens_imagens (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES itens (id),
+    chave VARCHAR(255) NOT NULL,
+    chave_miniatura VARCHAR(255) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    tamanho BIGINT NOT NULL,
+    largura INTEGER NOT NULL,
+    altura INTEGER NOT NULL,
+    ordem INTEGER NOT NULL,
+    principal BOOLEAN NOT NULL DEFAULT FALSE,
+    criado_por BIGINT NULL,
+    criado_em TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX idx_itens_imagens_chave ON itens_imagens (chave);
+CREATE INDEX idx_itens_imagens_item_id ON itens_imagens (item_id, ordem);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_itens_ean;
+ALTER TABLE itens DROP COLUMN ean;
@@ -0,0 +1,3 @@
+-- EAN-13 opcional do fabricante; itens sem EAN usam o interno, derivado do id
+ALTER TABLE itens ADD COLUMN ean VARCHAR(13) NULL;
+CREATE UNIQUE INDEX idx_itens_ean ON itens (ean);
@@ -0,0 +1,915 @@
+package postgres
+
+import (
+	cambioEntity "desafio-itens-app/internal/domain/cambio"
+	compraEntity "desafio-itens-app/internal/domain/compra"
+	depositoEntity "desafio-itens-app/internal/domain/deposito"
+	"desafio-itens-app/internal/domain/dinheiro"
+	fornecedorEntity "desafio-itens-app/internal/domain/fornecedor"
+	idempotenciaEntity "desafio-itens-app/internal/domain/idempotencia"
+	imagemEntity "desafio-itens-app/internal/domain/imagem"
+	importacaoEntity "desafio-itens-app/internal/domain/importacao"
+	entity "desafio-itens-app/internal/domain/item"
+	precoEntity "desafio-itens-app/internal/domain/preco"
+	promocaoEntity "desafio-itens-app/internal/domain/promocao"
+	reservaEntity "desafio-itens-app/internal/domain/reserva"
+	userEntity "desafio-itens-app/internal/domain/user"
+	vendaEntity "desafio-itens-app/internal/domain/venda"
+	"encoding/json"
+	"gorm.io/gorm"
+	"time"
+)
+
+// No PostgreSQL os "enums" viram CHECK constraints: mudar os valores
+// permitidos é só trocar a constraint, sem ALTER TYPE.
+type UserModel struct {
+	ID        int            `gorm:"primaryKey;autoIncrement"`
+	Username  string         `gorm:"uniqueIndex;size:50;not null"`
+	Email     string         `gorm:"uniqueIndex;size:255;not null"`
+	Password  string         `gorm:"size:255;not null"`
+	Role      string         `gorm:"size:20;default:'user';not null;check:chk_users_role,role IN ('admin','user')"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (UserModel) TableName() string {
+	return "users"
+}
+
+func (m *UserModel) toEntity() userEntity.User {
+	return userEntity.User{
+		ID:        m.ID,
+		Username:  m.Username,
+		Email:     m.Email,
+		Password:  m.Password,
+		Role:      userEntity.Role(m.Role),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func fromUserEntity(user userEntity.User) UserModel {
+	return UserModel{
+		ID:        user.ID,
+		Username:  user.Username,
+		Email:     user.Email,
+		Password:  user.Password,
+		Role:      string(user.Role),
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
+
+type ItemModel struct {
+	ID                  int               `gorm:"primaryKey;autoIncrement"`
+	Code                string            `gorm:"uniqueIndex;size:50;not null"`
+	EAN                 *string           `gorm:"column:ean;size:13;uniqueIndex"` // Opcional; NULL não conflita
+	Nome                string            `gorm:"size:100;not null"`
+	Descricao           string            `gorm:"size:500"`
+	Categoria           string            `gorm:"size:50;not null;default:'';index"`
+	Tags                []string          `gorm:"serializer:json;type:jsonb"`
+	PrecoCentavos       int64             `gorm:"column:preco_centavos;not null"`
+	Moeda               string            `gorm:"type:char(3);default:'BRL';not null"`
+	Estoque             int               `gorm:"default:0;not null"`
+	Status              string            `gorm:"size:20;default:'active';not null;check:chk_itens_status,status IN ('active','inactive')"`
+	PontoReposicao      int               `gorm:"not null;default:0;check:chk_itens_reposicao,ponto_reposicao >= 0 AND quantidade_reposicao >= 0"`
+	QuantidadeReposicao int               `gorm:"not null;default:0"`
+	EstoqueEmAlerta     bool              `gorm:"not null"`                // Sem default: o gorm pularia o false
+	ProdutoID           *int              `gorm:"column:produto_id;index"` // Variante: aponta para o produto
+	Eixos               []EixoModel       `gorm:"serializer:json;type:jsonb"`
+	Opcoes              map[string]string `gorm:"serializer:json;type:jsonb"`
+	PrecoProprio        bool              `gorm:"not null"`
+	CreatedAt           time.Time         `gorm:"autoCreateTime"`
+	UpdatedAt           time.Time         `gorm:"autoUpdateTime"`
+	DeletedAt           gorm.DeletedAt    `gorm:"index"`
+	CreatedBy           *int              `gorm:"column:created_by;index"`
+	UpdatedBy           *int              `gorm:"column:updated_by;index"`
+	CreatedByUser       *UserModel        `gorm:"foreignKey:CreatedBy;references:ID"`
+	UpdatedByUser       *UserModel        `gorm:"foreignKey:UpdatedBy;references:ID"`
+}
+
+func (ItemModel) TableName() string {
+	return "itens"
+}
+
+// 🔄 CONVERSÕES Domain ↔ Model
+func (m *ItemModel) ToEntity() entity.Item {
+	return entity.Item{
+		ID:        m.ID,
+		Code:      m.Code,
+		EAN:       m.EAN,
+		Nome:      m.Nome,
+		Descricao: m.Descricao,
+		Categoria: m.Categoria,
+		Tags:      m.Tags,
+		Preco:     dinheiro.Novo(m.PrecoCentavos, dinheiro.Moeda(m.Moeda)),
+		Estoque:   m.Estoque,
+		Status:    entity.Status(m.Status),
+
+		PontoReposicao:      m.PontoReposicao,
+		QuantidadeReposicao: m.QuantidadeReposicao,
+		EstoqueEmAlerta:     m.EstoqueEmAlerta,
+
+		ProdutoID:    m.ProdutoID,
+		Eixos:        toEixos(m.Eixos),
+		Opcoes:       m.Opcoes,
+		PrecoProprio: m.PrecoProprio,
+
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+		CreatedBy: m.CreatedBy,
+		UpdateBy:  m.UpdatedBy,
+	}
+}
+
+func FromEntity(item entity.Item) ItemModel {
+	return ItemModel{
+		ID:            item.ID,
+		Code:          item.Code,
+		EAN:           item.EAN,
+		Nome:          item.Nome,
+		Descricao:     item.Descricao,
+		Categoria:     item.Categoria,
+		Tags:          item.Tags,
+		PrecoCentavos: item.Preco.Centavos,
+		Moeda:         string(item.Preco.Moeda),
+		Estoque:       item.Estoque,
+		Status:        string(item.Status),
+
+		PontoReposicao:      item.PontoReposicao,
+		QuantidadeReposicao: item.QuantidadeReposicao,
+		EstoqueEmAlerta:     item.EstoqueEmAlerta,
+
+		ProdutoID:    item.ProdutoID,
+		Eixos:        fromEixos(item.Eixos),
+		Opcoes:       item.Opcoes,
+		PrecoProprio: item.PrecoProprio,
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		CreatedBy: item.CreatedBy,
+		UpdatedBy: item.UpdateBy,
+	}
+}
+
+// EixoModel é um eixo de variação gravado no JSON de itens.eixos
+type EixoModel struct {
+	Nome    string   `json:"nome"`
+	Valores []string `json:"valores"`
+}
+
+func toEixos(models []EixoModel) []entity.Eixo {
+	if len(models) == 0 {
+		return nil
+	}
+	eixos := make([]entity.Eixo, 0, len(models))
+	for _, m := range models {
+		eixos = append(eixos, entity.Eixo{Nome: m.Nome, Valores: m.Valores})
+	}
+	return eixos
+}
+
+func fromEixos(eixos []entity.Eixo) []EixoModel {
+	if len(eixos) == 0 {
+		return nil
+	}
+	models := make([]EixoModel, 0, len(eixos))
+	for _, e := range eixos {
+		models = append(models, EixoModel{Nome: e.Nome, Valores: e.Valores})
+	}
+	return models
+}
+
+// RateLimitModel é um balde do rate limiter (ver domain/ratelimit)
+type RateLimitModel struct {
+	Chave        string    `gorm:"primaryKey;size:191"`
+	Tokens       float64   `gorm:"not null"`
+	AtualizadoEm time.Time `gorm:"not null;index"`
+}
+
+func (RateLimitModel) TableName() string {
+	return "rate_limit_baldes"
+}
+
+// IdempotenciaModel guarda a resposta de uma Idempotency-Key
+type IdempotenciaModel struct {
+	Chave       string    `gorm:"primaryKey;size:64"`
+	Fingerprint string    `gorm:"size:64;not null"`
+	Concluido   bool      `gorm:"not null;default:false"`
+	StatusCode  int       `gorm:"not null;default:0"`
+	ContentType string    `gorm:"size:100;not null;default:''"`
+	Corpo       []byte    `gorm:"type:bytea"`
+	CriadoEm    time.Time `gorm:"not null"`
+	ExpiraEm    time.Time `gorm:"not null;index"`
+}
+
+func (IdempotenciaModel) TableName() string {
+	return "idempotencia_chaves"
+}
+
+func (m IdempotenciaModel) toEntity() idempotenciaEntity.Registro {
+	return idempotenciaEntity.Registro{
+		Chave:       m.Chave,
+		Fingerprint: m.Fingerprint,
+		Concluido:   m.Concluido,
+		StatusCode:  m.StatusCode,
+		ContentType: m.ContentType,
+		Corpo:       m.Corpo,
+		CriadoEm:    m.CriadoEm,
+		ExpiraEm:    m.ExpiraEm,
+	}
+}
+
+func fromIdempotenciaEntity(r idempotenciaEntity.Registro) IdempotenciaModel {
+	return IdempotenciaModel{
+		Chave:       r.Chave,
+		Fingerprint: r.Fingerprint,
+		Concluido:   r.Concluido,
+		StatusCode:  r.StatusCode,
+		ContentType: r.ContentType,
+		Corpo:       r.Corpo,
+		CriadoEm:    r.CriadoEm,
+		ExpiraEm:    r.ExpiraEm,
+	}
+}
+
+// ImportacaoJobModel guarda o andamento de uma importação assíncrona; o
+// relatório final vai serializado em JSON
+type ImportacaoJobModel struct {
+	ID           string    `gorm:"primaryKey;size:32"`
+	Status       string    `gorm:"size:20;not null;check:chk_importacao_jobs_status,status IN ('pendente','processando','concluido','falhou')"`
+	Total        int       `gorm:"not null"`
+	Processadas  int       `gorm:"not null;default:0"`
+	Relatorio    *string   `gorm:"type:jsonb"`
+	Erro         string    `gorm:"size:500;not null;default:''"`
+	CriadoPor    int       `gorm:"not null;index"`
+	CriadoEm     time.Time `gorm:"not null"`
+	AtualizadoEm time.Time `gorm:"not null"`
+}
+
+func (ImportacaoJobModel) TableName() string {
+	return "importacao_jobs"
+}
+
+func (m ImportacaoJobModel) toEntity() (importacaoEntity.Job, error) {
+	job := importacaoEntity.Job{
+		ID:           m.ID,
+		Status:       importacaoEntity.StatusJob(m.Status),
+		Total:        m.Total,
+		Processadas:  m.Processadas,
+		Erro:         m.Erro,
+		CriadoPor:    m.CriadoPor,
+		CriadoEm:     m.CriadoEm,
+		AtualizadoEm: m.AtualizadoEm,
+	}
+	if m.Relatorio != nil {
+		var relatorio importacaoEntity.Relatorio
+		if err := json.Unmarshal([]byte(*m.Relatorio), &relatorio); err != nil {
+			return importacaoEntity.Job{}, err
+		}
+		job.Relatorio = &relatorio
+	}
+	return job, nil
+}
+
+func fromImportacaoJobEntity(job importacaoEntity.Job) (ImportacaoJobModel, error) {
+	model := ImportacaoJobModel{
+		ID:           job.ID,
+		Status:       string(job.Status),
+		Total:        job.Total,
+		Processadas:  job.Processadas,
+		Erro:         job.Erro,
+		CriadoPor:    job.CriadoPor,
+		CriadoEm:     job.CriadoEm,
+		AtualizadoEm: job.AtualizadoEm,
+	}
+	if job.Relatorio != nil {
+		relatorio, err := json.Marshal(job.Relatorio)
+		if err != nil {
+			return ImportacaoJobModel{}, err
+		}
+		texto := string(relatorio)
+		model.Relatorio = &texto
+	}
+	return model, nil
+}
+
+// TaxaCambioModel guarda a taxa como texto decimal para não perder precisão
+type TaxaCambioModel struct {
+	MoedaOrigem   string    `gorm:"primaryKey;type:char(3)"`
+	MoedaDestino  string    `gorm:"primaryKey;type:char(3)"`
+	Taxa          string    `gorm:"type:numeric(24,10);not null"`
+	AtualizadaEm  time.Time `gorm:"not null"`
+	AtualizadaPor *int
+}
+
+func (TaxaCambioModel) TableName() string {
+	return "taxas_cambio"
+}
+
+func (m TaxaCambioModel) toEntity() (cambioEntity.Taxa, error) {
+	taxa, err := cambioEntity.NovaTaxa(dinheiro.Moeda(m.MoedaOrigem), dinheiro.Moeda(m.MoedaDestino), m.Taxa)
+	if err != nil {
+		return cambioEntity.Taxa{}, err
+	}
+	taxa.AtualizadaEm = m.AtualizadaEm
+	if m.AtualizadaPor != nil {
+		taxa.AtualizadaPor = *m.AtualizadaPor
+	}
+	return taxa, nil
+}
+
+func fromTaxaCambioEntity(taxa cambioEntity.Taxa) TaxaCambioModel {
+	model := TaxaCambioModel{
+		MoedaOrigem:  string(taxa.De),
+		MoedaDestino: string(taxa.Para),
+		Taxa:         taxa.Valor.FloatString(10),
+		AtualizadaEm: taxa.AtualizadaEm,
+	}
+	if taxa.AtualizadaPor != 0 {
+		model.AtualizadaPor = &taxa.AtualizadaPor
+	}
+	return model
+}
+
+// HistoricoPrecoModel é uma linha de precos_historico. O preço anterior
+// fica nulo no registro de criação do item.
+type HistoricoPrecoModel struct {
+	ID                    int       `gorm:"primaryKey;autoIncrement"`
+	ItemID                int       `gorm:"not null;index"`
+	PrecoAnteriorCentavos *int64    `gorm:"column:preco_anterior_centavos"`
+	MoedaAnterior         *string   `gorm:"type:char(3)"`
+	PrecoCentavos         int64     `gorm:"column:preco_centavos;not null"`
+	Moeda                 string    `gorm:"type:char(3);not null"`
+	AlteradoEm            time.Time `gorm:"not null"`
+	AlteradoPor           *int
+}
+
+func (HistoricoPrecoModel) TableName() string {
+	return "precos_historico"
+}
+
+func novoHistoricoPreco(anterior *ItemModel, atual ItemModel, alteradoPor *int) HistoricoPrecoModel {
+	model := HistoricoPrecoModel{
+		ItemID:        atual.ID,
+		PrecoCentavos: atual.PrecoCentavos,
+		Moeda:         atual.Moeda,
+		AlteradoEm:    atual.UpdatedAt,
+		AlteradoPor:   alteradoPor,
+	}
+	if anterior != nil {
+		model.PrecoAnteriorCentavos = &anterior.PrecoCentavos
+		model.MoedaAnterior = &anterior.Moeda
+	}
+	return model
+}
+
+func (m HistoricoPrecoModel) toEntity() precoEntity.Alteracao {
+	alteracao := precoEntity.Alteracao{
+		ID:          m.ID,
+		ItemID:      m.ItemID,
+		Preco:       dinheiro.Novo(m.PrecoCentavos, dinheiro.Moeda(m.Moeda)),
+		AlteradoEm:  m.AlteradoEm,
+		AlteradoPor: m.AlteradoPor,
+	}
+	if m.PrecoAnteriorCentavos != nil && m.MoedaAnterior != nil {
+		anterior := dinheiro.Novo(*m.PrecoAnteriorCentavos, dinheiro.Moeda(*m.MoedaAnterior))
+		alteracao.Anterior = &anterior
+	}
+	return alteracao
+}
+
+type PrecoAgendadoModel struct {
+	ID               int       `gorm:"primaryKey;autoIncrement"`
+	ItemID           int       `gorm:"not null;index"`
+	PrecoCentavos    int64     `gorm:"column:preco_centavos;not null"`
+	Moeda            string    `gorm:"type:char(3);not null"`
+	VigenteAPartirDe time.Time `gorm:"not null"`
+	Status           string    `gorm:"size:20;default:'pendente';not null;check:chk_precos_agendados_status,status IN ('pendente','aplicado','cancelado','falhou')"`
+	Erro             string    `gorm:"size:500;not null;default:''"`
+	CriadoPor        int       `gorm:"not null"`
+	CriadoEm         time.Time `gorm:"not null"`
+	AplicadoEm       *time.Time
+}
+
+func (PrecoAgendadoModel) TableName() string {
+	return "precos_agendados"
+}
+
+func (m PrecoAgendadoModel) toEntity() precoEntity.Agendamento {
+	return precoEntity.Agendamento{
+		ID:               m.ID,
+		ItemID:           m.ItemID,
+		Preco:            dinheiro.Novo(m.PrecoCentavos, dinheiro.Moeda(m.Moeda)),
+		VigenteAPartirDe: m.VigenteAPartirDe,
+		Status:           precoEntity.StatusAgendamento(m.Status),
+		Erro:             m.Erro,
+		CriadoPor:        m.CriadoPor,
+		CriadoEm:         m.CriadoEm,
+		AplicadoEm:       m.AplicadoEm,
+	}
+}
+
+func fromAgendamentoEntity(a precoEntity.Agendamento) PrecoAgendadoModel {
+	return PrecoAgendadoModel{
+		ID:               a.ID,
+		ItemID:           a.ItemID,
+		PrecoCentavos:    a.Preco.Centavos,
+		Moeda:            string(a.Preco.Moeda),
+		VigenteAPartirDe: a.VigenteAPartirDe,
+		Status:           string(a.Status),
+		Erro:             a.Erro,
+		CriadoPor:        a.CriadoPor,
+		CriadoEm:         a.CriadoEm,
+		AplicadoEm:       a.AplicadoEm,
+	}
+}
+
+// PromocaoModel: o desconto fixo guarda centavos e moeda; o percentual,
+// centésimos de ponto (1550 = 15,5%)
+type PromocaoModel struct {
+	ID               int       `gorm:"primaryKey;autoIncrement"`
+	Nome             string    `gorm:"size:100;not null"`
+	Tipo             string    `gorm:"size:20;not null;check:chk_promocoes_tipo,tipo IN ('percentual','valor_fixo')"`
+	Percentual       int64     `gorm:"not null;default:0"`
+	DescontoCentavos int64     `gorm:"column:desconto_centavos;not null;default:0"`
+	Moeda            *string   `gorm:"type:char(3)"`
+	Alvo             string    `gorm:"size:20;not null;check:chk_promocoes_alvo,alvo IN ('item','categoria','tag')"`
+	ItemID           *int      `gorm:"index"`
+	Categoria        string    `gorm:"size:50;not null;default:''"`
+	Tag              string    `gorm:"size:50;not null;default:''"`
+	Inicio           time.Time `gorm:"not null"`
+	Fim              time.Time `gorm:"not null"`
+	Prioridade       int       `gorm:"not null;default:0"`
+	Cumulativa       bool      `gorm:"not null"`
+	Ativa            bool      `gorm:"not null"` // Sem default na tag: o GORM pularia o false
+	CriadoPor        int       `gorm:"not null"`
+	CriadoEm         time.Time `gorm:"not null"`
+	AtualizadoEm     time.Time `gorm:"not null"`
+}
+
+func (PromocaoModel) TableName() string {
+	return "promocoes"
+}
+
+func (m PromocaoModel) toEntity() promocaoEntity.Promocao {
+	p := promocaoEntity.Promocao{
+		ID:           m.ID,
+		Nome:         m.Nome,
+		Tipo:         promocaoEntity.Tipo(m.Tipo),
+		Percentual:   m.Percentual,
+		Alvo:         promocaoEntity.Alvo(m.Alvo),
+		Categoria:    m.Categoria,
+		Tag:          m.Tag,
+		Inicio:       m.Inicio,
+		Fim:          m.Fim,
+		Prioridade:   m.Prioridade,
+		Cumulativa:   m.Cumulativa,
+		Ativa:        m.Ativa,
+		CriadoPor:    m.CriadoPor,
+		CriadoEm:     m.CriadoEm,
+		AtualizadoEm: m.AtualizadoEm,
+	}
+	if m.Moeda != nil {
+		p.Desconto = dinheiro.Novo(m.DescontoCentavos, dinheiro.Moeda(*m.Moeda))
+	}
+	if m.ItemID != nil {
+		p.ItemID = *m.ItemID
+	}
+	return p
+}
+
+func fromPromocaoEntity(p promocaoEntity.Promocao) PromocaoModel {
+	model := PromocaoModel{
+		ID:           p.ID,
+		Nome:         p.Nome,
+		Tipo:         string(p.Tipo),
+		Percentual:   p.Percentual,
+		Alvo:         string(p.Alvo),
+		Categoria:    p.Categoria,
+		Tag:          p.Tag,
+		Inicio:       p.Inicio,
+		Fim:          p.Fim,
+		Prioridade:   p.Prioridade,
+		Cumulativa:   p.Cumulativa,
+		Ativa:        p.Ativa,
+		CriadoPor:    p.CriadoPor,
+		CriadoEm:     p.CriadoEm,
+		AtualizadoEm: p.AtualizadoEm,
+	}
+	if p.Tipo == promocaoEntity.TipoValorFixo {
+		moeda := string(p.Desconto.Moeda)
+		model.DescontoCentavos = p.Desconto.Centavos
+		model.Moeda = &moeda
+	}
+	if p.Alvo == promocaoEntity.AlvoItem {
+		model.ItemID = &p.ItemID
+	}
+	return model
+}
+
+type DepositoModel struct {
+	ID       int       `gorm:"primaryKey;autoIncrement"`
+	Codigo   string    `gorm:"uniqueIndex;size:20;not null"`
+	Nome     string    `gorm:"size:100;not null"`
+	Padrao   bool      `gorm:"not null"`
+	CriadoEm time.Time `gorm:"not null"`
+}
+
+func (DepositoModel) TableName() string {
+	return "depositos"
+}
+
+func (m DepositoModel) toEntity() depositoEntity.Deposito {
+	return depositoEntity.Deposito{
+		ID:       m.ID,
+		Codigo:   m.Codigo,
+		Nome:     m.Nome,
+		Padrao:   m.Padrao,
+		CriadoEm: m.CriadoEm,
+	}
+}
+
+// SaldoEstoqueModel é a quantidade de um item num depósito
+type SaldoEstoqueModel struct {
+	ItemID       int       `gorm:"primaryKey"`
+	DepositoID   int       `gorm:"primaryKey"`
+	Quantidade   int       `gorm:"not null"`
+	AtualizadoEm time.Time `gorm:"not null"`
+}
+
+func (SaldoEstoqueModel) TableName() string {
+	return "saldos_estoque"
+}
+
+type TransferenciaEstoqueModel struct {
+	ID         int `gorm:"primaryKey;autoIncrement"`
+	ItemID     int `gorm:"not null;index"`
+	Origem     int `gorm:"column:deposito_origem;not null"`
+	Destino    int `gorm:"column:deposito_destino;not null"`
+	Quantidade int `gorm:"not null"`
+	UsuarioID  *int
+	CriadoEm   time.Time `gorm:"not null"`
+}
+
+func (TransferenciaEstoqueModel) TableName() string {
+	return "transferencias_estoque"
+}
+
+func (m TransferenciaEstoqueModel) toEntity() depositoEntity.Transferencia {
+	t := depositoEntity.Transferencia{
+		ID:         m.ID,
+		ItemID:     m.ItemID,
+		Origem:     m.Origem,
+		Destino:    m.Destino,
+		Quantidade: m.Quantidade,
+		CriadoEm:   m.CriadoEm,
+	}
+	if m.UsuarioID != nil {
+		t.UsuarioID = *m.UsuarioID
+	}
+	return t
+}
+
+type ReservaModel struct {
+	ID          int       `gorm:"primaryKey;autoIncrement"`
+	ItemID      int       `gorm:"not null;index"`
+	Quantidade  int       `gorm:"not null"`
+	Status      string    `gorm:"size:20;default:'ativa';not null;check:chk_reservas_status,status IN ('ativa','confirmada','cancelada','expirada')"`
+	UsuarioID   int       `gorm:"not null"`
+	ExpiraEm    time.Time `gorm:"not null"`
+	CriadoEm    time.Time `gorm:"not null"`
+	ConcluidaEm *time.Time
+}
+
+func (ReservaModel) TableName() string {
+	return "reservas"
+}
+
+func (m ReservaModel) toEntity() reservaEntity.Reserva {
+	return reservaEntity.Reserva{
+		ID:          m.ID,
+		ItemID:      m.ItemID,
+		Quantidade:  m.Quantidade,
+		Status:      reservaEntity.Status(m.Status),
+		UsuarioID:   m.UsuarioID,
+		ExpiraEm:    m.ExpiraEm,
+		CriadoEm:    m.CriadoEm,
+		ConcluidaEm: m.ConcluidaEm,
+	}
+}
+
+type FornecedorModel struct {
+	ID           int       `gorm:"primaryKey;autoIncrement"`
+	Nome         string    `gorm:"size:100;not null"`
+	Documento    string    `gorm:"size:30;not null;default:''"`
+	Email        string    `gorm:"size:255;not null;default:''"`
+	Telefone     string    `gorm:"size:30;not null;default:''"`
+	CriadoEm     time.Time `gorm:"not null"`
+	AtualizadoEm time.Time `gorm:"not null"`
+}
+
+func (FornecedorModel) TableName() string {
+	return "fornecedores"
+}
+
+func (m FornecedorModel) toEntity() fornecedorEntity.Fornecedor {
+	return fornecedorEntity.Fornecedor{
+		ID:           m.ID,
+		Nome:         m.Nome,
+		Documento:    m.Documento,
+		Email:        m.Email,
+		Telefone:     m.Telefone,
+		CriadoEm:     m.CriadoEm,
+		AtualizadoEm: m.AtualizadoEm,
+	}
+}
+
+func fromFornecedorEntity(f fornecedorEntity.Fornecedor) FornecedorModel {
+	return FornecedorModel{
+		ID:           f.ID,
+		Nome:         f.Nome,
+		Documento:    f.Documento,
+		Email:        f.Email,
+		Telefone:     f.Telefone,
+		CriadoEm:     f.CriadoEm,
+		AtualizadoEm: f.AtualizadoEm,
+	}
+}
+
+// ItemFornecedorModel é o SKU e o custo de um item num fornecedor
+type ItemFornecedorModel struct {
+	ItemID        int       `gorm:"primaryKey"`
+	FornecedorID  int       `gorm:"primaryKey;index"`
+	SKU           string    `gorm:"column:sku;size:50;not null;default:''"`
+	CustoCentavos int64     `gorm:"column:custo_centavos;not null"`
+	Moeda         string    `gorm:"type:char(3);not null"`
+	AtualizadoEm  time.Time `gorm:"not null"`
+}
+
+func (ItemFornecedorModel) TableName() string {
+	return "itens_fornecedores"
+}
+
+func (m ItemFornecedorModel) toEntity() fornecedorEntity.ItemFornecedor {
+	return fornecedorEntity.ItemFornecedor{
+		ItemID:       m.ItemID,
+		FornecedorID: m.FornecedorID,
+		SKU:          m.SKU,
+		Custo:        dinheiro.Novo(m.CustoCentavos, dinheiro.Moeda(m.Moeda)),
+		AtualizadoEm: m.AtualizadoEm,
+	}
+}
+
+type PedidoCompraModel struct {
+	ID           int                      `gorm:"primaryKey;autoIncrement"`
+	FornecedorID int                      `gorm:"not null;index"`
+	Status       string                   `gorm:"size:30;default:'rascunho';not null;check:chk_pedidos_compra_status,status IN ('rascunho','enviado','parcialmente_recebido','recebido','cancelado')"`
+	Observacao   string                   `gorm:"size:500;not null;default:''"`
+	Linhas       []PedidoCompraLinhaModel `gorm:"foreignKey:PedidoID"`
+	CriadoPor    int                      `gorm:"not null"`
+	CriadoEm     time.Time                `gorm:"not null"`
+	AtualizadoEm time.Time                `gorm:"not null"`
+	EnviadoEm    *time.Time
+	RecebidoEm   *time.Time
+}
+
+func (PedidoCompraModel) TableName() string {
+	return "pedidos_compra"
+}
+
+func (m PedidoCompraModel) toEntity() compraEntity.Pedido {
+	p := compraEntity.Pedido{
+		ID:           m.ID,
+		FornecedorID: m.FornecedorID,
+		Status:       compraEntity.Status(m.Status),
+		Observacao:   m.Observacao,
+		Linhas:       make([]compraEntity.Linha, 0, len(m.Linhas)),
+		CriadoPor:    m.CriadoPor,
+		CriadoEm:     m.CriadoEm,
+		AtualizadoEm: m.AtualizadoEm,
+		EnviadoEm:    m.EnviadoEm,
+		RecebidoEm:   m.RecebidoEm,
+	}
+	for _, linha := range m.Linhas {
+		p.Linhas = append(p.Linhas, linha.toEntity())
+	}
+	return p
+}
+
+func fromPedidoCompraEntity(p compraEntity.Pedido) PedidoCompraModel {
+	model := PedidoCompraModel{
+		ID:           p.ID,
+		FornecedorID: p.FornecedorID,
+		Status:       string(p.Status),
+		Observacao:   p.Observacao,
+		CriadoPor:    p.CriadoPor,
+		CriadoEm:     p.CriadoEm,
+		AtualizadoEm: p.AtualizadoEm,
+		EnviadoEm:    p.EnviadoEm,
+		RecebidoEm:   p.RecebidoEm,
+	}
+	for _, linha := range p.Linhas {
+		model.Linhas = append(model.Linhas, fromLinhaCompraEntity(p.ID, linha))
+	}
+	return model
+}
+
+// PedidoCompraLinhaModel: um item por pedido
+type PedidoCompraLinhaModel struct {
+	ID            int    `gorm:"primaryKey;autoIncrement"`
+	PedidoID      int    `gorm:"not null;uniqueIndex:idx_pedidos_compra_linhas_item"`
+	ItemID        int    `gorm:"not null;uniqueIndex:idx_pedidos_compra_linhas_item"`
+	Quantidade    int    `gorm:"not null"`
+	Recebida      int    `gorm:"not null;default:0"`
+	CustoCentavos int64  `gorm:"column:custo_centavos;not null"`
+	Moeda         string `gorm:"type:char(3);not null"`
+}
+
+func (PedidoCompraLinhaModel) TableName() string {
+	return "pedidos_compra_linhas"
+}
+
+func (m PedidoCompraLinhaModel) toEntity() compraEntity.Linha {
+	return compraEntity.Linha{
+		ID:            m.ID,
+		ItemID:        m.ItemID,
+		Quantidade:    m.Quantidade,
+		Recebida:      m.Recebida,
+		CustoUnitario: dinheiro.Novo(m.CustoCentavos, dinheiro.Moeda(m.Moeda)),
+	}
+}
+
+func fromLinhaCompraEntity(pedidoID int, l compraEntity.Linha) PedidoCompraLinhaModel {
+	return PedidoCompraLinhaModel{
+		ID:            l.ID,
+		PedidoID:      pedidoID,
+		ItemID:        l.ItemID,
+		Quantidade:    l.Quantidade,
+		Recebida:      l.Recebida,
+		CustoCentavos: l.CustoUnitario.Centavos,
+		Moeda:         string(l.CustoUnitario.Moeda),
+	}
+}
+
+// RecebimentoCompraModel é cada entrada de mercadoria de uma linha
+type RecebimentoCompraModel struct {
+	ID          int       `gorm:"primaryKey;autoIncrement"`
+	PedidoID    int       `gorm:"not null;index"`
+	LinhaID     int       `gorm:"not null"`
+	ItemID      int       `gorm:"not null;index"`
+	Quantidade  int       `gorm:"not null"`
+	RecebidoPor int       `gorm:"not null"`
+	RecebidoEm  time.Time `gorm:"not null"`
+}
+
+func (RecebimentoCompraModel) TableName() string {
+	return "recebimentos_compra"
+}
+
+func (m RecebimentoCompraModel) toEntity() compraEntity.Recebimento {
+	return compraEntity.Recebimento{
+		ID:          m.ID,
+		PedidoID:    m.PedidoID,
+		LinhaID:     m.LinhaID,
+		ItemID:      m.ItemID,
+		Quantidade:  m.Quantidade,
+		RecebidoPor: m.RecebidoPor,
+		RecebidoEm:  m.RecebidoEm,
+	}
+}
+
+type PedidoVendaModel struct {
+	ID           int                     `gorm:"primaryKey;autoIncrement"`
+	UsuarioID    int                     `gorm:"not null;index"`
+	Status       string                  `gorm:"size:20;default:'pendente';not null;check:chk_pedidos_venda_status,status IN ('pendente','confirmado','cancelado')"`
+	Linhas       []PedidoVendaLinhaModel `gorm:"foreignKey:PedidoID"`
+	CriadoEm     time.Time               `gorm:"not null"`
+	AtualizadoEm time.Time               `gorm:"not null"`
+	ConfirmadoEm *time.Time
+	CanceladoEm  *time.Time
+}
+
+func (PedidoVendaModel) TableName() string {
+	return "pedidos_venda"
+}
+
+func (m PedidoVendaModel) toEntity() vendaEntity.Pedido {
+	p := vendaEntity.Pedido{
+		ID:           m.ID,
+		UsuarioID:    m.UsuarioID,
+		Status:       vendaEntity.Status(m.Status),
+		Linhas:       make([]vendaEntity.Linha, 0, len(m.Linhas)),
+		CriadoEm:     m.CriadoEm,
+		AtualizadoEm: m.AtualizadoEm,
+		ConfirmadoEm: m.ConfirmadoEm,
+		CanceladoEm:  m.CanceladoEm,
+	}
+	for _, linha := range m.Linhas {
+		p.Linhas = append(p.Linhas, vendaEntity.Linha{
+			ID:            linha.ID,
+			ItemID:        linha.ItemID,
+			Code:          linha.Code,
+			Nome:          linha.Nome,
+			Quantidade:    linha.Quantidade,
+			PrecoUnitario: dinheiro.Novo(linha.PrecoCentavos, dinheiro.Moeda(linha.Moeda)),
+		})
+	}
+	return p
+}
+
+func fromPedidoVendaEntity(p vendaEntity.Pedido) PedidoVendaModel {
+	model := PedidoVendaModel{
+		ID:           p.ID,
+		UsuarioID:    p.UsuarioID,
+		Status:       string(p.Status),
+		CriadoEm:     p.CriadoEm,
+		AtualizadoEm: p.AtualizadoEm,
+		ConfirmadoEm: p.ConfirmadoEm,
+		CanceladoEm:  p.CanceladoEm,
+	}
+	for _, linha := range p.Linhas {
+		model.Linhas = append(model.Linhas, PedidoVendaLinhaModel{
+			ID:            linha.ID,
+			PedidoID:      p.ID,
+			ItemID:        linha.ItemID,
+			Code:          linha.Code,
+			Nome:          linha.Nome,
+			Quantidade:    linha.Quantidade,
+			PrecoCentavos: linha.PrecoUnitario.Centavos,
+			Moeda:         string(linha.PrecoUnitario.Moeda),
+		})
+	}
+	return model
+}
+
+// PedidoVendaLinhaModel guarda code, nome e preço do item no momento do
+// pedido
+type PedidoVendaLinhaModel struct {
+	ID            int    `gorm:"primaryKey;autoIncrement"`
+	PedidoID      int    `gorm:"not null;index"`
+	ItemID        int    `gorm:"not null;index"`
+	Code          string `gorm:"size:50;not null"`
+	Nome          string `gorm:"size:100;not null"`
+	Quantidade    int    `gorm:"not null"`
+	PrecoCentavos int64  `gorm:"column:preco_centavos;not null"`
+	Moeda         string `gorm:"type:char(3);not null"`
+}
+
+func (PedidoVendaLinhaModel) TableName() string {
+	return "pedidos_venda_linhas"
+}
+
+type ImagemModel struct {
+	ID             int    `gorm:"primaryKey;autoIncrement"`
+	ItemID         int    `gorm:"not null;index"`
+	Chave          string `gorm:"size:255;not null;uniqueIndex"`
+	ChaveMiniatura string `gorm:"size:255;not null"`
+	ContentType    string `gorm:"size:50;not null"`
+	Tamanho        int64  `gorm:"not null"`
+	Largura        int    `gorm:"not null"`
+	Altura         int    `gorm:"not null"`
+	Ordem          int    `gorm:"not null"`
+	Principal      bool   `gorm:"not null"` // Sem default: o gorm pularia o false
+	CriadoPor      *int
+	CriadoEm       time.Time `gorm:"not null"`
+}
+
+func (ImagemModel) TableName() string {
+	return "itens_imagens"
+}
+
+func (m ImagemModel) toEntity() imagemEntity.Imagem {
+	return imagemEntity.Imagem{
+		ID:             m.ID,
+		ItemID:         m.ItemID,
+		Chave:          m.Chave,
+		ChaveMiniatura: m.ChaveMiniatura,
+		ContentType:    m.ContentType,
+		Tamanho:        m.Tamanho,
+		Largura:        m.Largura,
+		Altura:         m.Altura,
+		Ordem:          m.Ordem,
+		Principal:      m.Principal,
+		CriadoPor:      m.CriadoPor,
+		CriadoEm:       m.CriadoEm,
+	}
+}
+
+func fromImagemEntity(img imagemEntity.Imagem) ImagemModel {
+	return ImagemModel{
+		ID:             img.ID,
+		ItemID:         img.ItemID,
+		Chave:          img.Chave,
+		ChaveMiniatura: img.ChaveMiniatura,
+		ContentType:    img.ContentType,
+		Tamanho:        img.Tamanho,
+		Largura:        img.Largura,
+		Altura:         img.Altura,
+		Ordem:          img.Ordem,
+		Principal:      img.Principal,
+		CriadoPor:      img.CriadoPor,
+		CriadoEm:       img.CriadoEm,
+	}
+}
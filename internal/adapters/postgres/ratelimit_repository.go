@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/ratelimit"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// PostgresRateLimitRepository divide a cota entre todas as réplicas da API.
+// Cada consumo é uma transação curta com a linha do balde travada.
+type PostgresRateLimitRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresRateLimitRepository(db *gorm.DB) *PostgresRateLimitRepository {
+	return &PostgresRateLimitRepository{db: db}
+}
+
+func (r *PostgresRateLimitRepository) Consumir(ctx context.Context, chave string, politica ratelimit.Politica, agora time.Time) (ratelimit.Resultado, error) {
+	var resultado ratelimit.Resultado
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Primeiro acesso da chave: cria o balde cheio (se outra requisição
+		// criou antes, o conflito é ignorado e seguimos com o dela)
+		cheio := politica.Cheio(agora)
+		novo := RateLimitModel{Chave: chave, Tokens: cheio.Tokens, AtualizadoEm: cheio.AtualizadoEm}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&novo).Error; err != nil {
+			return err
+		}
+
+		var model RateLimitModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("chave = ?", chave).
+			First(&model).Error; err != nil {
+			return err
+		}
+
+		balde, res := politica.Consumir(ratelimit.Balde{Tokens: model.Tokens, AtualizadoEm: model.AtualizadoEm}, agora)
+		resultado = res
+
+		return tx.Model(&RateLimitModel{}).
+			Where("chave = ?", chave).
+			Updates(map[string]any{"tokens": balde.Tokens, "atualizado_em": balde.AtualizadoEm}).Error
+	})
+	if err != nil {
+		return ratelimit.Resultado{}, fmt.Errorf("Erro ao consumir cota: %w", err)
+	}
+
+	return resultado, nil
+}
+
+func (r *PostgresRateLimitRepository) Limpar(ctx context.Context, antesDe time.Time) (int, error) {
+	result := r.db.WithContext(ctx).
+		Where("atualizado_em < ?", antesDe).
+		Delete(&RateLimitModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("Erro ao limpar baldes: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"gorm.io/gorm"
+)
+
+type (
+	chaveTransacao  struct{}
+	chaveAposCommit struct{}
+)
+
+// PostgresTransacionador abre a transação e a carrega no contexto; conexao()
+// usa essa transação em vez do pool enquanto ela existir
+type PostgresTransacionador struct {
+	db *gorm.DB
+}
+
+var _ repositories.Transacionador = (*PostgresTransacionador)(nil)
+
+func NewPostgresTransacionador(db *gorm.DB) *PostgresTransacionador {
+	return &PostgresTransacionador{db: db}
+}
+
+func (t *PostgresTransacionador) EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error {
+	if transacaoDoContexto(ctx) != nil {
+		return fn(ctx) // Já dentro de uma transação: participa dela
+	}
+
+	var aposCommit []func()
+	ctx = context.WithValue(ctx, chaveAposCommit{}, &aposCommit)
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, chaveTransacao{}, tx))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range aposCommit {
+		f()
+	}
+	return nil
+}
+
+func (t *PostgresTransacionador) AposCommit(ctx context.Context, fn func()) {
+	pendentes, ok := ctx.Value(chaveAposCommit{}).(*[]func())
+	if !ok || transacaoDoContexto(ctx) == nil {
+		fn() // Fora de transação: nada a esperar
+		return
+	}
+	*pendentes = append(*pendentes, fn)
+}
+
+func transacaoDoContexto(ctx context.Context) *gorm.DB {
+	tx, _ := ctx.Value(chaveTransacao{}).(*gorm.DB)
+	return tx
+}
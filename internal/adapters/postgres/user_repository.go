@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	userDomain "desafio-itens-app/internal/domain/user"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"log/slog"
+)
+
+type PostgresUserRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+var _ repositories.UserRepository = (*PostgresUserRepository)(nil)
+
+func NewPostgresUserRepository(db *gorm.DB, logger *slog.Logger) *PostgresUserRepository {
+	return &PostgresUserRepository{db: db, logger: logger}
+}
+
+func (r *PostgresUserRepository) Create(ctx context.Context, user userDomain.User) (userDomain.User, error) {
+	model := fromUserEntity(user)
+
+	err := conexao(ctx, r.db).Create(&model).Error
+	if err != nil {
+		return userDomain.User{}, fmt.Errorf("erro ao criar usuário: %w", traduzirErro(err))
+	}
+
+	return model.toEntity(), nil
+}
+
+func (r *PostgresUserRepository) GetById(ctx context.Context, id int) (*userDomain.User, error) {
+	var model UserModel
+
+	err := conexao(ctx, r.db).First(&model, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.DebugContext(ctx, "usuário não encontrado", "user_id", id)
+			return nil, fmt.Errorf("usuário com ID %d não encontrado", id)
+		}
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	user := model.toEntity()
+	return &user, nil
+}
+
+func (r *PostgresUserRepository) List(ctx context.Context, limit, offset int) ([]*userDomain.User, int64, error) {
+	var models []UserModel
+	var totalCount int64
+
+	err := conexao(ctx, r.db).Model(&UserModel{}).Count(&totalCount).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("erro ao contar os usuários: %w", err)
+	}
+
+	err = conexao(ctx, r.db).Limit(limit).Offset(offset).Order("created_at DESC").Find(&models).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("erro ao buscar usuários: %w", err)
+	}
+
+	var users []*userDomain.User
+	for _, model := range models {
+		user := model.toEntity()
+		users = append(users, &user)
+	}
+
+	return users, totalCount, nil
+}
+
+func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username string) (*userDomain.User, error) {
+	var model UserModel
+	err := conexao(ctx, r.db).Where("username = ?", username).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.DebugContext(ctx, "usuário não encontrado", "username", username)
+			return nil, fmt.Errorf("usuário %s não encontrado", username)
+		}
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	user := model.toEntity()
+	return &user, nil
+}
+
+func (r *PostgresUserRepository) GetByEmail(ctx context.Context, email string) (*userDomain.User, error) {
+	var model UserModel
+
+	err := conexao(ctx, r.db).Where("email = ?", email).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.DebugContext(ctx, "usuário não encontrado por email")
+			return nil, fmt.Errorf("usuário com email %s não encontrado", email)
+		}
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	user := model.toEntity()
+	return &user, nil
+}
+
+func (r *PostgresUserRepository) Update(ctx context.Context, user userDomain.User) error {
+	model := fromUserEntity(user)
+
+	err := conexao(ctx, r.db).Save(&model).Error
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar usuário: %w", traduzirErro(err))
+	}
+	return nil
+}
+
+func (r *PostgresUserRepository) Delete(ctx context.Context, id int) error {
+	result := conexao(ctx, r.db).Delete(&UserModel{}, id)
+
+	if result.Error != nil {
+		return fmt.Errorf("erro ao deletar usuário: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		r.logger.WarnContext(ctx, "delete não afetou nenhuma linha", "user_id", id)
+		return fmt.Errorf("usuário com ID %d não encontrado", id)
+	}
+	return nil
+}
+
+func (r *PostgresUserRepository) UserNameExists(ctx context.Context, username string) (bool, error) {
+	var count int64
+
+	err := conexao(ctx, r.db).Model(&UserModel{}).Where("username = ?", username).Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar username: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *PostgresUserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	var count int64
+
+	err := conexao(ctx, r.db).Model(&UserModel{}).Where("email = ?", email).Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar email: %w", err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,69 @@
+package prometheus
+
+import (
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+	"time"
+)
+
+const chaveInicio = "metricas:inicio"
+
+// InstrumentarGORM registra callbacks que medem cada operação do GORM e
+// expõe as estatísticas do pool de conexões (abertas, em uso, esperas...).
+func (m *Metricas) InstrumentarGORM(db *gorm.DB, nomeBanco string) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("erro ao obter pool de conexões: %w", err)
+	}
+	if err := m.registry.Register(collectors.NewDBStatsCollector(sqlDB, nomeBanco)); err != nil {
+		return fmt.Errorf("erro ao registrar métricas do pool: %w", err)
+	}
+
+	cb := db.Callback()
+	registros := []struct {
+		operacao string
+		antes    func(string, func(*gorm.DB)) error
+		depois   func(string, func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range registros {
+		if err := r.antes("metricas:antes_"+r.operacao, iniciarCronometro); err != nil {
+			return err
+		}
+		if err := r.depois("metricas:depois_"+r.operacao, m.observarQuery(r.operacao)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iniciarCronometro(db *gorm.DB) {
+	db.InstanceSet(chaveInicio, time.Now())
+}
+
+func (m *Metricas) observarQuery(operacao string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(chaveInicio)
+		if !ok {
+			return
+		}
+		inicio, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		tabela := db.Statement.Table
+		if tabela == "" {
+			tabela = "desconhecida"
+		}
+		m.dbDuracao.WithLabelValues(operacao, tabela).Observe(time.Since(inicio).Seconds())
+	}
+}
@@ -0,0 +1,25 @@
+package prometheus
+
+import (
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"time"
+)
+
+// Middleware mede as requisições usando o template da rota
+// ("/v1/itens/:id") e não o caminho, para não explodir a cardinalidade.
+func (m *Metricas) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		inicio := time.Now()
+
+		c.Next()
+
+		rota := c.FullPath()
+		if rota == "" {
+			rota = "nao_encontrada"
+		}
+
+		m.httpRequisicoes.WithLabelValues(rota, c.Request.Method, strconv.Itoa(c.Writer.Status())).Inc()
+		m.httpDuracao.WithLabelValues(rota, c.Request.Method).Observe(time.Since(inicio).Seconds())
+	}
+}
@@ -0,0 +1,115 @@
+package prometheus
+
+import (
+	"desafio-itens-app/internal/application/ports/metrics"
+	"desafio-itens-app/internal/domain/item"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+const namespace = "itens_app"
+
+// Metricas guarda o registry e os coletores da aplicação. Implementa a
+// porta metrics.Metricas para os indicadores de negócio.
+type Metricas struct {
+	registry *prometheus.Registry
+
+	httpRequisicoes *prometheus.CounterVec
+	httpDuracao     *prometheus.HistogramVec
+	dbDuracao       *prometheus.HistogramVec
+	logins          *prometheus.CounterVec
+	itensPorStatus  *prometheus.GaugeVec
+	valorEstoque    *prometheus.GaugeVec
+}
+
+var _ metrics.Metricas = (*Metricas)(nil)
+
+func New() *Metricas {
+	m := &Metricas{
+		registry: prometheus.NewRegistry(),
+
+		httpRequisicoes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Requisições HTTP por rota, método e status.",
+		}, []string{"rota", "metodo", "status"}),
+
+		httpDuracao: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latência das requisições HTTP por rota e método.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"rota", "metodo"}),
+
+		dbDuracao: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Duração das queries do GORM por operação e tabela.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operacao", "tabela"}),
+
+		logins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "logins_total",
+			Help:      "Tentativas de login por resultado (sucesso/falha).",
+		}, []string{"resultado"}),
+
+		itensPorStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "itens",
+			Help:      "Quantidade de itens no catálogo por status.",
+		}, []string{"status"}),
+
+		valorEstoque: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "estoque_valor_total",
+			Help:      "Valor total do estoque (Σ preço × estoque) por moeda.",
+		}, []string{"moeda"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequisicoes,
+		m.httpDuracao,
+		m.dbDuracao,
+		m.logins,
+		m.itensPorStatus,
+		m.valorEstoque,
+	)
+
+	// Zera as séries para aparecerem no /metrics antes do primeiro evento
+	m.logins.WithLabelValues("sucesso")
+	m.logins.WithLabelValues("falha")
+
+	return m
+}
+
+// Handler serve o endpoint /metrics
+func (m *Metricas) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metricas) LoginRealizado(sucesso bool) {
+	resultado := "falha"
+	if sucesso {
+		resultado = "sucesso"
+	}
+	m.logins.WithLabelValues(resultado).Inc()
+}
+
+func (m *Metricas) ResumoEstoqueAtualizado(resumo item.ResumoEstoque) {
+	// Status que sumiram do resumo (ex.: nenhum item inativo) voltam a zero
+	for _, status := range []item.Status{item.StatusAtivo, item.StatusInativo} {
+		m.itensPorStatus.WithLabelValues(string(status)).Set(0)
+	}
+	for status, total := range resumo.PorStatus {
+		m.itensPorStatus.WithLabelValues(string(status)).Set(float64(total))
+	}
+	m.valorEstoque.Reset()
+	for moeda, valor := range resumo.ValorPorMoeda {
+		m.valorEstoque.WithLabelValues(string(moeda)).Set(valor.Float64())
+	}
+}
@@ -0,0 +1,64 @@
+package prometheus
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/item"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddleware_UsaTemplateDaRota(t *testing.T) {
+	//ARRANGE
+	gin.SetMode(gin.TestMode)
+	m := New()
+	router := gin.New()
+	router.Use(m.Middleware())
+	router.GET("/v1/itens/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	//ACT
+	for _, id := range []string{"1", "2", "3"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/itens/"+id, nil))
+	}
+
+	//ASSERT
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.httpRequisicoes.WithLabelValues("/v1/itens/:id", "GET", "200")))
+}
+
+func TestResumoEstoqueAtualizado_AtualizaGauges(t *testing.T) {
+	//ARRANGE
+	m := New()
+	m.ResumoEstoqueAtualizado(item.ResumoEstoque{
+		PorStatus:     map[item.Status]int{item.StatusAtivo: 5, item.StatusInativo: 2},
+		ValorPorMoeda: map[dinheiro.Moeda]dinheiro.Dinheiro{dinheiro.BRL: dinheiro.Novo(99950, dinheiro.BRL), dinheiro.USD: dinheiro.Novo(100, dinheiro.USD)},
+	})
+
+	//ACT
+	m.ResumoEstoqueAtualizado(item.ResumoEstoque{
+		PorStatus:     map[item.Status]int{item.StatusAtivo: 6},
+		ValorPorMoeda: map[dinheiro.Moeda]dinheiro.Dinheiro{dinheiro.BRL: dinheiro.Novo(100000, dinheiro.BRL)},
+	})
+
+	//ASSERT
+	assert.Equal(t, 6.0, testutil.ToFloat64(m.itensPorStatus.WithLabelValues("active")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.itensPorStatus.WithLabelValues("inactive")))
+	assert.Equal(t, 1000.0, testutil.ToFloat64(m.valorEstoque.WithLabelValues("BRL")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.valorEstoque)) // USD sumiu do resumo
+}
+
+func TestLoginRealizado_ContaPorResultado(t *testing.T) {
+	//ARRANGE
+	m := New()
+
+	//ACT
+	m.LoginRealizado(true)
+	m.LoginRealizado(false)
+	m.LoginRealizado(false)
+
+	//ASSERT
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.logins.WithLabelValues("sucesso")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.logins.WithLabelValues("falha")))
+}
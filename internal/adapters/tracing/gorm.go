@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const chaveSpan = "tracing:span"
+
+// InstrumentarGORM cria um span de cliente para cada operação do GORM,
+// filho do span do service que a originou. O SQL vai com placeholders,
+// sem os valores.
+func InstrumentarGORM(db *gorm.DB, sistema string) error {
+	tracer := otel.Tracer(nomeInstrumentacao)
+	cb := db.Callback()
+
+	registros := []struct {
+		operacao string
+		antes    func(string, func(*gorm.DB)) error
+		depois   func(string, func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range registros {
+		if err := r.antes("tracing:antes_"+r.operacao, iniciarSpan(tracer, sistema, r.operacao)); err != nil {
+			return err
+		}
+		if err := r.depois("tracing:depois_"+r.operacao, finalizarSpan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iniciarSpan(tracer trace.Tracer, sistema, operacao string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		// Sem span pai (ex.: migrations, jobs sem trace) não abre span solto
+		if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			return
+		}
+
+		_, span := tracer.Start(ctx, "db."+operacao,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemKey.String(sistema),
+				semconv.DBOperationName(operacao),
+			),
+		)
+		db.InstanceSet(chaveSpan, span)
+	}
+}
+
+func finalizarSpan(db *gorm.DB) {
+	v, ok := db.InstanceGet(chaveSpan)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBCollectionName(db.Statement.Table),
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
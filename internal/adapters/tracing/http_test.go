@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func configurarTeste(t *testing.T) *tracetest.SpanRecorder {
+	gin.SetMode(gin.TestMode)
+	gravador := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(gravador))
+
+	anterior := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(anterior) })
+
+	return gravador
+}
+
+func TestMiddleware_ContinuaTraceRecebido(t *testing.T) {
+	//ARRANGE
+	gravador := configurarTeste(t)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/v1/itens/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/itens/42", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	//ACT
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	//ASSERT
+	spans := gravador.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "GET /v1/itens/:id", spans[0].Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+}
+
+func TestMiddleware_Erro500MarcaSpanComErro(t *testing.T) {
+	//ARRANGE
+	gravador := configurarTeste(t)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/falha", func(c *gin.Context) { c.Status(http.StatusInternalServerError) })
+
+	//ACT
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/falha", nil))
+
+	//ASSERT
+	spans := gravador.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+}
@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"context"
+	"desafio-itens-app/internal/config"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"os"
+)
+
+// Configurar instala o TracerProvider global conforme OTEL_TRACES_EXPORTER:
+//
+//	none   (padrão) spans não são gravados
+//	otlp   envia para um coletor (OTEL_EXPORTER_OTLP_ENDPOINT)
+//	stdout imprime os spans no terminal, útil em desenvolvimento
+//	file   grava os spans em JSON no arquivo OTEL_TRACES_FILE
+//
+// A função devolvida faz o flush dos spans pendentes e deve ser chamada
+// no encerramento.
+func Configurar(ctx context.Context, cfg config.Tracing) (func(context.Context) error, error) {
+	// Propagação W3C (traceparent/tracestate) vale mesmo sem exporter,
+	// para repassar o contexto recebido adiante
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var arquivo *os.File
+	var err error
+
+	switch cfg.Exporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "file":
+		arquivo, err = os.OpenFile(cfg.Arquivo, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err == nil {
+			exporter, err = stdouttrace.New(stdouttrace.WithWriter(arquivo))
+		}
+	default:
+		return nil, fmt.Errorf("OTEL_TRACES_EXPORTER desconhecido: %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar exporter de traces: %w", err)
+	}
+
+	recurso, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.NomeServico),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao montar resource de traces: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(recurso),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Amostragem))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if arquivo != nil {
+			arquivo.Close()
+		}
+		return err
+	}, nil
+}
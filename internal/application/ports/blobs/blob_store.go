@@ -0,0 +1,21 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNaoEncontrado: não há arquivo com a chave
+var ErrBlobNaoEncontrado = errors.New("arquivo não encontrado")
+
+// BlobStore guarda arquivos por chave ("itens/7/ab12.jpg") sem os services
+// saberem se é disco local ou um bucket S3
+type BlobStore interface {
+	// Salvar grava (ou substitui) o arquivo
+	Salvar(ctx context.Context, chave, contentType string, dados []byte) error
+	// Abrir devolve o conteúdo e o content-type; quem chama fecha o reader
+	Abrir(ctx context.Context, chave string) (io.ReadCloser, string, error)
+	// Remover apaga o arquivo; chave inexistente não é erro
+	Remover(ctx context.Context, chave string) error
+}
@@ -0,0 +1,16 @@
+package metrics
+
+import "desafio-itens-app/internal/domain/item"
+
+// Metricas é como os services publicam indicadores de negócio sem
+// depender de Prometheus (ou de qualquer outra ferramenta).
+type Metricas interface {
+	LoginRealizado(sucesso bool)
+	ResumoEstoqueAtualizado(resumo item.ResumoEstoque)
+}
+
+// Nop descarta tudo; útil em testes e quando as métricas estão desligadas
+type Nop struct{}
+
+func (Nop) LoginRealizado(bool)                        {}
+func (Nop) ResumoEstoqueAtualizado(item.ResumoEstoque) {}
@@ -0,0 +1,17 @@
+package notificacoes
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/item"
+)
+
+// Notificador entrega alertas de negócio (log, webhook, e-mail) sem os
+// services saberem como
+type Notificador interface {
+	AlertaEstoque(ctx context.Context, alerta item.AlertaEstoque) error
+}
+
+// Nop descarta tudo; útil em testes e quando não há canal configurado
+type Nop struct{}
+
+func (Nop) AlertaEstoque(context.Context, item.AlertaEstoque) error { return nil }
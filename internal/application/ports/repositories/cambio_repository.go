@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+)
+
+type CambioRepository interface {
+	Listar(ctx context.Context) ([]cambio.Taxa, error)
+	// Salvar cria ou substitui a taxa do par De → Para
+	Salvar(ctx context.Context, taxa cambio.Taxa) error
+	// Remover devolve cambio.ErrTaxaNaoEncontrada se o par não existe
+	Remover(ctx context.Context, de, para dinheiro.Moeda) error
+}
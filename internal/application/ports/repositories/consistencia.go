@@ -0,0 +1,17 @@
+package repositories
+
+import "context"
+
+type chaveLeituraNoPrimario struct{}
+
+// ComLeituraNoPrimario marca o contexto para que as leituras ignorem as
+// réplicas e vão ao banco primário ("read your writes").
+func ComLeituraNoPrimario(ctx context.Context) context.Context {
+	return context.WithValue(ctx, chaveLeituraNoPrimario{}, true)
+}
+
+// LeituraNoPrimario informa se o contexto pediu leitura no primário
+func LeituraNoPrimario(ctx context.Context) bool {
+	v, _ := ctx.Value(chaveLeituraNoPrimario{}).(bool)
+	return v
+}
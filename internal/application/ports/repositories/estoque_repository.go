@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/deposito"
+)
+
+// EstoqueRepository guarda os depósitos e o saldo de cada item por depósito.
+// itens.estoque continua sendo o total: o ItemRepository joga no depósito
+// padrão a diferença quando só o total muda.
+type EstoqueRepository interface {
+	ListarDepositos(ctx context.Context) ([]deposito.Deposito, error)
+	// CriarDeposito devolve deposito.ErrCodigoDuplicado se o código já existe
+	CriarDeposito(ctx context.Context, d deposito.Deposito) (deposito.Deposito, error)
+	// Saldos traz todos os depósitos, com zero onde o item não tem estoque
+	Saldos(ctx context.Context, itemID int) ([]deposito.Saldo, error)
+	// DefinirSaldo trava o item, grava a quantidade no depósito e devolve o
+	// novo total do item
+	DefinirSaldo(ctx context.Context, itemID, depositoID, quantidade int) (int, error)
+	// Transferir trava o item e move a quantidade; devolve
+	// deposito.ErrEstoqueInsuficiente se a origem não tem o bastante
+	Transferir(ctx context.Context, t deposito.Transferencia) (deposito.Transferencia, error)
+	Transferencias(ctx context.Context, itemID, limite int) ([]deposito.Transferencia, error)
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/fornecedor"
+)
+
+// FornecedorRepository guarda os fornecedores e quais itens cada um fornece
+type FornecedorRepository interface {
+	Listar(ctx context.Context) ([]fornecedor.Fornecedor, error)
+	GetByID(ctx context.Context, id int) (*fornecedor.Fornecedor, error)
+	Criar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error)
+	// Atualizar devolve fornecedor.ErrFornecedorNaoEncontrado se o id não existe
+	Atualizar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error)
+	// Vinculos traz os fornecedores do item, com o nome de cada um
+	Vinculos(ctx context.Context, itemID int) ([]fornecedor.ItemFornecedor, error)
+	// Vinculo devolve fornecedor.ErrVinculoNaoEncontrado se o fornecedor não
+	// fornece o item
+	Vinculo(ctx context.Context, itemID, fornecedorID int) (*fornecedor.ItemFornecedor, error)
+	// Vincular cria ou substitui SKU e custo do par item/fornecedor
+	Vincular(ctx context.Context, v fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error)
+	Desvincular(ctx context.Context, itemID, fornecedorID int) error
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/idempotencia"
+	"time"
+)
+
+type IdempotenciaRepository interface {
+	// Reservar grava o registro (ainda não concluído) se a chave estiver
+	// livre ou expirada e devolve nil. Se a chave já existir, não grava e
+	// devolve o registro existente. Precisa ser atômico.
+	Reservar(ctx context.Context, registro idempotencia.Registro) (*idempotencia.Registro, error)
+	// Concluir guarda a resposta que será repetida nos replays
+	Concluir(ctx context.Context, chave string, statusCode int, contentType string, corpo []byte) error
+	// Liberar apaga a reserva para o cliente poder tentar de novo (ex.: erro 5xx)
+	Liberar(ctx context.Context, chave string) error
+	LimparExpirados(ctx context.Context, agora time.Time) (int, error)
+}
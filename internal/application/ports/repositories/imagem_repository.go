@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/imagem"
+)
+
+// ImagemRepository guarda os dados das imagens dos itens; os arquivos
+// ficam no BlobStore
+type ImagemRepository interface {
+	// Listar traz as imagens do item pela ordem
+	Listar(ctx context.Context, itemID int) ([]imagem.Imagem, error)
+	// PorItens traz as imagens de vários itens de uma vez, pela ordem
+	PorItens(ctx context.Context, itemIDs []int) (map[int][]imagem.Imagem, error)
+	Criar(ctx context.Context, imagens []imagem.Imagem) ([]imagem.Imagem, error)
+	// Atualizar grava ordem e principal
+	Atualizar(ctx context.Context, imagens []imagem.Imagem) error
+	Remover(ctx context.Context, id int) error
+	// TravarItem trava a linha do item com FOR UPDATE, para que dois envios
+	// simultâneos não disputem ordem e principal; use dentro de uma transação
+	TravarItem(ctx context.Context, itemID int) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/importacao"
+)
+
+type ImportacaoJobRepository interface {
+	Create(ctx context.Context, job importacao.Job) error
+	Update(ctx context.Context, job importacao.Job) error
+	// GetByID devolve importacao.ErrJobNaoEncontrado se o job não existe
+	GetByID(ctx context.Context, id string) (*importacao.Job, error)
+}
@@ -1,16 +1,36 @@
 package repositories
 
-import "desafio-itens-app/internal/domain/item"
+import (
+	"context"
+	"desafio-itens-app/internal/domain/item"
+)
 
 type ItemRepository interface {
-	GetItem(id int) (*item.Item, error)
-	GetItens() ([]item.Item, error)
-	GetItensFiltrados(status *item.Status, limit int) ([]item.Item, error)
-	GetItensPaginados(ofsset, limit int) ([]item.Item, int, error)
-	GetItensFiltradosPaginados(status *item.Status, page, pageSize int) ([]item.Item, int, error)
-	CountItens(status *item.Status) (int, error)
-	CodeExists(code string) (bool, error)
-	AddItem(item item.Item) (item.Item, error)
-	UpdateItem(item item.Item) error
-	DeleteItem(id int) error
+	GetItem(ctx context.Context, id int) (*item.Item, error)
+	// TravarItens trava as linhas dos itens com FOR UPDATE até o fim da
+	// transação do contexto. Quem lê um item para gravar o estoque por cima
+	// trava antes, senão duas baixas simultâneas perdem uma delas.
+	TravarItens(ctx context.Context, ids []int) error
+	GetItens(ctx context.Context) ([]item.Item, error)
+	GetItensFiltrados(ctx context.Context, status *item.Status, limit int) ([]item.Item, error)
+	GetItensPaginados(ctx context.Context, ofsset, limit int) ([]item.Item, int, error)
+	GetItensFiltradosPaginados(ctx context.Context, status *item.Status, page, pageSize int) ([]item.Item, int, error)
+	BuscarItens(ctx context.Context, termo string, status *item.Status, offset, limit int) ([]item.Item, int, error)
+	ListarLote(ctx context.Context, status *item.Status, termo string, aposID, limite int) ([]item.Item, error)
+	CountItens(ctx context.Context, status *item.Status) (int, error)
+	ResumoEstoque(ctx context.Context) (item.ResumoEstoque, error)
+	// ListarEstoqueBaixo traz os itens no ponto de reposição ou abaixo, os
+	// mais distantes dele primeiro
+	ListarEstoqueBaixo(ctx context.Context) ([]item.Item, error)
+	// ListarProdutos pagina os itens que não são variantes
+	ListarProdutos(ctx context.Context, offset, limit int) ([]item.Item, int, error)
+	// ListarVariantes traz as variantes dos produtos, agrupadas por produto
+	ListarVariantes(ctx context.Context, produtoIDs []int) ([]item.Item, error)
+	GetItemByCode(ctx context.Context, code string) (*item.Item, error)
+	CodeExists(ctx context.Context, code string) (bool, error)
+	AddItem(ctx context.Context, item item.Item) (item.Item, error)
+	// UpdateItem grava a linha inteira, estoque incluído: o item precisa ter
+	// sido lido depois de TravarItens, na mesma transação
+	UpdateItem(ctx context.Context, item item.Item) error
+	DeleteItem(ctx context.Context, id int) error
 }
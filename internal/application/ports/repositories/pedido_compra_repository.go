@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/compra"
+)
+
+// PedidoCompraRepository guarda os pedidos de compra com as linhas e os
+// recebimentos de cada um
+type PedidoCompraRepository interface {
+	// Listar traz os pedidos com as linhas, mais novos primeiro
+	Listar(ctx context.Context, filtro compra.Filtro) ([]compra.Pedido, error)
+	GetByID(ctx context.Context, id int) (*compra.Pedido, error)
+	// Travar busca o pedido com FOR UPDATE; use dentro de uma transação
+	Travar(ctx context.Context, id int) (*compra.Pedido, error)
+	Criar(ctx context.Context, p compra.Pedido) (compra.Pedido, error)
+	// Atualizar grava status e datas. Em rascunho as linhas são substituídas;
+	// depois disso só a quantidade recebida de cada linha muda.
+	Atualizar(ctx context.Context, p compra.Pedido) (compra.Pedido, error)
+	RegistrarRecebimentos(ctx context.Context, recebimentos []compra.Recebimento) error
+	Recebimentos(ctx context.Context, pedidoID int) ([]compra.Recebimento, error)
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/venda"
+)
+
+// PedidoVendaRepository guarda os pedidos de venda com as linhas. As
+// linhas não mudam depois de criadas.
+type PedidoVendaRepository interface {
+	Criar(ctx context.Context, p venda.Pedido) (venda.Pedido, error)
+	GetByID(ctx context.Context, id int) (*venda.Pedido, error)
+	// Travar busca o pedido com FOR UPDATE; use dentro de uma transação
+	Travar(ctx context.Context, id int) (*venda.Pedido, error)
+	// Atualizar grava status e datas
+	Atualizar(ctx context.Context, p venda.Pedido) error
+	// ListarPorUsuario traz os pedidos do usuário, mais novos primeiro, e o
+	// total deles
+	ListarPorUsuario(ctx context.Context, usuarioID, offset, limit int) ([]venda.Pedido, int, error)
+	// TravarItens trava as linhas dos itens com FOR UPDATE, na ordem dada.
+	// Quem confirma ou cancela pedidos trava sempre em ordem crescente de id,
+	// então dois pedidos com os mesmos itens não se travam mutuamente.
+	TravarItens(ctx context.Context, itemIDs []int) error
+}
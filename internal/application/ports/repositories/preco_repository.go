@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/preco"
+	"time"
+)
+
+// PrecoRepository lê o histórico de preços (gravado pelo ItemRepository a
+// cada AddItem/UpdateItem que muda o preço) e guarda os preços agendados
+type PrecoRepository interface {
+	ListarHistorico(ctx context.Context, itemID int) ([]preco.Alteracao, error)
+	Agendar(ctx context.Context, agendamento preco.Agendamento) (preco.Agendamento, error)
+	ListarAgendamentos(ctx context.Context, itemID int) ([]preco.Agendamento, error)
+	// CancelarAgendamento só cancela pendentes; devolve
+	// preco.ErrAgendamentoNaoEncontrado se não há pendente com esse id no item
+	CancelarAgendamento(ctx context.Context, itemID, id int) error
+	// Vencidos traz os pendentes com vigência até ate, na ordem de vigência.
+	// Dentro de uma transação as linhas ficam travadas (SKIP LOCKED), então
+	// réplicas diferentes não aplicam o mesmo agendamento.
+	Vencidos(ctx context.Context, ate time.Time, limite int) ([]preco.Agendamento, error)
+	// Concluir grava status, aplicado_em e erro do agendamento
+	Concluir(ctx context.Context, agendamento preco.Agendamento) error
+}
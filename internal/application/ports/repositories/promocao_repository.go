@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/promocao"
+	"time"
+)
+
+type PromocaoRepository interface {
+	Listar(ctx context.Context) ([]promocao.Promocao, error)
+	// Vigentes traz as ativas com agora entre início e fim
+	Vigentes(ctx context.Context, agora time.Time) ([]promocao.Promocao, error)
+	// GetByID devolve promocao.ErrPromocaoNaoEncontrada se não existe
+	GetByID(ctx context.Context, id int) (*promocao.Promocao, error)
+	Criar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error)
+	Atualizar(ctx context.Context, p promocao.Promocao) error
+	// Remover devolve promocao.ErrPromocaoNaoEncontrada se não existe
+	Remover(ctx context.Context, id int) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/ratelimit"
+	"time"
+)
+
+// RateLimitRepository guarda os baldes do rate limiter. Consumir precisa
+// ser atômico por chave: duas requisições (ou duas réplicas da API) não
+// podem gastar o mesmo token.
+type RateLimitRepository interface {
+	Consumir(ctx context.Context, chave string, politica ratelimit.Politica, agora time.Time) (ratelimit.Resultado, error)
+	// Limpar apaga baldes sem uso desde antesDe (já estariam cheios de novo)
+	Limpar(ctx context.Context, antesDe time.Time) (int, error)
+}
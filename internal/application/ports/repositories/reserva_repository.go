@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/reserva"
+	"time"
+)
+
+// ReservaRepository guarda as reservas de estoque. Só reservas ativas com
+// expira_em depois de agora contam como reservadas, então uma reserva
+// vencida deixa de segurar estoque mesmo antes da limpeza.
+type ReservaRepository interface {
+	// Reservar trava o item e só grava se estoque − reservado cobre a
+	// quantidade; senão devolve reserva.ErrEstoqueIndisponivel
+	Reservar(ctx context.Context, r reserva.Reserva) (reserva.Reserva, error)
+	GetByID(ctx context.Context, id int) (*reserva.Reserva, error)
+	// Travar busca a reserva com FOR UPDATE; use dentro de uma transação
+	Travar(ctx context.Context, id int) (*reserva.Reserva, error)
+	// Concluir grava status e concluida_em
+	Concluir(ctx context.Context, r reserva.Reserva) error
+	// Reservados soma as reservas vigentes em agora de cada item
+	Reservados(ctx context.Context, itemIDs []int, agora time.Time) (map[int]int, error)
+	// ReservadosPorOutros soma como Reservados, sem as reservas do usuário:
+	// o pedido dele não disputa o estoque que ele mesmo segurou
+	ReservadosPorOutros(ctx context.Context, itemIDs []int, usuarioID int, agora time.Time) (map[int]int, error)
+	// VigentesDoUsuario trava com FOR UPDATE as reservas vigentes do usuário
+	// nos itens, por item e das que vencem primeiro às últimas
+	VigentesDoUsuario(ctx context.Context, itemIDs []int, usuarioID int, agora time.Time) ([]reserva.Reserva, error)
+	// Dividir grava a quantidade que sobrou na reserva e cria a parte
+	// separada dela (reserva.Reserva.Dividir)
+	Dividir(ctx context.Context, restante, parte reserva.Reserva) (reserva.Reserva, error)
+	// Expirar marca como expiradas as ativas vencidas até agora e devolve
+	// quantas foram
+	Expirar(ctx context.Context, agora time.Time) (int64, error)
+}
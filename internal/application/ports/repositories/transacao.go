@@ -0,0 +1,15 @@
+package repositories
+
+import "context"
+
+// Transacionador agrupa chamadas a repositórios numa transação do banco.
+// Os repositórios usados dentro de fn com o ctx recebido participam da
+// transação; se fn devolver erro, tudo é desfeito.
+type Transacionador interface {
+	EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error
+	// AposCommit guarda fn para rodar depois do commit da transação do ctx;
+	// se ela for desfeita, fn não roda. Fora de transação, fn roda na hora.
+	// Serve para efeitos fora do banco (alertas, webhooks) que não podem
+	// sair de uma alteração que não aconteceu.
+	AposCommit(ctx context.Context, fn func())
+}
@@ -6,13 +6,13 @@ import (
 )
 
 type UserRepository interface {
-	Create(user user.User) (user.User, error)
-	GetById(id int) (*user.User, error)
+	Create(ctx context.Context, user user.User) (user.User, error)
+	GetById(ctx context.Context, id int) (*user.User, error)
 	List(ctx context.Context, limit, offset int) ([]*user.User, int64, error)
-	GetByUsername(username string) (*user.User, error)
-	GetByEmail(email string) (*user.User, error)
-	Update(user user.User) error
-	Delete(id int) error
-	UserNameExists(username string) (bool, error)
-	EmailExists(email string) (bool, error)
+	GetByUsername(ctx context.Context, username string) (*user.User, error)
+	GetByEmail(ctx context.Context, email string) (*user.User, error)
+	Update(ctx context.Context, user user.User) error
+	Delete(ctx context.Context, id int) error
+	UserNameExists(ctx context.Context, username string) (bool, error)
+	EmailExists(ctx context.Context, email string) (bool, error)
 }
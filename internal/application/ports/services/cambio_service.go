@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/item"
+)
+
+type CambioService interface {
+	ListarTaxas(ctx context.Context) ([]cambio.Taxa, error)
+	DefinirTaxa(ctx context.Context, taxa cambio.Taxa) (cambio.Taxa, error)
+	RemoverTaxa(ctx context.Context, de, para dinheiro.Moeda) error
+	// ConverterPrecos devolve uma conversão por item, na mesma ordem
+	ConverterPrecos(ctx context.Context, itens []item.Item, para dinheiro.Moeda) ([]cambio.Conversao, error)
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/compra"
+)
+
+type CompraService interface {
+	Listar(ctx context.Context, filtro compra.Filtro) ([]compra.Pedido, error)
+	Buscar(ctx context.Context, id int) (*compra.Pedido, error)
+	Recebimentos(ctx context.Context, id int) ([]compra.Recebimento, error)
+	// Criar grava um rascunho; linha sem custo usa o custo do vínculo do
+	// item com o fornecedor
+	Criar(ctx context.Context, p compra.Pedido) (compra.Pedido, error)
+	Atualizar(ctx context.Context, id int, observacao string, linhas []compra.Linha) (compra.Pedido, error)
+	Enviar(ctx context.Context, id int) (compra.Pedido, error)
+	Cancelar(ctx context.Context, id int) (compra.Pedido, error)
+	// Receber soma as quantidades (itemID → quantidade) ao estoque dos itens
+	Receber(ctx context.Context, id int, quantidades map[int]int, usuarioID int) (compra.Pedido, error)
+}
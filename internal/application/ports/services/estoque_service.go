@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/deposito"
+)
+
+type EstoqueService interface {
+	ListarDepositos(ctx context.Context) ([]deposito.Deposito, error)
+	CriarDeposito(ctx context.Context, d deposito.Deposito) (deposito.Deposito, error)
+	Posicao(ctx context.Context, itemID int) (deposito.Posicao, error)
+	// DefinirSaldo grava a quantidade no depósito e recalcula o total e o
+	// status do item
+	DefinirSaldo(ctx context.Context, itemID, depositoID, quantidade, usuarioID int) (deposito.Posicao, error)
+	Transferir(ctx context.Context, t deposito.Transferencia) (deposito.Transferencia, error)
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/fornecedor"
+)
+
+type FornecedorService interface {
+	Listar(ctx context.Context) ([]fornecedor.Fornecedor, error)
+	Buscar(ctx context.Context, id int) (*fornecedor.Fornecedor, error)
+	Criar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error)
+	Atualizar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error)
+	// Vinculos lista os fornecedores do item com SKU e custo
+	Vinculos(ctx context.Context, itemID int) ([]fornecedor.ItemFornecedor, error)
+	Vincular(ctx context.Context, v fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error)
+	Desvincular(ctx context.Context, itemID, fornecedorID int) error
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/imagem"
+	"io"
+)
+
+type ImagemService interface {
+	// Enviar valida os arquivos, gera as miniaturas e acrescenta as imagens
+	// no fim da ordem do item; nada é gravado se um arquivo for recusado
+	Enviar(ctx context.Context, itemID int, arquivos [][]byte, usuarioID int) ([]imagem.Imagem, error)
+	Listar(ctx context.Context, itemID int) ([]imagem.Imagem, error)
+	// PorItens traz as imagens de vários itens, para as listagens
+	PorItens(ctx context.Context, itemIDs []int) (map[int][]imagem.Imagem, error)
+	Reordenar(ctx context.Context, itemID int, ids []int) ([]imagem.Imagem, error)
+	DefinirPrincipal(ctx context.Context, itemID, imagemID int) ([]imagem.Imagem, error)
+	Remover(ctx context.Context, itemID, imagemID int) error
+	// Abrir lê o arquivo guardado; quem chama fecha o reader
+	Abrir(ctx context.Context, chave string) (io.ReadCloser, string, error)
+}
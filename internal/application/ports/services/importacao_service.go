@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/importacao"
+)
+
+type ImportacaoService interface {
+	// Importar processa as linhas na hora e devolve o relatório
+	Importar(ctx context.Context, linhas []importacao.Linha, opcoes importacao.Opcoes) (importacao.Relatorio, error)
+	// IniciarImportacao processa em segundo plano; acompanhe por GetJob
+	IniciarImportacao(ctx context.Context, linhas []importacao.Linha, opcoes importacao.Opcoes) (importacao.Job, error)
+	GetJob(ctx context.Context, id string) (*importacao.Job, error)
+}
@@ -1,14 +1,24 @@
 package services
 
-import entity "desafio-itens-app/internal/domain/item"
+import (
+	"context"
+	entity "desafio-itens-app/internal/domain/item"
+)
 
 type ItemService interface {
-	GetItem(id int) (*entity.Item, error)
-	AddItem(item entity.Item) (entity.Item, error)
-	GetItens() ([]entity.Item, error)
-	GetItensFiltrados(status *entity.Status, limit int) ([]entity.Item, int, int, error)
-	GetItensPaginados(page, pageSize int) ([]entity.Item, int, error)
-	GetItensFiltradosPaginados(status *entity.Status, page, pageSize int) ([]entity.Item, int, error)
-	UpdateItem(item entity.Item) error
-	DeleteItem(id int) error
+	GetItem(ctx context.Context, id int) (*entity.Item, error)
+	AddItem(ctx context.Context, item entity.Item) (entity.Item, error)
+	GetItens(ctx context.Context) ([]entity.Item, error)
+	GetItensFiltrados(ctx context.Context, status *entity.Status, limit int) ([]entity.Item, int, int, error)
+	GetItensPaginados(ctx context.Context, page, pageSize int) ([]entity.Item, int, error)
+	GetItensFiltradosPaginados(ctx context.Context, status *entity.Status, page, pageSize int) ([]entity.Item, int, error)
+	BuscarItens(ctx context.Context, termo string, status *entity.Status, page, pageSize int) ([]entity.Item, int, error)
+	UpdateItem(ctx context.Context, item entity.Item) error
+	// AlterarItem trava e relê o item antes de alterar e gravar; é o caminho
+	// de quem altera um item a partir do que está gravado
+	AlterarItem(ctx context.Context, id int, alterar func(*entity.Item) error) (entity.Item, error)
+	DeleteItem(ctx context.Context, id int) error
+	ExportarItens(ctx context.Context, status *entity.Status, termo string, fn func(entity.Item) error) error
+	ListarEstoqueBaixo(ctx context.Context) ([]entity.Item, error)
+	PublicarResumoEstoque(ctx context.Context) error
 }
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/lote"
+)
+
+type LoteService interface {
+	AtualizarItens(ctx context.Context, selecao lote.Selecao, alteracao lote.Alteracao, opcoes lote.Opcoes) (lote.Relatorio, error)
+	RemoverItens(ctx context.Context, selecao lote.Selecao, opcoes lote.Opcoes) (lote.Relatorio, error)
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/preco"
+)
+
+type PrecoService interface {
+	Historico(ctx context.Context, itemID int) ([]preco.Alteracao, error)
+	Agendamentos(ctx context.Context, itemID int) ([]preco.Agendamento, error)
+	// Agendar grava um agendamento já validado por preco.NovoAgendamento
+	Agendar(ctx context.Context, agendamento preco.Agendamento) (preco.Agendamento, error)
+	CancelarAgendamento(ctx context.Context, itemID, id int) error
+	// AplicarAgendados aplica os preços cuja vigência já começou. Chamado
+	// periodicamente pelo main.
+	AplicarAgendados(ctx context.Context) error
+}
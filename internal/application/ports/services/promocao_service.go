@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/promocao"
+)
+
+type PromocaoService interface {
+	Listar(ctx context.Context) ([]promocao.Promocao, error)
+	Buscar(ctx context.Context, id int) (*promocao.Promocao, error)
+	// Criar e Atualizar recebem a promoção já validada (promocao.Validar)
+	Criar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error)
+	Atualizar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error)
+	Remover(ctx context.Context, id int) error
+	// Aplicar devolve o preço efetivo de cada item, na mesma ordem
+	Aplicar(ctx context.Context, itens []item.Item) ([]promocao.Resultado, error)
+}
@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/reserva"
+)
+
+type ReservaService interface {
+	Reservar(ctx context.Context, r reserva.Reserva) (reserva.Reserva, error)
+	Buscar(ctx context.Context, id int) (*reserva.Reserva, error)
+	// Confirmar tira a quantidade do estoque do item
+	Confirmar(ctx context.Context, id, usuarioID int) (reserva.Reserva, error)
+	Cancelar(ctx context.Context, id int) (reserva.Reserva, error)
+	// Reservados soma as reservas vigentes de cada item
+	Reservados(ctx context.Context, itemIDs []int) (map[int]int, error)
+}
@@ -7,11 +7,11 @@ import (
 )
 
 type UserService interface {
-	CreateUser(user userDomain.User) (userDomain.User, error)
-	GetUser(id int) (*userDomain.User, error)
+	CreateUser(ctx context.Context, user userDomain.User) (userDomain.User, error)
+	GetUser(ctx context.Context, id int) (*userDomain.User, error)
 	ListUsers(ctx context.Context, page, limit int) (*dto.ListUsersResponse, error)
-	GetUserByUsername(username string) (*userDomain.User, error)
-	UpdateUser(user userDomain.User) error
-	DeleteUser(id int) error
-	ValidateCredentials(username, password string) (*userDomain.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*userDomain.User, error)
+	UpdateUser(ctx context.Context, user userDomain.User) error
+	DeleteUser(ctx context.Context, id int) error
+	ValidateCredentials(ctx context.Context, username, password string) (*userDomain.User, error)
 }
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+)
+
+type VarianteService interface {
+	// ListarProdutos pagina os itens de topo com as variantes de cada um
+	ListarProdutos(ctx context.Context, page, pageSize int) ([]entity.Produto, int, error)
+	Variantes(ctx context.Context, produtoID int) ([]entity.Item, error)
+	// DefinirEixos troca os eixos do produto; as variantes existentes
+	// precisam continuar válidas
+	DefinirEixos(ctx context.Context, produto entity.Item, eixos []entity.Eixo, usuarioID int) (entity.Item, error)
+	// CriarVariante cria uma combinação; preco nil segue o produto
+	CriarVariante(ctx context.Context, produto entity.Item, opcoes map[string]string, preco *dinheiro.Dinheiro, estoque, usuarioID int) (entity.Item, error)
+	// GerarVariantes cria, sem estoque, as combinações que ainda faltam
+	GerarVariantes(ctx context.Context, produto entity.Item, usuarioID int) ([]entity.Item, error)
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/venda"
+)
+
+type VendaService interface {
+	// Criar recebe só item e quantidade de cada linha; nome e preço vêm do
+	// item
+	Criar(ctx context.Context, usuarioID int, linhas []venda.Linha) (venda.Pedido, error)
+	Buscar(ctx context.Context, id int) (*venda.Pedido, error)
+	ListarDoUsuario(ctx context.Context, usuarioID, page, pageSize int) ([]venda.Pedido, int, error)
+	// Confirmar baixa o estoque de todas as linhas de uma vez
+	Confirmar(ctx context.Context, id, usuarioID int) (venda.Pedido, error)
+	// Cancelar devolve o estoque se o pedido estava confirmado
+	Cancelar(ctx context.Context, id, usuarioID int) (venda.Pedido, error)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+type cambioService struct {
+	repo   repositories.CambioRepository
+	logger *slog.Logger
+}
+
+func NewCambioService(repo repositories.CambioRepository, logger *slog.Logger) *cambioService {
+	return &cambioService{repo: repo, logger: logger}
+}
+
+func (s *cambioService) ListarTaxas(ctx context.Context) ([]cambio.Taxa, error) {
+	ctx, span := tracer.Start(ctx, "cambioService.ListarTaxas")
+	defer span.End()
+
+	taxas, err := s.repo.Listar(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar taxas de câmbio: %w", err)
+	}
+	return taxas, nil
+}
+
+// DefinirTaxa cria ou substitui a taxa do par (já validada por cambio.NovaTaxa)
+func (s *cambioService) DefinirTaxa(ctx context.Context, taxa cambio.Taxa) (cambio.Taxa, error) {
+	ctx, span := tracer.Start(ctx, "cambioService.DefinirTaxa", trace.WithAttributes(
+		attribute.String("moeda.de", string(taxa.De)),
+		attribute.String("moeda.para", string(taxa.Para))))
+	defer span.End()
+
+	taxa.AtualizadaEm = time.Now().UTC()
+	if err := s.repo.Salvar(ctx, taxa); err != nil {
+		return cambio.Taxa{}, err
+	}
+
+	s.logger.InfoContext(ctx, "taxa de câmbio definida",
+		"de", taxa.De,
+		"para", taxa.Para,
+		"taxa", taxa.Texto(),
+		"usuario_id", taxa.AtualizadaPor)
+	return taxa, nil
+}
+
+func (s *cambioService) RemoverTaxa(ctx context.Context, de, para dinheiro.Moeda) error {
+	ctx, span := tracer.Start(ctx, "cambioService.RemoverTaxa")
+	defer span.End()
+
+	if err := s.repo.Remover(ctx, de, para); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "taxa de câmbio removida", "de", de, "para", para)
+	return nil
+}
+
+// ConverterPrecos lê as taxas uma vez e converte o preço de cada item.
+// Sem taxa para alguma moeda, devolve cambio.ErrTaxaNaoEncontrada.
+func (s *cambioService) ConverterPrecos(ctx context.Context, itens []entity.Item, para dinheiro.Moeda) ([]cambio.Conversao, error) {
+	ctx, span := tracer.Start(ctx, "cambioService.ConverterPrecos", trace.WithAttributes(
+		attribute.String("moeda.para", string(para)),
+		attribute.Int("itens", len(itens))))
+	defer span.End()
+
+	taxas, err := s.repo.Listar(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao carregar taxas de câmbio: %w", err)
+	}
+	tabela := cambio.NovaTabela(taxas)
+
+	conversoes := make([]cambio.Conversao, 0, len(itens))
+	for _, item := range itens {
+		conversao, err := tabela.Converter(item.Preco, para)
+		if err != nil {
+			return nil, err
+		}
+		conversoes = append(conversoes, conversao)
+	}
+	return conversoes, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/cambio"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestDefinirTaxa_GravaComDataDeAtualizacao(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCambioService(d.cambios, d.logger)
+	antes := time.Now()
+
+	taxa, _ := cambio.NovaTaxa(dinheiro.USD, dinheiro.BRL, "5.4321")
+	taxa.AtualizadaPor = 1
+	d.cambios.On("Salvar", mock.Anything, mock.MatchedBy(func(t cambio.Taxa) bool {
+		return t.De == dinheiro.USD && t.Texto() == "5.4321" && !t.AtualizadaEm.Before(antes)
+	})).Return(nil)
+
+	// ACT
+	salva, err := service.DefinirTaxa(context.Background(), taxa)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), salva.AtualizadaEm, time.Second)
+}
+
+func TestConverterPrecos(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCambioService(d.cambios, d.logger)
+	cotadaEm := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	taxa, _ := cambio.NovaTaxa(dinheiro.USD, dinheiro.BRL, "5")
+	taxa.AtualizadaEm = cotadaEm
+	d.cambios.On("Listar", mock.Anything).Return([]cambio.Taxa{taxa}, nil).Once()
+
+	itens := []entity.Item{
+		{ID: 1, Preco: dinheiro.Novo(1000, dinheiro.BRL)},
+		{ID: 2, Preco: dinheiro.Novo(250, dinheiro.USD)},
+	}
+
+	// ACT
+	conversoes, err := service.ConverterPrecos(context.Background(), itens, dinheiro.USD)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, conversoes, 2)
+	assert.Equal(t, dinheiro.Novo(200, dinheiro.USD), conversoes[0].Valor)
+	assert.Equal(t, cotadaEm, conversoes[0].CotadaEm)
+	assert.Equal(t, dinheiro.Novo(250, dinheiro.USD), conversoes[1].Valor) // já estava em USD
+}
+
+func TestConverterPrecos_SemTaxa(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCambioService(d.cambios, d.logger)
+	d.cambios.On("Listar", mock.Anything).Return([]cambio.Taxa{}, nil)
+
+	// ACT
+	_, err := service.ConverterPrecos(context.Background(), []entity.Item{{Preco: dinheiro.Novo(1000, dinheiro.BRL)}}, dinheiro.EUR)
+
+	// ASSERT
+	assert.ErrorIs(t, err, cambio.ErrTaxaNaoEncontrada)
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/compra"
+	"desafio-itens-app/internal/domain/fornecedor"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+type compraService struct {
+	repoItens    repositories.ItemRepository
+	itens        services.ItemService // O recebimento soma ao estoque pelas regras do UpdateItem
+	repo         repositories.PedidoCompraRepository
+	fornecedores repositories.FornecedorRepository
+	transacoes   repositories.Transacionador
+	logger       *slog.Logger
+}
+
+func NewCompraService(repoItens repositories.ItemRepository, itens services.ItemService, repo repositories.PedidoCompraRepository, fornecedores repositories.FornecedorRepository, transacoes repositories.Transacionador, logger *slog.Logger) *compraService {
+	return &compraService{repoItens: repoItens, itens: itens, repo: repo, fornecedores: fornecedores, transacoes: transacoes, logger: logger}
+}
+
+func (s *compraService) Listar(ctx context.Context, filtro compra.Filtro) ([]compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Listar")
+	defer span.End()
+
+	return s.repo.Listar(ctx, filtro)
+}
+
+func (s *compraService) Buscar(ctx context.Context, id int) (*compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Buscar")
+	defer span.End()
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *compraService) Recebimentos(ctx context.Context, id int) ([]compra.Recebimento, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Recebimentos")
+	defer span.End()
+
+	return s.repo.Recebimentos(ctx, id)
+}
+
+// Criar grava o pedido como rascunho. Linha sem custo usa o custo do
+// vínculo do item com o fornecedor.
+func (s *compraService) Criar(ctx context.Context, p compra.Pedido) (compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Criar", trace.WithAttributes(attribute.Int("fornecedor.id", p.FornecedorID)))
+	defer span.End()
+
+	if _, err := s.fornecedores.GetByID(ctx, p.FornecedorID); err != nil {
+		return compra.Pedido{}, err
+	}
+	linhas, err := s.completarLinhas(ctx, p.FornecedorID, p.Linhas)
+	if err != nil {
+		return compra.Pedido{}, err
+	}
+	novo, err := compra.NovoPedido(p.FornecedorID, p.Observacao, linhas, p.CriadoPor, time.Now())
+	if err != nil {
+		return compra.Pedido{}, err
+	}
+
+	criado, err := s.repo.Criar(ctx, novo)
+	if err != nil {
+		return compra.Pedido{}, err
+	}
+
+	s.logger.InfoContext(ctx, "pedido de compra criado",
+		"pedido_id", criado.ID,
+		"fornecedor_id", criado.FornecedorID,
+		"linhas", len(criado.Linhas),
+		"criado_por", criado.CriadoPor)
+	return criado, nil
+}
+
+// Atualizar troca observação e linhas de um rascunho
+func (s *compraService) Atualizar(ctx context.Context, id int, observacao string, linhas []compra.Linha) (compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Atualizar", trace.WithAttributes(attribute.Int("pedido.id", id)))
+	defer span.End()
+
+	return s.alterar(ctx, id, "pedido de compra alterado", func(ctx context.Context, p *compra.Pedido) error {
+		completas, err := s.completarLinhas(ctx, p.FornecedorID, linhas)
+		if err != nil {
+			return err
+		}
+		return p.AlterarRascunho(observacao, completas, time.Now())
+	})
+}
+
+func (s *compraService) Enviar(ctx context.Context, id int) (compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Enviar", trace.WithAttributes(attribute.Int("pedido.id", id)))
+	defer span.End()
+
+	return s.alterar(ctx, id, "pedido de compra enviado", func(_ context.Context, p *compra.Pedido) error {
+		return p.Enviar(time.Now())
+	})
+}
+
+func (s *compraService) Cancelar(ctx context.Context, id int) (compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Cancelar", trace.WithAttributes(attribute.Int("pedido.id", id)))
+	defer span.End()
+
+	return s.alterar(ctx, id, "pedido de compra cancelado", func(_ context.Context, p *compra.Pedido) error {
+		return p.Cancelar(time.Now())
+	})
+}
+
+// Receber dá entrada das quantidades (itemID → quantidade) numa transação
+// só: linhas do pedido, estoque de cada item e registro dos recebimentos.
+// O estoque entra no depósito padrão, como qualquer mudança do total.
+func (s *compraService) Receber(ctx context.Context, id int, quantidades map[int]int, usuarioID int) (compra.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "compraService.Receber", trace.WithAttributes(attribute.Int("pedido.id", id)))
+	defer span.End()
+
+	var (
+		recebido     compra.Pedido
+		recebimentos []compra.Recebimento
+	)
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		p, err := s.repo.Travar(ctx, id)
+		if err != nil {
+			return err
+		}
+		recebimentos, err = p.Receber(quantidades, time.Now())
+		if err != nil {
+			return err
+		}
+
+		// 🔒 Trava antes de ler, em ordem crescente de id como os recebimentos
+		// já vêm: a soma não pode perder uma baixa concorrente
+		ids := make([]int, 0, len(recebimentos))
+		for _, r := range recebimentos {
+			ids = append(ids, r.ItemID)
+		}
+		if err := s.repoItens.TravarItens(ctx, ids); err != nil {
+			return err
+		}
+
+		for i, r := range recebimentos {
+			item, err := s.repoItens.GetItem(ctx, r.ItemID)
+			if err != nil {
+				return err
+			}
+			atualizado := *item
+			atualizado.Estoque += r.Quantidade
+			atualizado.UpdateBy = &usuarioID // Auditoria
+			if err := s.itens.UpdateItem(ctx, atualizado); err != nil {
+				return fmt.Errorf("item %d: %w", r.ItemID, err)
+			}
+			recebimentos[i].RecebidoPor = usuarioID
+		}
+
+		recebido, err = s.repo.Atualizar(ctx, *p)
+		if err != nil {
+			return err
+		}
+		return s.repo.RegistrarRecebimentos(ctx, recebimentos)
+	})
+	if err != nil {
+		return compra.Pedido{}, fmt.Errorf("Erro ao receber pedido de compra: %w", err)
+	}
+
+	for _, r := range recebimentos {
+		s.logger.InfoContext(ctx, "mercadoria recebida",
+			"pedido_id", recebido.ID,
+			"item_id", r.ItemID,
+			"quantidade", r.Quantidade,
+			"recebido_por", usuarioID)
+	}
+	s.logger.InfoContext(ctx, "pedido de compra atualizado", "pedido_id", recebido.ID, "status", recebido.Status)
+	return recebido, nil
+}
+
+// alterar trava o pedido, aplica a mudança e grava, tudo numa transação
+func (s *compraService) alterar(ctx context.Context, id int, evento string, mudar func(context.Context, *compra.Pedido) error) (compra.Pedido, error) {
+	var alterado compra.Pedido
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		p, err := s.repo.Travar(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := mudar(ctx, p); err != nil {
+			return err
+		}
+
+		alterado, err = s.repo.Atualizar(ctx, *p)
+		return err
+	})
+	if err != nil {
+		return compra.Pedido{}, err
+	}
+
+	s.logger.InfoContext(ctx, evento, "pedido_id", alterado.ID, "status", alterado.Status)
+	return alterado, nil
+}
+
+// completarLinhas confere se os itens existem e preenche o custo das linhas
+// que vieram sem ele com o custo do item no fornecedor
+func (s *compraService) completarLinhas(ctx context.Context, fornecedorID int, linhas []compra.Linha) ([]compra.Linha, error) {
+	completas := make([]compra.Linha, len(linhas))
+	for i, l := range linhas {
+		if _, err := s.repoItens.GetItem(ctx, l.ItemID); err != nil {
+			return nil, fmt.Errorf("%w: linha %d: %v", compra.ErrPedidoInvalido, i+1, err)
+		}
+		if l.CustoUnitario.Centavos == 0 && l.CustoUnitario.Moeda == "" {
+			vinculo, err := s.fornecedores.Vinculo(ctx, l.ItemID, fornecedorID)
+			if errors.Is(err, fornecedor.ErrVinculoNaoEncontrado) {
+				return nil, fmt.Errorf("%w: linha %d: informe o custo, o item %d não está vinculado ao fornecedor", compra.ErrPedidoInvalido, i+1, l.ItemID)
+			}
+			if err != nil {
+				return nil, err
+			}
+			l.CustoUnitario = vinculo.Custo
+		}
+		completas[i] = l
+	}
+	return completas, nil
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/compra"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/fornecedor"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCriarPedidoCompra_UsaCustoDoFornecedor(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCompraService(d.itens, d.itemService(), d.pedidosCompra, d.fornecedores, d.transacoes, d.logger)
+	custo := dinheiro.Novo(1250, dinheiro.BRL)
+
+	d.fornecedores.On("GetByID", mock.Anything, 2).Return(&fornecedor.Fornecedor{ID: 2, Nome: "Distribuidora Sul"}, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5, Nome: "Mouse"}, nil)
+	d.fornecedores.On("Vinculo", mock.Anything, 5, 2).Return(&fornecedor.ItemFornecedor{ItemID: 5, FornecedorID: 2, Custo: custo}, nil)
+	d.pedidosCompra.On("Criar", mock.Anything, mock.MatchedBy(func(p compra.Pedido) bool {
+		return p.Status == compra.StatusRascunho && p.CriadoPor == 7 && p.Linhas[0].CustoUnitario == custo
+	})).Return(func(_ context.Context, p compra.Pedido) (compra.Pedido, error) {
+		p.ID = 1
+		return p, nil
+	}).Once()
+
+	// ACT
+	criado, err := service.Criar(context.Background(), compra.Pedido{
+		FornecedorID: 2,
+		Linhas:       []compra.Linha{{ItemID: 5, Quantidade: 10}},
+		CriadoPor:    7,
+	})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, criado.ID)
+	assert.Equal(t, dinheiro.Novo(12500, dinheiro.BRL), criado.Total()[dinheiro.BRL])
+}
+
+func TestCriarPedidoCompra_SemCustoESemVinculo(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCompraService(d.itens, d.itemService(), d.pedidosCompra, d.fornecedores, d.transacoes, d.logger)
+
+	d.fornecedores.On("GetByID", mock.Anything, 2).Return(&fornecedor.Fornecedor{ID: 2}, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5}, nil)
+	d.fornecedores.On("Vinculo", mock.Anything, 5, 2).Return(nil, fornecedor.ErrVinculoNaoEncontrado)
+
+	// ACT
+	_, err := service.Criar(context.Background(), compra.Pedido{FornecedorID: 2, Linhas: []compra.Linha{{ItemID: 5, Quantidade: 10}}, CriadoPor: 7})
+
+	// ASSERT
+	assert.ErrorIs(t, err, compra.ErrPedidoInvalido)
+	d.pedidosCompra.AssertNotCalled(t, "Criar", mock.Anything, mock.Anything)
+}
+
+func TestReceberPedidoCompra_SomaAoEstoque(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCompraService(d.itens, d.itemService(), d.pedidosCompra, d.fornecedores, d.transacoes, d.logger)
+	enviado := &compra.Pedido{ID: 1, FornecedorID: 2, Status: compra.StatusEnviado, Linhas: []compra.Linha{
+		{ID: 10, ItemID: 5, Quantidade: 10, CustoUnitario: dinheiro.Novo(1250, dinheiro.BRL)},
+	}}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosCompra.On("Travar", mock.Anything, 1).Return(enviado, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{5}).Return(nil).Once()
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 0, Status: entity.StatusInativo}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 4 && i.Status == entity.StatusAtivo && *i.UpdateBy == 7
+	})).Return(nil).Once()
+	d.pedidosCompra.On("Atualizar", mock.Anything, mock.MatchedBy(func(p compra.Pedido) bool {
+		return p.Status == compra.StatusParcialmenteRecebido && p.Linhas[0].Recebida == 4
+	})).Return(func(_ context.Context, p compra.Pedido) (compra.Pedido, error) { return p, nil }).Once()
+	d.pedidosCompra.On("RegistrarRecebimentos", mock.Anything, mock.MatchedBy(func(r []compra.Recebimento) bool {
+		return len(r) == 1 && r[0].LinhaID == 10 && r[0].Quantidade == 4 && r[0].RecebidoPor == 7
+	})).Return(nil).Once()
+
+	// ACT
+	recebido, err := service.Receber(context.Background(), 1, map[int]int{5: 4}, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, compra.StatusParcialmenteRecebido, recebido.Status)
+}
+
+func TestReceberPedidoCompra_MaisQueOPendente(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCompraService(d.itens, d.itemService(), d.pedidosCompra, d.fornecedores, d.transacoes, d.logger)
+	enviado := &compra.Pedido{ID: 1, FornecedorID: 2, Status: compra.StatusEnviado, Linhas: []compra.Linha{
+		{ID: 10, ItemID: 5, Quantidade: 10, Recebida: 8, CustoUnitario: dinheiro.Novo(1250, dinheiro.BRL)},
+	}}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosCompra.On("Travar", mock.Anything, 1).Return(enviado, nil)
+
+	// ACT
+	_, err := service.Receber(context.Background(), 1, map[int]int{5: 3}, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, compra.ErrPedidoInvalido)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+	d.pedidosCompra.AssertNotCalled(t, "RegistrarRecebimentos", mock.Anything, mock.Anything)
+}
+
+func TestCancelarPedidoCompra_JaRecebido(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewCompraService(d.itens, d.itemService(), d.pedidosCompra, d.fornecedores, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosCompra.On("Travar", mock.Anything, 1).Return(&compra.Pedido{ID: 1, Status: compra.StatusParcialmenteRecebido}, nil)
+
+	// ACT
+	_, err := service.Cancelar(context.Background(), 1)
+
+	// ASSERT
+	assert.ErrorIs(t, err, compra.ErrTransicaoInvalida)
+	d.pedidosCompra.AssertNotCalled(t, "Atualizar", mock.Anything, mock.Anything)
+}
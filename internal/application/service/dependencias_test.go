@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/metrics"
+	"desafio-itens-app/internal/application/service/mocks"
+	"log/slog"
+	"testing"
+)
+
+// dependencias reúne os mocks dos ports usados pelos services; cada teste
+// monta o service que vai testar a partir daqui
+type dependencias struct {
+	itens         *mocks.ItemRepository
+	transacoes    *mocks.Transacionador
+	cambios       *mocks.CambioRepository
+	precos        *mocks.PrecoRepository
+	promocoes     *mocks.PromocaoRepository
+	estoque       *mocks.EstoqueRepository
+	reservas      *mocks.ReservaRepository
+	fornecedores  *mocks.FornecedorRepository
+	pedidosCompra *mocks.PedidoCompraRepository
+	pedidosVenda  *mocks.PedidoVendaRepository
+	jobs          *mocks.ImportacaoJobRepository
+	imagens       *mocks.ImagemRepository
+	blobs         *mocks.BlobStore
+	logger        *slog.Logger
+}
+
+// Mocks sem expectativas passam no AssertExpectations, então criar todos não custa nada
+func novasDependencias(t *testing.T) dependencias {
+	return dependencias{
+		itens:         mocks.NewItemRepository(t),
+		transacoes:    mocks.NewTransacionador(t),
+		cambios:       mocks.NewCambioRepository(t),
+		precos:        mocks.NewPrecoRepository(t),
+		promocoes:     mocks.NewPromocaoRepository(t),
+		estoque:       mocks.NewEstoqueRepository(t),
+		reservas:      mocks.NewReservaRepository(t),
+		fornecedores:  mocks.NewFornecedorRepository(t),
+		pedidosCompra: mocks.NewPedidoCompraRepository(t),
+		pedidosVenda:  mocks.NewPedidoVendaRepository(t),
+		jobs:          mocks.NewImportacaoJobRepository(t),
+		imagens:       mocks.NewImagemRepository(t),
+		blobs:         mocks.NewBlobStore(t),
+		logger:        slog.New(slog.DiscardHandler),
+	}
+}
+
+// itemService é o service real sobre o mock de itens, como os outros services o recebem em produção
+func (d dependencias) itemService() *itemService {
+	return NewItemService(d.itens, d.logger, metrics.Nop{})
+}
+
+// executarNaTransacao faz o mock rodar fn como o Transacionador real
+func executarNaTransacao(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/deposito"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+)
+
+// limiteTransferencias: transferências mostradas na posição do item
+const limiteTransferencias = 20
+
+type estoqueService struct {
+	repoItens  repositories.ItemRepository
+	itens      services.ItemService // Total e status do item seguem as regras do UpdateItem
+	repo       repositories.EstoqueRepository
+	transacoes repositories.Transacionador
+	logger     *slog.Logger
+}
+
+func NewEstoqueService(repoItens repositories.ItemRepository, itens services.ItemService, repo repositories.EstoqueRepository, transacoes repositories.Transacionador, logger *slog.Logger) *estoqueService {
+	return &estoqueService{repoItens: repoItens, itens: itens, repo: repo, transacoes: transacoes, logger: logger}
+}
+
+func (s *estoqueService) ListarDepositos(ctx context.Context) ([]deposito.Deposito, error) {
+	ctx, span := tracer.Start(ctx, "estoqueService.ListarDepositos")
+	defer span.End()
+
+	return s.repo.ListarDepositos(ctx)
+}
+
+func (s *estoqueService) CriarDeposito(ctx context.Context, d deposito.Deposito) (deposito.Deposito, error) {
+	ctx, span := tracer.Start(ctx, "estoqueService.CriarDeposito")
+	defer span.End()
+
+	criado, err := s.repo.CriarDeposito(ctx, d)
+	if err != nil {
+		return deposito.Deposito{}, err
+	}
+
+	s.logger.InfoContext(ctx, "depósito criado", "deposito_id", criado.ID, "codigo", criado.Codigo)
+	return criado, nil
+}
+
+// Posicao traz o saldo em cada depósito e as últimas transferências
+func (s *estoqueService) Posicao(ctx context.Context, itemID int) (deposito.Posicao, error) {
+	ctx, span := tracer.Start(ctx, "estoqueService.Posicao", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	saldos, err := s.repo.Saldos(ctx, itemID)
+	if err != nil {
+		return deposito.Posicao{}, err
+	}
+	transferencias, err := s.repo.Transferencias(ctx, itemID, limiteTransferencias)
+	if err != nil {
+		return deposito.Posicao{}, err
+	}
+
+	return deposito.Posicao{
+		ItemID:         itemID,
+		Total:          deposito.Total(saldos),
+		Saldos:         saldos,
+		Transferencias: transferencias,
+	}, nil
+}
+
+// DefinirSaldo grava o saldo e atualiza o item numa transação só: o
+// Estoque do item vira o novo total e o status segue a regra de sempre
+// (sem estoque em nenhum depósito = inativo)
+func (s *estoqueService) DefinirSaldo(ctx context.Context, itemID, depositoID, quantidade, usuarioID int) (deposito.Posicao, error) {
+	ctx, span := tracer.Start(ctx, "estoqueService.DefinirSaldo", trace.WithAttributes(
+		attribute.Int("item.id", itemID),
+		attribute.Int("deposito.id", depositoID)))
+	defer span.End()
+
+	if quantidade < 0 {
+		return deposito.Posicao{}, errors.New("Estoque não pode ser negativo")
+	}
+
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		total, err := s.repo.DefinirSaldo(ctx, itemID, depositoID, quantidade)
+		if err != nil {
+			return err
+		}
+
+		item, err := s.repoItens.GetItem(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		atualizado := *item
+		atualizado.Estoque = total
+		atualizado.UpdateBy = &usuarioID // Auditoria
+		return s.itens.UpdateItem(ctx, atualizado)
+	})
+	if err != nil {
+		return deposito.Posicao{}, fmt.Errorf("Erro ao definir estoque no depósito: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "saldo de estoque definido",
+		"item_id", itemID,
+		"deposito_id", depositoID,
+		"quantidade", quantidade,
+		"updated_by", usuarioID)
+
+	return s.Posicao(ctx, itemID)
+}
+
+// Transferir move estoque entre depósitos; o total e o status do item não
+// mudam
+func (s *estoqueService) Transferir(ctx context.Context, t deposito.Transferencia) (deposito.Transferencia, error) {
+	ctx, span := tracer.Start(ctx, "estoqueService.Transferir", trace.WithAttributes(attribute.Int("item.id", t.ItemID)))
+	defer span.End()
+
+	if err := t.Validar(); err != nil {
+		return deposito.Transferencia{}, err
+	}
+
+	feita, err := s.repo.Transferir(ctx, t)
+	if err != nil {
+		return deposito.Transferencia{}, err
+	}
+
+	s.logger.InfoContext(ctx, "estoque transferido",
+		"item_id", feita.ItemID,
+		"origem", feita.Origem,
+		"destino", feita.Destino,
+		"quantidade", feita.Quantidade,
+		"usuario_id", feita.UsuarioID)
+	return feita, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/deposito"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestDefinirSaldo_ItemFicaInativoQuandoTotalZera(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewEstoqueService(d.itens, d.itemService(), d.estoque, d.transacoes, d.logger)
+	principal := deposito.Deposito{ID: 1, Codigo: "PRINCIPAL", Padrao: true}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.estoque.On("DefinirSaldo", mock.Anything, 5, 1, 0).Return(0, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 4, Status: entity.StatusAtivo}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 0 && i.Status == entity.StatusInativo && *i.UpdateBy == 7
+	})).Return(nil).Once()
+	d.estoque.On("Saldos", mock.Anything, 5).Return([]deposito.Saldo{{Deposito: principal, Quantidade: 0}}, nil)
+	d.estoque.On("Transferencias", mock.Anything, 5, limiteTransferencias).Return([]deposito.Transferencia{}, nil)
+
+	// ACT
+	posicao, err := service.DefinirSaldo(context.Background(), 5, 1, 0, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 0, posicao.Total)
+	assert.Len(t, posicao.Saldos, 1)
+}
+
+func TestDefinirSaldo_TotalSomaTodosOsDepositos(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewEstoqueService(d.itens, d.itemService(), d.estoque, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.estoque.On("DefinirSaldo", mock.Anything, 5, 2, 3).Return(3, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 0, Status: entity.StatusInativo}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 3 && i.Status == entity.StatusAtivo
+	})).Return(nil).Once()
+	d.estoque.On("Saldos", mock.Anything, 5).Return([]deposito.Saldo{
+		{Deposito: deposito.Deposito{ID: 1}, Quantidade: 0},
+		{Deposito: deposito.Deposito{ID: 2}, Quantidade: 3},
+	}, nil)
+	d.estoque.On("Transferencias", mock.Anything, 5, limiteTransferencias).Return([]deposito.Transferencia{}, nil)
+
+	// ACT
+	posicao, err := service.DefinirSaldo(context.Background(), 5, 2, 3, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 3, posicao.Total)
+}
+
+func TestDefinirSaldo_DepositoInexistente(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewEstoqueService(d.itens, d.itemService(), d.estoque, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.estoque.On("DefinirSaldo", mock.Anything, 5, 9, 3).Return(0, deposito.ErrDepositoNaoEncontrado)
+
+	// ACT
+	_, err := service.DefinirSaldo(context.Background(), 5, 9, 3, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, deposito.ErrDepositoNaoEncontrado)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
+
+func TestDefinirSaldo_QuantidadeNegativa(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewEstoqueService(d.itens, d.itemService(), d.estoque, d.transacoes, d.logger)
+
+	// ACT
+	_, err := service.DefinirSaldo(context.Background(), 5, 1, -1, 7)
+
+	// ASSERT
+	assert.Error(t, err)
+	d.estoque.AssertNotCalled(t, "DefinirSaldo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTransferir_ValidaAntesDeGravar(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewEstoqueService(d.itens, d.itemService(), d.estoque, d.transacoes, d.logger)
+
+	// ACT
+	_, err := service.Transferir(context.Background(), deposito.Transferencia{ItemID: 5, Origem: 1, Destino: 1, Quantidade: 2})
+
+	// ASSERT
+	assert.ErrorContains(t, err, "diferentes")
+	d.estoque.AssertNotCalled(t, "Transferir", mock.Anything, mock.Anything)
+}
+
+func TestTransferir_EstoqueInsuficiente(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewEstoqueService(d.itens, d.itemService(), d.estoque, d.transacoes, d.logger)
+	transferencia := deposito.Transferencia{ItemID: 5, Origem: 1, Destino: 2, Quantidade: 10, UsuarioID: 7}
+
+	d.estoque.On("Transferir", mock.Anything, transferencia).Return(deposito.Transferencia{}, deposito.ErrEstoqueInsuficiente)
+
+	// ACT
+	_, err := service.Transferir(context.Background(), transferencia)
+
+	// ASSERT
+	assert.True(t, errors.Is(err, deposito.ErrEstoqueInsuficiente))
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/fornecedor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+type fornecedorService struct {
+	repoItens repositories.ItemRepository // Só para conferir se o item existe ao vincular
+	repo      repositories.FornecedorRepository
+	logger    *slog.Logger
+}
+
+func NewFornecedorService(repoItens repositories.ItemRepository, repo repositories.FornecedorRepository, logger *slog.Logger) *fornecedorService {
+	return &fornecedorService{repoItens: repoItens, repo: repo, logger: logger}
+}
+
+func (s *fornecedorService) Listar(ctx context.Context) ([]fornecedor.Fornecedor, error) {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Listar")
+	defer span.End()
+
+	return s.repo.Listar(ctx)
+}
+
+func (s *fornecedorService) Buscar(ctx context.Context, id int) (*fornecedor.Fornecedor, error) {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Buscar")
+	defer span.End()
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *fornecedorService) Criar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Criar")
+	defer span.End()
+
+	f.Normalizar()
+	if err := f.Validar(); err != nil {
+		return fornecedor.Fornecedor{}, err
+	}
+	agora := time.Now().UTC()
+	f.CriadoEm, f.AtualizadoEm = agora, agora
+
+	criado, err := s.repo.Criar(ctx, f)
+	if err != nil {
+		return fornecedor.Fornecedor{}, err
+	}
+
+	s.logger.InfoContext(ctx, "fornecedor criado", "fornecedor_id", criado.ID, "nome", criado.Nome)
+	return criado, nil
+}
+
+func (s *fornecedorService) Atualizar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Atualizar", trace.WithAttributes(attribute.Int("fornecedor.id", f.ID)))
+	defer span.End()
+
+	f.Normalizar()
+	if err := f.Validar(); err != nil {
+		return fornecedor.Fornecedor{}, err
+	}
+	f.AtualizadoEm = time.Now().UTC()
+
+	atualizado, err := s.repo.Atualizar(ctx, f)
+	if err != nil {
+		return fornecedor.Fornecedor{}, err
+	}
+
+	s.logger.InfoContext(ctx, "fornecedor atualizado", "fornecedor_id", atualizado.ID)
+	return atualizado, nil
+}
+
+func (s *fornecedorService) Vinculos(ctx context.Context, itemID int) ([]fornecedor.ItemFornecedor, error) {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Vinculos", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	return s.repo.Vinculos(ctx, itemID)
+}
+
+// Vincular grava SKU e custo do item no fornecedor, substituindo o que
+// já houver
+func (s *fornecedorService) Vincular(ctx context.Context, v fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error) {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Vincular", trace.WithAttributes(
+		attribute.Int("item.id", v.ItemID),
+		attribute.Int("fornecedor.id", v.FornecedorID)))
+	defer span.End()
+
+	if err := v.Validar(); err != nil {
+		return fornecedor.ItemFornecedor{}, err
+	}
+	f, err := s.repo.GetByID(ctx, v.FornecedorID)
+	if err != nil {
+		return fornecedor.ItemFornecedor{}, err
+	}
+	if _, err := s.repoItens.GetItem(ctx, v.ItemID); err != nil {
+		return fornecedor.ItemFornecedor{}, err
+	}
+	v.FornecedorNome = f.Nome
+
+	vinculo, err := s.repo.Vincular(ctx, v)
+	if err != nil {
+		return fornecedor.ItemFornecedor{}, err
+	}
+
+	s.logger.InfoContext(ctx, "fornecedor vinculado ao item",
+		"item_id", vinculo.ItemID,
+		"fornecedor_id", vinculo.FornecedorID,
+		"sku", vinculo.SKU,
+		"custo", vinculo.Custo.String())
+	return vinculo, nil
+}
+
+func (s *fornecedorService) Desvincular(ctx context.Context, itemID, fornecedorID int) error {
+	ctx, span := tracer.Start(ctx, "fornecedorService.Desvincular")
+	defer span.End()
+
+	if err := s.repo.Desvincular(ctx, itemID, fornecedorID); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "fornecedor desvinculado do item", "item_id", itemID, "fornecedor_id", fornecedorID)
+	return nil
+}
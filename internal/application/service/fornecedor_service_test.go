@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/fornecedor"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCriarFornecedor_Normaliza(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewFornecedorService(d.itens, d.fornecedores, d.logger)
+
+	d.fornecedores.On("Criar", mock.Anything, mock.MatchedBy(func(f fornecedor.Fornecedor) bool {
+		return f.Nome == "Distribuidora Sul" && f.Email == "compras@sul.com.br" && !f.CriadoEm.IsZero()
+	})).Return(func(_ context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+		f.ID = 2
+		return f, nil
+	}).Once()
+
+	// ACT
+	criado, err := service.Criar(context.Background(), fornecedor.Fornecedor{Nome: " Distribuidora Sul ", Email: "Compras@Sul.com.br"})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 2, criado.ID)
+}
+
+func TestVincularFornecedor(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewFornecedorService(d.itens, d.fornecedores, d.logger)
+	vinculo := fornecedor.ItemFornecedor{ItemID: 5, FornecedorID: 2, SKU: " MS-01 ", Custo: dinheiro.Novo(1250, dinheiro.BRL)}
+
+	d.fornecedores.On("GetByID", mock.Anything, 2).Return(&fornecedor.Fornecedor{ID: 2, Nome: "Distribuidora Sul"}, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5}, nil)
+	d.fornecedores.On("Vincular", mock.Anything, mock.MatchedBy(func(v fornecedor.ItemFornecedor) bool {
+		return v.SKU == "MS-01" && v.FornecedorNome == "Distribuidora Sul"
+	})).Return(func(_ context.Context, v fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error) { return v, nil }).Once()
+
+	// ACT
+	criado, err := service.Vincular(context.Background(), vinculo)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, "Distribuidora Sul", criado.FornecedorNome)
+}
+
+func TestVincularFornecedor_FornecedorInexistente(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewFornecedorService(d.itens, d.fornecedores, d.logger)
+
+	d.fornecedores.On("GetByID", mock.Anything, 9).Return(nil, fornecedor.ErrFornecedorNaoEncontrado)
+
+	// ACT
+	_, err := service.Vincular(context.Background(), fornecedor.ItemFornecedor{ItemID: 5, FornecedorID: 9, Custo: dinheiro.Novo(100, dinheiro.BRL)})
+
+	// ASSERT
+	assert.ErrorIs(t, err, fornecedor.ErrFornecedorNaoEncontrado)
+	d.fornecedores.AssertNotCalled(t, "Vincular", mock.Anything, mock.Anything)
+}
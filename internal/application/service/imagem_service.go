@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"desafio-itens-app/internal/application/ports/blobs"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/domain/imagem"
+	"encoding/hex"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+)
+
+type imagemService struct {
+	repo       repositories.ImagemRepository
+	store      blobs.BlobStore
+	urlBase    string // Prefixo das URLs públicas: BLOB_PUBLIC_URL ou a rota /v1/imagens
+	transacoes repositories.Transacionador
+	logger     *slog.Logger
+}
+
+func NewImagemService(repo repositories.ImagemRepository, store blobs.BlobStore, urlBase string, transacoes repositories.Transacionador, logger *slog.Logger) *imagemService {
+	return &imagemService{repo: repo, store: store, urlBase: strings.TrimRight(urlBase, "/"), transacoes: transacoes, logger: logger}
+}
+
+func (s *imagemService) Enviar(ctx context.Context, itemID int, arquivos [][]byte, usuarioID int) ([]imagem.Imagem, error) {
+	ctx, span := tracer.Start(ctx, "imagemService.Enviar", trace.WithAttributes(attribute.Int("item.id", itemID), attribute.Int("arquivos", len(arquivos))))
+	defer span.End()
+
+	if len(arquivos) == 0 {
+		return nil, fmt.Errorf("%w: nenhum arquivo enviado", imagem.ErrImagemInvalida)
+	}
+	if len(arquivos) > imagem.MaxPorItem {
+		return nil, imagem.ErrLimiteImagens
+	}
+
+	// PASSO 1: Processa tudo antes de gravar qualquer coisa
+	processadas := make([]imagem.Processada, 0, len(arquivos))
+	for i, dados := range arquivos {
+		p, err := imagem.Processar(dados)
+		if err != nil {
+			return nil, fmt.Errorf("arquivo %d: %w", i+1, err)
+		}
+		processadas = append(processadas, p)
+	}
+
+	// PASSO 2: Grava os arquivos; se algo falhar daqui em diante, eles são apagados
+	agora := time.Now()
+	novas := make([]imagem.Imagem, 0, len(arquivos))
+	gravadas := make([]string, 0, 2*len(arquivos))
+	for i, p := range processadas {
+		nome, err := novoNomeArquivo()
+		if err != nil {
+			s.removerArquivos(ctx, gravadas...)
+			return nil, err
+		}
+		chave := fmt.Sprintf("itens/%d/%s%s", itemID, nome, p.Extensao)
+		chaveMiniatura := fmt.Sprintf("itens/%d/%s_mini%s", itemID, nome, p.ExtensaoMiniatura)
+
+		if err := s.store.Salvar(ctx, chave, p.ContentType, arquivos[i]); err != nil {
+			s.removerArquivos(ctx, gravadas...)
+			return nil, err
+		}
+		gravadas = append(gravadas, chave)
+		if err := s.store.Salvar(ctx, chaveMiniatura, p.ContentTypeMiniatura, p.Miniatura); err != nil {
+			s.removerArquivos(ctx, gravadas...)
+			return nil, err
+		}
+		gravadas = append(gravadas, chaveMiniatura)
+
+		novas = append(novas, imagem.Imagem{
+			ItemID:         itemID,
+			Chave:          chave,
+			ChaveMiniatura: chaveMiniatura,
+			ContentType:    p.ContentType,
+			Tamanho:        int64(len(arquivos[i])),
+			Largura:        p.Largura,
+			Altura:         p.Altura,
+			CriadoPor:      &usuarioID,
+			CriadoEm:       agora,
+		})
+	}
+
+	// PASSO 3: Com o item travado, envios simultâneos não disputam a ordem nem o limite
+	var criadas []imagem.Imagem
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		if err := s.repo.TravarItem(ctx, itemID); err != nil {
+			return err
+		}
+		existentes, err := s.repo.Listar(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		ordenadas, err := imagem.Acrescentar(existentes, novas)
+		if err != nil {
+			return err
+		}
+		criadas, err = s.repo.Criar(ctx, ordenadas)
+		return err
+	})
+	if err != nil {
+		s.removerArquivos(ctx, gravadas...)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "imagens enviadas",
+		"item_id", itemID,
+		"quantidade", len(criadas),
+		"usuario_id", usuarioID)
+	return s.comURLs(criadas), nil
+}
+
+func (s *imagemService) Listar(ctx context.Context, itemID int) ([]imagem.Imagem, error) {
+	ctx, span := tracer.Start(ctx, "imagemService.Listar", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	imagens, err := s.repo.Listar(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return s.comURLs(imagens), nil
+}
+
+func (s *imagemService) PorItens(ctx context.Context, itemIDs []int) (map[int][]imagem.Imagem, error) {
+	ctx, span := tracer.Start(ctx, "imagemService.PorItens", trace.WithAttributes(attribute.Int("itens", len(itemIDs))))
+	defer span.End()
+
+	porItem, err := s.repo.PorItens(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	for id, imagens := range porItem {
+		porItem[id] = s.comURLs(imagens)
+	}
+	return porItem, nil
+}
+
+func (s *imagemService) Reordenar(ctx context.Context, itemID int, ids []int) ([]imagem.Imagem, error) {
+	ctx, span := tracer.Start(ctx, "imagemService.Reordenar", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	return s.alterar(ctx, itemID, func(imagens []imagem.Imagem) ([]imagem.Imagem, error) {
+		if err := imagem.Reordenar(imagens, ids); err != nil {
+			return nil, err
+		}
+		return imagens, nil
+	})
+}
+
+func (s *imagemService) DefinirPrincipal(ctx context.Context, itemID, imagemID int) ([]imagem.Imagem, error) {
+	ctx, span := tracer.Start(ctx, "imagemService.DefinirPrincipal", trace.WithAttributes(attribute.Int("item.id", itemID), attribute.Int("imagem.id", imagemID)))
+	defer span.End()
+
+	return s.alterar(ctx, itemID, func(imagens []imagem.Imagem) ([]imagem.Imagem, error) {
+		if err := imagem.DefinirPrincipal(imagens, imagemID); err != nil {
+			return nil, err
+		}
+		return imagens, nil
+	})
+}
+
+// Remover apaga o registro na transação; os arquivos saem depois, e uma
+// falha aí só deixa lixo no BlobStore
+func (s *imagemService) Remover(ctx context.Context, itemID, imagemID int) error {
+	ctx, span := tracer.Start(ctx, "imagemService.Remover", trace.WithAttributes(attribute.Int("item.id", itemID), attribute.Int("imagem.id", imagemID)))
+	defer span.End()
+
+	var removida imagem.Imagem
+	_, err := s.alterar(ctx, itemID, func(imagens []imagem.Imagem) ([]imagem.Imagem, error) {
+		var restantes []imagem.Imagem
+		var err error
+		removida, restantes, err = imagem.Retirar(imagens, imagemID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.repo.Remover(ctx, imagemID); err != nil {
+			return nil, err
+		}
+		return restantes, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.removerArquivos(ctx, removida.Chave, removida.ChaveMiniatura)
+	s.logger.InfoContext(ctx, "imagem removida", "item_id", itemID, "imagem_id", imagemID)
+	return nil
+}
+
+func (s *imagemService) Abrir(ctx context.Context, chave string) (io.ReadCloser, string, error) {
+	ctx, span := tracer.Start(ctx, "imagemService.Abrir")
+	defer span.End()
+
+	return s.store.Abrir(ctx, chave)
+}
+
+// alterar trava o item, aplica a mudança nas imagens dele e grava ordem e
+// principal do que a função devolver
+func (s *imagemService) alterar(ctx context.Context, itemID int, mudar func([]imagem.Imagem) ([]imagem.Imagem, error)) ([]imagem.Imagem, error) {
+	var alteradas []imagem.Imagem
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		if err := s.repo.TravarItem(ctx, itemID); err != nil {
+			return err
+		}
+		imagens, err := s.repo.Listar(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		alteradas, err = mudar(imagens)
+		if err != nil {
+			return err
+		}
+		return s.repo.Atualizar(ctx, alteradas)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.comURLs(ordenarImagens(alteradas)), nil
+}
+
+func (s *imagemService) comURLs(imagens []imagem.Imagem) []imagem.Imagem {
+	for i := range imagens {
+		imagens[i].URL = s.urlBase + "/" + imagens[i].Chave
+		imagens[i].URLMiniatura = s.urlBase + "/" + imagens[i].ChaveMiniatura
+	}
+	return imagens
+}
+
+func (s *imagemService) removerArquivos(ctx context.Context, chaves ...string) {
+	for _, chave := range chaves {
+		if err := s.store.Remover(ctx, chave); err != nil {
+			s.logger.WarnContext(ctx, "falha ao remover arquivo de imagem", "chave", chave, "erro", err)
+		}
+	}
+}
+
+func ordenarImagens(imagens []imagem.Imagem) []imagem.Imagem {
+	slices.SortFunc(imagens, func(a, b imagem.Imagem) int { return a.Ordem - b.Ordem })
+	return imagens
+}
+
+func novoNomeArquivo() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("erro ao gerar nome do arquivo: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"desafio-itens-app/internal/domain/imagem"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func pngDeTeste(t *testing.T) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 8, 4))))
+	return buf.Bytes()
+}
+
+func TestEnviarImagens_AcrescentaNoFimDaOrdem(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	d.blobs.On("Salvar", mock.Anything, mock.MatchedBy(func(chave string) bool {
+		return strings.HasPrefix(chave, "itens/5/") && strings.HasSuffix(chave, ".png")
+	}), "image/png", mock.Anything).Return(nil).Times(2)
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.imagens.On("TravarItem", mock.Anything, 5).Return(nil)
+	d.imagens.On("Listar", mock.Anything, 5).Return([]imagem.Imagem{{ID: 1, ItemID: 5, Ordem: 1, Principal: true}}, nil)
+	d.imagens.On("Criar", mock.Anything, mock.MatchedBy(func(novas []imagem.Imagem) bool {
+		return len(novas) == 1 && novas[0].Ordem == 2 && !novas[0].Principal &&
+			novas[0].Largura == 8 && novas[0].Altura == 4 && *novas[0].CriadoPor == 7
+	})).Return(func(_ context.Context, novas []imagem.Imagem) ([]imagem.Imagem, error) {
+		novas[0].ID = 2
+		return novas, nil
+	}).Once()
+
+	// ACT
+	criadas, err := service.Enviar(context.Background(), 5, [][]byte{pngDeTeste(t)}, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	require.Len(t, criadas, 1)
+	assert.Equal(t, "/v1/imagens/"+criadas[0].Chave, criadas[0].URL)
+	assert.Contains(t, criadas[0].URLMiniatura, "_mini.png")
+}
+
+func TestEnviarImagens_ArquivoInvalidoNaoGravaNada(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	// ACT
+	_, err := service.Enviar(context.Background(), 5, [][]byte{pngDeTeste(t), []byte("não é imagem")}, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, imagem.ErrTipoNaoSuportado)
+	d.blobs.AssertNotCalled(t, "Salvar", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	d.imagens.AssertNotCalled(t, "Criar", mock.Anything, mock.Anything)
+}
+
+func TestEnviarImagens_LimiteApagaArquivosGravados(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+	existentes := make([]imagem.Imagem, imagem.MaxPorItem)
+
+	d.blobs.On("Salvar", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.imagens.On("TravarItem", mock.Anything, 5).Return(nil)
+	d.imagens.On("Listar", mock.Anything, 5).Return(existentes, nil)
+	d.blobs.On("Remover", mock.Anything, mock.Anything).Return(nil).Times(2)
+
+	// ACT
+	_, err := service.Enviar(context.Background(), 5, [][]byte{pngDeTeste(t)}, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, imagem.ErrLimiteImagens)
+	d.imagens.AssertNotCalled(t, "Criar", mock.Anything, mock.Anything)
+}
+
+func TestEnviarImagens_FalhaNoStoreApagaOQueJaFoiGravado(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	d.blobs.On("Salvar", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	d.blobs.On("Salvar", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("disco cheio")).Once()
+	d.blobs.On("Remover", mock.Anything, mock.Anything).Return(nil).Once()
+
+	// ACT
+	_, err := service.Enviar(context.Background(), 5, [][]byte{pngDeTeste(t)}, 7)
+
+	// ASSERT
+	assert.Error(t, err)
+	d.imagens.AssertNotCalled(t, "TravarItem", mock.Anything, mock.Anything)
+}
+
+func TestReordenarImagens(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.imagens.On("TravarItem", mock.Anything, 5).Return(nil)
+	d.imagens.On("Listar", mock.Anything, 5).Return([]imagem.Imagem{{ID: 1, Ordem: 1}, {ID: 2, Ordem: 2}, {ID: 3, Ordem: 3}}, nil)
+	d.imagens.On("Atualizar", mock.Anything, mock.Anything).Return(nil).Once()
+
+	// ACT
+	imagens, err := service.Reordenar(context.Background(), 5, []int{3, 1, 2})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 2}, []int{imagens[0].ID, imagens[1].ID, imagens[2].ID})
+}
+
+func TestReordenarImagens_ListaIncompleta(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.imagens.On("TravarItem", mock.Anything, 5).Return(nil)
+	d.imagens.On("Listar", mock.Anything, 5).Return([]imagem.Imagem{{ID: 1, Ordem: 1}, {ID: 2, Ordem: 2}}, nil)
+
+	// ACT
+	_, err := service.Reordenar(context.Background(), 5, []int{2})
+
+	// ASSERT
+	assert.ErrorIs(t, err, imagem.ErrOrdemInvalida)
+	d.imagens.AssertNotCalled(t, "Atualizar", mock.Anything, mock.Anything)
+}
+
+func TestRemoverImagem_PrincipalPassaParaAProxima(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.imagens.On("TravarItem", mock.Anything, 5).Return(nil)
+	d.imagens.On("Listar", mock.Anything, 5).Return([]imagem.Imagem{
+		{ID: 1, Ordem: 1, Principal: true, Chave: "itens/5/a.png", ChaveMiniatura: "itens/5/a_mini.png"},
+		{ID: 2, Ordem: 2},
+	}, nil)
+	d.imagens.On("Remover", mock.Anything, 1).Return(nil).Once()
+	d.imagens.On("Atualizar", mock.Anything, mock.MatchedBy(func(imagens []imagem.Imagem) bool {
+		return len(imagens) == 1 && imagens[0].ID == 2 && imagens[0].Ordem == 1 && imagens[0].Principal
+	})).Return(nil).Once()
+	d.blobs.On("Remover", mock.Anything, "itens/5/a.png").Return(nil).Once()
+	d.blobs.On("Remover", mock.Anything, "itens/5/a_mini.png").Return(errors.New("timeout")).Once()
+
+	// ACT
+	err := service.Remover(context.Background(), 5, 1)
+
+	// ASSERT
+	assert.NoError(t, err) // Falha ao apagar o arquivo não desfaz a remoção
+}
+
+func TestRemoverImagem_DeOutroItem(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImagemService(d.imagens, d.blobs, "/v1/imagens/", d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.imagens.On("TravarItem", mock.Anything, 5).Return(nil)
+	d.imagens.On("Listar", mock.Anything, 5).Return([]imagem.Imagem{{ID: 1, Ordem: 1, Principal: true}}, nil)
+
+	// ACT
+	err := service.Remover(context.Background(), 5, 9)
+
+	// ASSERT
+	assert.ErrorIs(t, err, imagem.ErrImagemNaoEncontrada)
+	d.imagens.AssertNotCalled(t, "Remover", mock.Anything, mock.Anything)
+	d.blobs.AssertNotCalled(t, "Remover", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/importacao"
+	entity "desafio-itens-app/internal/domain/item"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// intervaloProgresso: a cada quantas linhas o job grava o andamento
+const intervaloProgresso = 100
+
+type importacaoService struct {
+	repoItens repositories.ItemRepository
+	itens     services.ItemService // Criação e atualização seguem as mesmas regras do CRUD
+	jobs      repositories.ImportacaoJobRepository
+	logger    *slog.Logger
+
+	// Jobs em andamento: Encerrar cancela e espera todos
+	encerramento context.Context
+	encerrar     context.CancelFunc
+	execucoes    sync.WaitGroup
+}
+
+func NewImportacaoService(repoItens repositories.ItemRepository, itens services.ItemService, jobs repositories.ImportacaoJobRepository, logger *slog.Logger) *importacaoService {
+	encerramento, encerrar := context.WithCancel(context.Background())
+	return &importacaoService{
+		repoItens:    repoItens,
+		itens:        itens,
+		jobs:         jobs,
+		logger:       logger,
+		encerramento: encerramento,
+		encerrar:     encerrar,
+	}
+}
+
+func (s *importacaoService) Importar(ctx context.Context, linhas []importacao.Linha, opcoes importacao.Opcoes) (importacao.Relatorio, error) {
+	ctx, span := tracer.Start(ctx, "importacaoService.Importar", trace.WithAttributes(attribute.Int("linhas", len(linhas)), attribute.Bool("dry_run", opcoes.DryRun)))
+	defer span.End()
+
+	relatorio := importacao.Relatorio{DryRun: opcoes.DryRun}
+	for _, linha := range linhas {
+		if err := ctx.Err(); err != nil {
+			return relatorio, err
+		}
+		relatorio.Registrar(s.importarLinha(ctx, linha, opcoes))
+	}
+
+	s.logger.InfoContext(ctx, "importação concluída",
+		"linhas", relatorio.Total,
+		"criados", relatorio.Criados,
+		"atualizados", relatorio.Atualizados,
+		"erros", relatorio.Erros,
+		"dry_run", opcoes.DryRun,
+		"usuario_id", opcoes.UsuarioID)
+
+	return relatorio, nil
+}
+
+// importarLinha nunca falha a importação inteira: qualquer problema vira
+// um resultado de erro para aquela linha
+func (s *importacaoService) importarLinha(ctx context.Context, linha importacao.Linha, opcoes importacao.Opcoes) importacao.ResultadoLinha {
+	resultado := importacao.ResultadoLinha{Linha: linha.Numero, Code: linha.Code}
+	falha := func(err error) importacao.ResultadoLinha {
+		resultado.Acao = importacao.AcaoErro
+		resultado.Erro = err.Error()
+		return resultado
+	}
+
+	if linha.Erro != "" {
+		return falha(errors.New(linha.Erro))
+	}
+
+	// 🔁 UPSERT: code de um item existente → atualiza em vez de criar
+	if opcoes.Upsert && linha.Code != "" {
+		existente, err := s.repoItens.GetItemByCode(ctx, linha.Code)
+		if err != nil {
+			return falha(err)
+		}
+		if existente != nil {
+			if !existente.PodeSerEditadoPor(opcoes.UsuarioID, opcoes.Admin) {
+				return falha(errors.New("Você só pode editar itens que criou"))
+			}
+
+			aplicar := func(item *entity.Item) error {
+				item.Nome = linha.Nome
+				item.Descricao = linha.Descricao
+				item.DefinirPreco(linha.Preco)
+				item.Estoque = linha.Estoque
+				item.UpdateBy = &opcoes.UsuarioID
+				item.AtualizarStatus()
+				return item.IsValid()
+			}
+
+			// Simulação confere na leitura; a gravação relê o item travado
+			if opcoes.DryRun {
+				atualizado := *existente
+				if err := aplicar(&atualizado); err != nil {
+					return falha(err)
+				}
+			} else if _, err := s.itens.AlterarItem(ctx, existente.ID, aplicar); err != nil {
+				return falha(err)
+			}
+			resultado.Acao = importacao.AcaoAtualizado
+			resultado.ItemID = existente.ID
+			return resultado
+		}
+	}
+
+	// ➕ CRIAÇÃO: o code do arquivo é ignorado, um novo é gerado
+	novo := entity.Item{
+		Nome:      linha.Nome,
+		Descricao: linha.Descricao,
+		Preco:     linha.Preco,
+		Estoque:   linha.Estoque,
+		CreatedBy: &opcoes.UsuarioID,
+	}
+
+	if opcoes.DryRun {
+		novo.AtualizarStatus()
+		if err := novo.IsValid(); err != nil {
+			return falha(err)
+		}
+		resultado.Acao = importacao.AcaoCriado
+		resultado.Code = ""
+		return resultado
+	}
+
+	criado, err := s.itens.AddItem(ctx, novo)
+	if err != nil {
+		return falha(err)
+	}
+	resultado.Acao = importacao.AcaoCriado
+	resultado.ItemID = criado.ID
+	resultado.Code = criado.Code
+	return resultado
+}
+
+func (s *importacaoService) IniciarImportacao(ctx context.Context, linhas []importacao.Linha, opcoes importacao.Opcoes) (importacao.Job, error) {
+	ctx, span := tracer.Start(ctx, "importacaoService.IniciarImportacao", trace.WithAttributes(attribute.Int("linhas", len(linhas))))
+	defer span.End()
+
+	id, err := novoIDJob()
+	if err != nil {
+		return importacao.Job{}, err
+	}
+
+	agora := time.Now()
+	job := importacao.Job{
+		ID:           id,
+		Status:       importacao.StatusPendente,
+		Total:        len(linhas),
+		CriadoPor:    opcoes.UsuarioID,
+		CriadoEm:     agora,
+		AtualizadoEm: agora,
+	}
+	if err := s.jobs.Create(ctx, job); err != nil {
+		return importacao.Job{}, fmt.Errorf("Erro ao registrar importação: %w", err)
+	}
+
+	// O job sobrevive ao fim da requisição (mantendo request ID e trace nos
+	// logs), mas é interrompido no encerramento do servidor
+	ctxJob, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	pararJunto := context.AfterFunc(s.encerramento, cancel)
+
+	s.execucoes.Add(1)
+	go func() {
+		defer s.execucoes.Done()
+		defer pararJunto()
+		defer cancel()
+		s.executarJob(ctxJob, job, linhas, opcoes)
+	}()
+
+	s.logger.InfoContext(ctx, "importação agendada", "job_id", job.ID, "linhas", job.Total)
+	return job, nil
+}
+
+func (s *importacaoService) executarJob(ctx context.Context, job importacao.Job, linhas []importacao.Linha, opcoes importacao.Opcoes) {
+	job.Status = importacao.StatusProcessando
+	s.salvarJob(ctx, &job)
+
+	relatorio := importacao.Relatorio{DryRun: opcoes.DryRun}
+	for i, linha := range linhas {
+		if ctx.Err() != nil {
+			// Sem ctx cancelado para conseguir gravar o estado final
+			job.Status = importacao.StatusFalhou
+			job.Erro = "importação interrompida pelo encerramento do servidor; reenvie as linhas não processadas"
+			job.Relatorio = &relatorio
+			s.salvarJob(context.WithoutCancel(ctx), &job)
+			return
+		}
+
+		relatorio.Registrar(s.importarLinha(ctx, linha, opcoes))
+		job.Processadas = i + 1
+		if job.Processadas%intervaloProgresso == 0 {
+			s.salvarJob(ctx, &job)
+		}
+	}
+
+	job.Status = importacao.StatusConcluido
+	job.Relatorio = &relatorio
+	s.salvarJob(ctx, &job)
+
+	s.logger.InfoContext(ctx, "importação concluída",
+		"job_id", job.ID,
+		"linhas", relatorio.Total,
+		"criados", relatorio.Criados,
+		"atualizados", relatorio.Atualizados,
+		"erros", relatorio.Erros)
+}
+
+func (s *importacaoService) salvarJob(ctx context.Context, job *importacao.Job) {
+	job.AtualizadoEm = time.Now()
+	if err := s.jobs.Update(ctx, *job); err != nil {
+		s.logger.WarnContext(ctx, "falha ao salvar andamento da importação", "job_id", job.ID, "erro", err)
+	}
+}
+
+func (s *importacaoService) GetJob(ctx context.Context, id string) (*importacao.Job, error) {
+	ctx, span := tracer.Start(ctx, "importacaoService.GetJob")
+	defer span.End()
+
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, importacao.ErrJobNaoEncontrado
+	}
+	return s.jobs.GetByID(ctx, id)
+}
+
+// Encerrar interrompe as importações em andamento e espera cada uma gravar
+// seu estado final. Chamado pelo main no shutdown.
+func (s *importacaoService) Encerrar() {
+	s.encerrar()
+	s.execucoes.Wait()
+}
+
+func novoIDJob() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("erro ao gerar id da importação: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
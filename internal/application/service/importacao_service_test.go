@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"desafio-itens-app/internal/domain/importacao"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestImportar_CriaItensERelataErrosPorLinha(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImportacaoService(d.itens, d.itemService(), d.jobs, d.logger)
+
+	linhas := []importacao.Linha{
+		{Numero: 2, Nome: "Mouse", Preco: dinheiro.Novo(9990, dinheiro.BRL), Estoque: 10},
+		{Numero: 3, Nome: "", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 1},
+		{Numero: 4, Erro: `preço inválido: "abc"`},
+	}
+
+	d.itens.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	d.itens.On("AddItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Nome == "Mouse" && *i.CreatedBy == 7
+	})).Return(entity.Item{ID: 1, Code: "MO12345678"}, nil)
+
+	// ACT
+	relatorio, err := service.Importar(context.Background(), linhas, importacao.Opcoes{UsuarioID: 7})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 3, relatorio.Total)
+	assert.Equal(t, 1, relatorio.Criados)
+	assert.Equal(t, 2, relatorio.Erros)
+	assert.Equal(t, importacao.ResultadoLinha{Linha: 2, Acao: importacao.AcaoCriado, ItemID: 1, Code: "MO12345678"}, relatorio.Linhas[0])
+	assert.Equal(t, "Nome é obrigatório", relatorio.Linhas[1].Erro)
+	assert.Equal(t, `preço inválido: "abc"`, relatorio.Linhas[2].Erro)
+}
+
+func TestImportar_DryRunNaoGrava(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImportacaoService(d.itens, d.itemService(), d.jobs, d.logger)
+	criador := 7
+
+	linhas := []importacao.Linha{
+		{Numero: 2, Nome: "Mouse", Preco: dinheiro.Novo(9990, dinheiro.BRL), Estoque: 10},
+		{Numero: 3, Code: "TE00000001", Nome: "Teclado", Preco: dinheiro.Novo(15000, dinheiro.BRL), Estoque: 0},
+	}
+	d.itens.On("GetItemByCode", mock.Anything, "TE00000001").
+		Return(&entity.Item{ID: 5, Code: "TE00000001", Nome: "Teclado", Preco: dinheiro.Novo(10000, dinheiro.BRL), Estoque: 3, CreatedBy: &criador}, nil)
+
+	// ACT
+	relatorio, err := service.Importar(context.Background(), linhas, importacao.Opcoes{DryRun: true, Upsert: true, UsuarioID: 7})
+
+	// ASSERT - sem AddItem/UpdateItem: o mock falharia se fossem chamados
+	assert.NoError(t, err)
+	assert.True(t, relatorio.DryRun)
+	assert.Equal(t, 1, relatorio.Criados)
+	assert.Equal(t, 1, relatorio.Atualizados)
+	assert.Equal(t, 5, relatorio.Linhas[1].ItemID)
+}
+
+func TestImportar_UpsertAtualizaItemExistente(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImportacaoService(d.itens, d.itemService(), d.jobs, d.logger)
+	criador := 7
+
+	teclado := &entity.Item{ID: 5, Code: "TE00000001", Nome: "Teclado", Preco: dinheiro.Novo(10000, dinheiro.BRL), Estoque: 3, CreatedBy: &criador}
+	d.itens.On("GetItemByCode", mock.Anything, "TE00000001").Return(teclado, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{5}).Return(nil).Once()
+	d.itens.On("GetItem", mock.Anything, 5).Return(teclado, nil).Once() // Relido depois da trava
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 5 && i.Preco.Centavos == 15000 && i.Estoque == 0 && i.Status == entity.StatusInativo && *i.UpdateBy == 7
+	})).Return(nil)
+
+	linhas := []importacao.Linha{{Numero: 2, Code: "TE00000001", Nome: "Teclado", Preco: dinheiro.Novo(15000, dinheiro.BRL), Estoque: 0}}
+
+	// ACT
+	relatorio, err := service.Importar(context.Background(), linhas, importacao.Opcoes{Upsert: true, UsuarioID: 7})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, relatorio.Atualizados)
+	assert.Equal(t, importacao.AcaoAtualizado, relatorio.Linhas[0].Acao)
+}
+
+func TestImportar_UpsertRespeitaDonoDoItem(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImportacaoService(d.itens, d.itemService(), d.jobs, d.logger)
+	outroUsuario := 99
+
+	d.itens.On("GetItemByCode", mock.Anything, "TE00000001").
+		Return(&entity.Item{ID: 5, Code: "TE00000001", CreatedBy: &outroUsuario}, nil)
+
+	linhas := []importacao.Linha{{Numero: 2, Code: "TE00000001", Nome: "Teclado", Preco: dinheiro.Novo(15000, dinheiro.BRL), Estoque: 1}}
+
+	// ACT
+	relatorio, err := service.Importar(context.Background(), linhas, importacao.Opcoes{Upsert: true, UsuarioID: 7})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, relatorio.Erros)
+	assert.Equal(t, "Você só pode editar itens que criou", relatorio.Linhas[0].Erro)
+}
+
+func TestIniciarImportacao_ProcessaEmSegundoPlano(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewImportacaoService(d.itens, d.itemService(), d.jobs, d.logger)
+
+	d.jobs.On("Create", mock.Anything, mock.MatchedBy(func(j importacao.Job) bool {
+		return j.Status == importacao.StatusPendente && j.Total == 1 && j.CriadoPor == 7
+	})).Return(nil)
+	d.jobs.On("Update", mock.Anything, mock.MatchedBy(func(j importacao.Job) bool {
+		return j.Status == importacao.StatusProcessando
+	})).Return(nil).Once()
+	d.jobs.On("Update", mock.Anything, mock.MatchedBy(func(j importacao.Job) bool {
+		return j.Status == importacao.StatusConcluido && j.Processadas == 1 && j.Relatorio.Criados == 1
+	})).Return(nil).Once()
+	d.itens.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	d.itens.On("AddItem", mock.Anything, mock.Anything).Return(entity.Item{ID: 1}, nil)
+
+	linhas := []importacao.Linha{{Numero: 2, Nome: "Mouse", Preco: dinheiro.Novo(9990, dinheiro.BRL), Estoque: 10}}
+
+	// ACT
+	job, err := service.IniciarImportacao(context.Background(), linhas, importacao.Opcoes{UsuarioID: 7})
+	service.execucoes.Wait() // Espera o job terminar
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, job.ID, 32)
+	assert.Equal(t, importacao.StatusPendente, job.Status)
+}
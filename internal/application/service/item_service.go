@@ -1,51 +1,99 @@
 package service
 
 import (
+	"context"
+	"desafio-itens-app/internal/application/ports/metrics"
+	"desafio-itens-app/internal/application/ports/notificacoes"
 	"desafio-itens-app/internal/application/ports/repositories"
 	entity "desafio-itens-app/internal/domain/item" // Importa entidades do domínio
 	"desafio-itens-app/utils"
 	"errors" // Para criar erros simples
 	"fmt"    // Para formatar erros
-	"math"   // Para cálculos (Ceil)
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog" // Logs estruturados
+	"math"     // Para cálculos (Ceil)
+	"slices"
+	"strings"
+	"time"
 )
 
 type itemService struct { // Struct que implementa as regras de negócio
-	repo repositories.ItemRepository // Dependência: interface do repositório
+	repo        repositories.ItemRepository // Dependência: interface do repositório
+	logger      *slog.Logger
+	metricas    metrics.Metricas
+	notificador notificacoes.Notificador    // Alertas de estoque baixo
+	transacoes  repositories.Transacionador // Alertas só saem depois do commit
 }
 
-func NewItemService(repo repositories.ItemRepository) *itemService { // Factory: cria nova instância do service
+func NewItemService(repo repositories.ItemRepository, logger *slog.Logger, metricas metrics.Metricas) *itemService { // Factory: cria nova instância do service
 	return &itemService{ // Injeta dependência do repositório
-		repo: repo,
+		repo:        repo,
+		logger:      logger,
+		metricas:    metricas,
+		notificador: notificacoes.Nop{},
+		transacoes:  semTransacao{},
 	}
 }
 
-func (s *itemService) AddItem(item entity.Item) (entity.Item, error) {
+// UsarNotificador troca o destino dos alertas de estoque (padrão: nenhum)
+func (s *itemService) UsarNotificador(n notificacoes.Notificador) {
+	s.notificador = n
+}
 
-	if item.Estoque == 0 { // Regra: sem estoque = inativo
-		item.Status = entity.StatusInativo
-	} else {
-		item.Status = entity.StatusAtivo // Com estoque = ativo
-	}
+// UsarTransacoes liga os alertas ao commit da transação em que a alteração
+// roda (padrão: sem transação, o alerta sai na hora)
+func (s *itemService) UsarTransacoes(t repositories.Transacionador) {
+	s.transacoes = t
+}
+
+func (s *itemService) AddItem(ctx context.Context, item entity.Item) (entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "itemService.AddItem")
+	defer span.End()
+
+	item.AtualizarStatus() // Regra: sem estoque = inativo
+	alerta, cruzou := item.AtualizarAlertaEstoque(time.Now())
+	item.Categoria = strings.TrimSpace(item.Categoria)
+	item.Tags = entity.NormalizarTags(item.Tags)
 
 	if err := item.IsValid(); err != nil {
 		return entity.Item{}, err
 	}
 
-	code, err := s.generateUniqueCode(item.Nome) // Gera código único
+	var code string
+	var err error
+	if item.EhVariante() {
+		code, err = s.codigoVarianteUnico(ctx, item.Code) // Base derivada do produto
+	} else {
+		code, err = s.generateUniqueCode(ctx, item.Nome) // Gera código único
+	}
 	if err != nil {
 		return entity.Item{}, err
 	}
 	item.Code = code // Atribui código gerado
 
-	itemCriado, err := s.repo.AddItem(item) // Persiste no banco
+	itemCriado, err := s.repo.AddItem(ctx, item) // Persiste no banco
 	if err != nil {
 		return entity.Item{}, err
 	}
 
+	s.logger.InfoContext(ctx, "item criado",
+		"item_id", itemCriado.ID,
+		"code", itemCriado.Code,
+		"created_by", item.CreatedBy)
+
+	if cruzou { // Já nasceu no ponto de reposição
+		alerta.ItemID, alerta.Code = itemCriado.ID, itemCriado.Code
+		s.notificarEstoque(ctx, alerta)
+	}
+
 	return itemCriado, nil // Retorna item com ID do banco
 }
 
-func (s *itemService) GetItem(id int) (*entity.Item, error) {
+func (s *itemService) GetItem(ctx context.Context, id int) (*entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "itemService.GetItem", trace.WithAttributes(attribute.Int("item.id", id)))
+	defer span.End()
+
 	if id == 0 {
 		return nil, fmt.Errorf("O id não pode ser 0.")
 	}
@@ -54,7 +102,7 @@ func (s *itemService) GetItem(id int) (*entity.Item, error) {
 		return nil, fmt.Errorf("O id não pode ser negativo.")
 	}
 
-	item, err := s.repo.GetItem(id) // Busca no repositório
+	item, err := s.repo.GetItem(ctx, id) // Busca no repositório
 	if err != nil {
 		return nil, fmt.Errorf("Erro ao buscar o item: %w", err)
 	}
@@ -62,8 +110,11 @@ func (s *itemService) GetItem(id int) (*entity.Item, error) {
 	return item, nil // Retorna item encontrado
 }
 
-func (s *itemService) GetItens() ([]entity.Item, error) {
-	itens, err := s.repo.GetItens() // Busca todos os itens
+func (s *itemService) GetItens(ctx context.Context) ([]entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "itemService.GetItens")
+	defer span.End()
+
+	itens, err := s.repo.GetItens(ctx) // Busca todos os itens
 	if err != nil {
 		return nil, fmt.Errorf("Erro ao buscar os itens: %w", err)
 	}
@@ -71,7 +122,10 @@ func (s *itemService) GetItens() ([]entity.Item, error) {
 	return itens, nil // Retorna lista completa
 }
 
-func (s *itemService) GetItensPaginados(page, pageSize int) ([]entity.Item, int, error) {
+func (s *itemService) GetItensPaginados(ctx context.Context, page, pageSize int) ([]entity.Item, int, error) {
+	ctx, span := tracer.Start(ctx, "itemService.GetItensPaginados")
+	defer span.End()
+
 	// 🛡️ VALIDAÇÕES dos parâmetros
 	if page < 1 {
 		page = 1 // Página mínima é 1
@@ -87,7 +141,7 @@ func (s *itemService) GetItensPaginados(page, pageSize int) ([]entity.Item, int,
 	offset := (page - 1) * pageSize
 
 	// 📞 CHAMAR o Repository paginado
-	itens, totalItens, err := s.repo.GetItensPaginados(offset, pageSize)
+	itens, totalItens, err := s.repo.GetItensPaginados(ctx, offset, pageSize)
 	if err != nil {
 		return nil, 0, fmt.Errorf("Erro ao buscar itens paginados: %w", err)
 	}
@@ -95,7 +149,10 @@ func (s *itemService) GetItensPaginados(page, pageSize int) ([]entity.Item, int,
 	return itens, totalItens, nil
 }
 
-func (s *itemService) GetItensFiltradosPaginados(status *entity.Status, page, pageSize int) ([]entity.Item, int, error) {
+func (s *itemService) GetItensFiltradosPaginados(ctx context.Context, status *entity.Status, page, pageSize int) ([]entity.Item, int, error) {
+	ctx, span := tracer.Start(ctx, "itemService.GetItensFiltradosPaginados", trace.WithAttributes(attribute.Int("page", page), attribute.Int("page_size", pageSize)))
+	defer span.End()
+
 	if page < 1 {
 		page = 1
 	}
@@ -110,10 +167,41 @@ func (s *itemService) GetItensFiltradosPaginados(status *entity.Status, page, pa
 	offset := (page - 1) * pageSize
 
 	// Chamar Repository com filtros + paginação
-	return s.repo.GetItensFiltradosPaginados(status, offset, pageSize)
+	return s.repo.GetItensFiltradosPaginados(ctx, status, offset, pageSize)
 }
 
-func (s *itemService) generateUniqueCode(nome string) (string, error) {
+func (s *itemService) BuscarItens(ctx context.Context, termo string, status *entity.Status, page, pageSize int) ([]entity.Item, int, error) {
+	ctx, span := tracer.Start(ctx, "itemService.BuscarItens", trace.WithAttributes(attribute.String("busca", termo), attribute.Int("page", page), attribute.Int("page_size", pageSize)))
+	defer span.End()
+
+	termo = strings.TrimSpace(termo)
+	if termo == "" {
+		return s.GetItensFiltradosPaginados(ctx, status, page, pageSize)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	offset := (page - 1) * pageSize
+
+	itens, total, err := s.repo.BuscarItens(ctx, termo, status, offset, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Erro ao buscar itens: %w", err)
+	}
+
+	return itens, total, nil
+}
+
+func (s *itemService) generateUniqueCode(ctx context.Context, nome string) (string, error) {
+	ctx, span := tracer.Start(ctx, "itemService.generateUniqueCode")
+	defer span.End()
 
 	maxTentativas := 100 // Limite máximo de tentativas
 
@@ -123,7 +211,7 @@ func (s *itemService) generateUniqueCode(nome string) (string, error) {
 			return "", err
 		}
 
-		exists, err := s.repo.CodeExists(code)
+		exists, err := s.repo.CodeExists(ctx, code)
 		if err != nil {
 			return "", fmt.Errorf("erro ao verificar código: %w", err)
 		}
@@ -133,10 +221,34 @@ func (s *itemService) generateUniqueCode(nome string) (string, error) {
 		}
 	}
 
+	s.logger.ErrorContext(ctx, "códigos esgotados para o nome", "nome", nome, "tentativas", maxTentativas)
 	return "", errors.New("não foi possível gerar código único")
 }
 
-func (s *itemService) GetItensFiltrados(status *entity.Status, limit int) (itens []entity.Item, totalItens int, totalPages int, err error) {
+// codigoVarianteUnico usa o código derivado do produto e, se já existir
+// (valores com as mesmas 3 primeiras letras), acrescenta -2, -3...
+func (s *itemService) codigoVarianteUnico(ctx context.Context, base string) (string, error) {
+	for i := 1; i <= 9; i++ {
+		code := base
+		if i > 1 {
+			code = fmt.Sprintf("%s-%d", base, i)
+		}
+
+		exists, err := s.repo.CodeExists(ctx, code)
+		if err != nil {
+			return "", fmt.Errorf("erro ao verificar código: %w", err)
+		}
+		if !exists {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("não foi possível gerar código único a partir de %s", base)
+}
+
+func (s *itemService) GetItensFiltrados(ctx context.Context, status *entity.Status, limit int) (itens []entity.Item, totalItens int, totalPages int, err error) {
+	ctx, span := tracer.Start(ctx, "itemService.GetItensFiltrados")
+	defer span.End()
+
 	if limit <= 0 { // Normaliza limit mínimo
 		limit = 10
 	}
@@ -145,13 +257,13 @@ func (s *itemService) GetItensFiltrados(status *entity.Status, limit int) (itens
 		limit = 20
 	}
 
-	totalItens, err = s.repo.CountItens(status) // Conta total para paginação
+	totalItens, err = s.repo.CountItens(ctx, status) // Conta total para paginação
 	if err != nil {
 		err = fmt.Errorf("erro ao contar itens: %w", err)
 		return // Named return
 	}
 
-	itens, err = s.repo.GetItensFiltrados(status, limit) // ❌ BUG: falta OFFSET para paginação
+	itens, err = s.repo.GetItensFiltrados(ctx, status, limit) // ❌ BUG: falta OFFSET para paginação
 	if err != nil {
 		err = fmt.Errorf("erro ao buscar itens: %w", err)
 		return
@@ -161,45 +273,279 @@ func (s *itemService) GetItensFiltrados(status *entity.Status, limit int) (itens
 	return                                                            // Retorna tudo via named return
 }
 
-func (s *itemService) UpdateItem(item entity.Item) error {
-	// ✅ PASSO 1: Validações de negócio (item já vem pronto)
-	if item.Preco <= 0 {
-		return fmt.Errorf("Preço deve ser maior que zero")
+// UpdateItem grava o item inteiro, estoque incluído: quem monta o item a
+// partir de uma leitura precisa ter travado a linha antes (ver AlterarItem).
+// O produto e as variantes que seguem o preço dele vão na mesma transação.
+func (s *itemService) UpdateItem(ctx context.Context, item entity.Item) error {
+	ctx, span := tracer.Start(ctx, "itemService.UpdateItem", trace.WithAttributes(attribute.Int("item.id", item.ID)))
+	defer span.End()
+
+	return s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		return s.atualizar(ctx, &item)
+	})
+}
+
+// AlterarItem é o ler-alterar-gravar de um item: trava a linha, relê o item,
+// aplica alterar e grava com as regras do UpdateItem, tudo numa transação.
+// Uma baixa de estoque que chega no meio espera o commit em vez de ser
+// sobrescrita pelo estoque lido antes dela. Devolve o item como ficou.
+func (s *itemService) AlterarItem(ctx context.Context, id int, alterar func(*entity.Item) error) (entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "itemService.AlterarItem", trace.WithAttributes(attribute.Int("item.id", id)))
+	defer span.End()
+
+	var alterado entity.Item
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		// 🔒 Trava antes de ler: o estoque lido é o que vai ser gravado
+		if err := s.repo.TravarItens(ctx, []int{id}); err != nil {
+			return err
+		}
+		item, err := s.repo.GetItem(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := alterar(item); err != nil {
+			return err
+		}
+		if err := s.atualizar(ctx, item); err != nil {
+			return err
+		}
+		alterado = *item
+		return nil
+	})
+	if err != nil {
+		return entity.Item{}, err
+	}
+	return alterado, nil
+}
+
+// atualizar aplica as regras do UpdateItem em item e grava
+func (s *itemService) atualizar(ctx context.Context, item *entity.Item) error {
+	// ✅ PASSO 1: Validações de negócio (item já vem pronto). Saem marcadas
+	// com ErrItemInvalido: quem grava em lote separa do erro de banco
+	if !item.Preco.Positivo() {
+		return entity.Invalido(fmt.Errorf("Preço deve ser maior que zero"))
 	}
 
 	if item.Estoque < 0 {
-		return fmt.Errorf("Estoque não pode ser negativo")
+		return entity.Invalido(fmt.Errorf("Estoque não pode ser negativo"))
 	}
 
 	if item.ID <= 0 {
-		return fmt.Errorf("O id deve ser maior que zero")
+		return entity.Invalido(fmt.Errorf("O id deve ser maior que zero"))
 	}
 
-	// ✅ PASSO 2: Recalcular status baseado no estoque
-	if item.Estoque == 0 {
-		item.Status = entity.StatusInativo
-	} else {
-		item.Status = entity.StatusAtivo
+	item.Categoria = strings.TrimSpace(item.Categoria)
+	item.Tags = entity.NormalizarTags(item.Tags)
+	if err := item.ValidarClassificacao(); err != nil {
+		return entity.Invalido(err)
+	}
+	if err := item.ValidarReposicao(); err != nil {
+		return entity.Invalido(err)
+	}
+	if err := item.ValidarEAN(); err != nil {
+		return entity.Invalido(err)
 	}
 
-	// ✅ PASSO 3: Salvar no banco
-	if err := s.repo.UpdateItem(item); err != nil {
+	// ✅ PASSO 2: Variante sem preço próprio segue o preço do produto
+	if item.EhVariante() && !item.PrecoProprio {
+		produto, err := s.repo.GetItem(ctx, *item.ProdutoID)
+		if errors.Is(err, entity.ErrItemNaoEncontrado) {
+			return entity.Invalido(fmt.Errorf("Produto %d da variante não encontrado", *item.ProdutoID))
+		}
+		if err != nil {
+			return fmt.Errorf("Erro ao buscar o produto da variante: %w", err)
+		}
+		item.Preco = produto.Preco
+	}
+
+	// ✅ PASSO 3: Recalcular status e alerta baseados no estoque
+	item.AtualizarStatus()
+	alerta, cruzou := item.AtualizarAlertaEstoque(time.Now())
+
+	// ✅ PASSO 4: Salvar no banco
+	if err := s.repo.UpdateItem(ctx, *item); err != nil {
 		return fmt.Errorf("Erro ao atualizar o item: %w", err)
 	}
 
+	s.logger.InfoContext(ctx, "item atualizado",
+		"item_id", item.ID,
+		"status", item.Status,
+		"updated_by", item.UpdateBy)
+
+	if cruzou {
+		s.notificarEstoque(ctx, alerta)
+	}
+
+	// ✅ PASSO 5: Produto leva o preço para as variantes que o seguem
+	if item.TemEixos() {
+		return s.propagarPreco(ctx, *item)
+	}
+
+	return nil
+}
+
+// propagarPreco grava o preço do produto nas variantes sem preço próprio.
+// A primeira leitura só descobre quais mudam; elas são travadas e relidas,
+// para a gravação não desfazer uma baixa de estoque que chegou no meio.
+func (s *itemService) propagarPreco(ctx context.Context, produto entity.Item) error {
+	segue := func(variante entity.Item) bool {
+		return !variante.PrecoProprio && variante.Preco != produto.Preco
+	}
+
+	encontradas, err := s.repo.ListarVariantes(ctx, []int{produto.ID})
+	if err != nil {
+		return fmt.Errorf("Erro ao buscar variantes: %w", err)
+	}
+	var ids []int
+	for _, variante := range encontradas {
+		if segue(variante) {
+			ids = append(ids, variante.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	slices.Sort(ids) // Ordem crescente, como em toda trava de itens
+
+	if err := s.repo.TravarItens(ctx, ids); err != nil {
+		return fmt.Errorf("Erro ao travar variantes: %w", err)
+	}
+	variantes, err := s.repo.ListarVariantes(ctx, []int{produto.ID})
+	if err != nil {
+		return fmt.Errorf("Erro ao buscar variantes: %w", err)
+	}
+
+	for _, variante := range variantes {
+		if !segue(variante) || !slices.Contains(ids, variante.ID) {
+			continue
+		}
+		variante.Preco = produto.Preco
+		variante.UpdateBy = produto.UpdateBy
+		if err := s.repo.UpdateItem(ctx, variante); err != nil {
+			return fmt.Errorf("Erro ao atualizar a variante %d: %w", variante.ID, err)
+		}
+	}
 	return nil
 }
 
-func (s *itemService) DeleteItem(id int) error {
+func (s *itemService) DeleteItem(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "itemService.DeleteItem", trace.WithAttributes(attribute.Int("item.id", id)))
+	defer span.End()
+
 	if id <= 0 { // Valida ID positivo
 		return fmt.Errorf("ID inválido para a exclusão %d", id)
 	}
 
-	err := s.repo.DeleteItem(id) // Deleta do banco
+	// 🛡️ Produto com variantes não sai: elas ficariam sem pai
+	variantes, err := s.repo.ListarVariantes(ctx, []int{id})
+	if err != nil {
+		return fmt.Errorf("Erro ao deletar item %w", err)
+	}
+	if len(variantes) > 0 {
+		return fmt.Errorf("%w: remova antes as %d variantes do item %d", entity.ErrProdutoInvalido, len(variantes), id)
+	}
+
+	err = s.repo.DeleteItem(ctx, id) // Deleta do banco
 
 	if err != nil { // ✅ CORRIGIDO: agora retorna erro
 		return fmt.Errorf("Erro ao deletar item %w", err)
 	}
 
+	s.logger.InfoContext(ctx, "item deletado", "item_id", id)
+
 	return nil // Sucesso
 }
+
+// tamanhoLoteExportacao: itens lidos do banco por vez na exportação
+const tamanhoLoteExportacao = 500
+
+// ExportarItens percorre todos os itens do filtro em lotes e entrega um a um
+// para fn, sem carregar o catálogo inteiro na memória. Para no primeiro
+// erro de fn (ex.: cliente desconectou).
+func (s *itemService) ExportarItens(ctx context.Context, status *entity.Status, termo string, fn func(entity.Item) error) error {
+	ctx, span := tracer.Start(ctx, "itemService.ExportarItens", trace.WithAttributes(attribute.String("busca", termo)))
+	defer span.End()
+
+	termo = strings.TrimSpace(termo)
+	aposID := 0
+	total := 0
+	for {
+		lote, err := s.repo.ListarLote(ctx, status, termo, aposID, tamanhoLoteExportacao)
+		if err != nil {
+			return fmt.Errorf("Erro ao exportar itens: %w", err)
+		}
+
+		for _, item := range lote {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		total += len(lote)
+
+		if len(lote) < tamanhoLoteExportacao {
+			break
+		}
+		aposID = lote[len(lote)-1].ID
+	}
+
+	span.SetAttributes(attribute.Int("itens", total))
+	s.logger.InfoContext(ctx, "itens exportados", "total", total)
+	return nil
+}
+
+// ListarEstoqueBaixo é o relatório de reposição: itens no ponto de
+// reposição ou abaixo
+func (s *itemService) ListarEstoqueBaixo(ctx context.Context) ([]entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "itemService.ListarEstoqueBaixo")
+	defer span.End()
+
+	itens, err := s.repo.ListarEstoqueBaixo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar itens com estoque baixo: %w", err)
+	}
+	return itens, nil
+}
+
+// notificarEstoque não falha a alteração: o alerta sai depois do commit
+// (uma transação desfeita não avisa nada) e fica no log se o canal estiver
+// fora
+func (s *itemService) notificarEstoque(ctx context.Context, alerta entity.AlertaEstoque) {
+	s.transacoes.AposCommit(ctx, func() {
+		s.logger.InfoContext(ctx, "estoque cruzou o ponto de reposição",
+			"item_id", alerta.ItemID,
+			"tipo", alerta.Tipo,
+			"estoque", alerta.Estoque,
+			"ponto_reposicao", alerta.PontoReposicao)
+
+		if err := s.notificador.AlertaEstoque(ctx, alerta); err != nil {
+			s.logger.WarnContext(ctx, "falha ao enviar alerta de estoque", "item_id", alerta.ItemID, "erro", err)
+		}
+	})
+}
+
+// semTransacao é o padrão do itemService: sem transação, o que espera o
+// commit roda na hora
+type semTransacao struct{}
+
+func (semTransacao) EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (semTransacao) AposCommit(_ context.Context, fn func()) {
+	fn()
+}
+
+// PublicarResumoEstoque recalcula itens por status e valor do estoque e
+// envia para as métricas. Chamado periodicamente pelo main.
+func (s *itemService) PublicarResumoEstoque(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "itemService.PublicarResumoEstoque")
+	defer span.End()
+
+	resumo, err := s.repo.ResumoEstoque(ctx)
+	if err != nil {
+		return fmt.Errorf("Erro ao calcular resumo do estoque: %w", err)
+	}
+
+	s.metricas.ResumoEstoqueAtualizado(resumo)
+	return nil
+}
@@ -1,38 +1,43 @@
 package service
 
 import (
+	"context"
+	"desafio-itens-app/internal/application/ports/metrics"
 	"desafio-itens-app/internal/application/service/mocks"
+	"desafio-itens-app/internal/domain/dinheiro"
 	entity "desafio-itens-app/internal/domain/item"
+	"errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"log/slog"
 	"testing"
 )
 
 func TestAddItem_WhenSuccess_ReturnsCreatedItem(t *testing.T) {
 	// ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	validItem := entity.Item{
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 	}
 
 	expectedItem := entity.Item{
 		ID:      1,
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 		Status:  entity.StatusAtivo,
 		Code:    "PR12345678",
 	}
 
-	mockRepo.On("CodeExists", mock.AnythingOfType("string")).Return(false, nil)
-	mockRepo.On("AddItem", mock.Anything).Return(expectedItem, nil)
+	mockRepo.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("AddItem", mock.Anything, mock.Anything).Return(expectedItem, nil)
 
 	// ACT
-	result, err := service.AddItem(validItem)
+	result, err := service.AddItem(context.Background(), validItem)
 
 	// ASSERT
 	assert.NoError(t, err)
@@ -50,15 +55,15 @@ func TestAddItem_WhenValidationFails_ReturnsError(t *testing.T) {
 
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	invalidItem := entity.Item{
 		Nome:  "",
-		Preco: -1,
+		Preco: dinheiro.Novo(-100, dinheiro.BRL),
 	}
 
 	//ACT
-	result, err := service.AddItem(invalidItem)
+	result, err := service.AddItem(context.Background(), invalidItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -69,33 +74,33 @@ func TestAddItem_WhenValidationFails_ReturnsError(t *testing.T) {
 func TestAddItem_WhenEstoquePositivo_SetsStatusAtivo(t *testing.T) {
 	// ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	itemComEstoque := entity.Item{
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 5,
 		Status:  entity.StatusAtivo,
 	}
 
-	mockRepo.On("CodeExists", mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
 
-	mockRepo.On("AddItem", mock.MatchedBy(func(item entity.Item) bool {
+	mockRepo.On("AddItem", mock.Anything, mock.MatchedBy(func(item entity.Item) bool {
 		return item.Nome == "Produto Válido" &&
-			item.Preco == 100.0 &&
+			item.Preco.Centavos == 10000 &&
 			item.Estoque == 5 &&
 			item.Status == entity.StatusAtivo &&
 			item.Code != ""
 	})).Return(entity.Item{
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 5,
 		Status:  entity.StatusAtivo,
 		Code:    "PR71619235",
 	}, nil)
 
 	// ACT
-	result, err := service.AddItem(itemComEstoque)
+	result, err := service.AddItem(context.Background(), itemComEstoque)
 
 	// ASSERT
 	assert.NoError(t, err)
@@ -107,33 +112,33 @@ func TestAddItem_WhenEstoquePositivo_SetsStatusAtivo(t *testing.T) {
 func TestAddItem_WhenEstoqueZero_SetsStatusInativo(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	itemSemEstoque := entity.Item{
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 0,
 		Status:  entity.StatusInativo,
 	}
 
-	mockRepo.On("CodeExists", mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
 
-	mockRepo.On("AddItem", mock.MatchedBy(func(item entity.Item) bool {
+	mockRepo.On("AddItem", mock.Anything, mock.MatchedBy(func(item entity.Item) bool {
 		return item.Nome == "Produto Válido" &&
-			item.Preco == 100.0 &&
+			item.Preco.Centavos == 10000 &&
 			item.Estoque == 0 &&
 			item.Status == entity.StatusInativo &&
 			item.Code != ""
 	})).Return(entity.Item{
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 0,
 		Status:  entity.StatusInativo,
 		Code:    "PR71619235",
 	}, nil)
 
 	//ACT
-	result, err := service.AddItem(itemSemEstoque)
+	result, err := service.AddItem(context.Background(), itemSemEstoque)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -147,20 +152,20 @@ func TestAddItem_WhenRepositoryFails_ReturnsError(t *testing.T) {
 
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	validItem := entity.Item{
 		Nome:    "Produto Válido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 		Status:  entity.StatusAtivo,
 	}
 
-	mockRepo.On("CodeExists", mock.AnythingOfType("string")).Return(false, nil)
-	mockRepo.On("AddItem", mock.Anything).Return(entity.Item{}, assert.AnError)
+	mockRepo.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("AddItem", mock.Anything, mock.Anything).Return(entity.Item{}, assert.AnError)
 
 	//ACT
-	result, err := service.AddItem(validItem)
+	result, err := service.AddItem(context.Background(), validItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -172,19 +177,19 @@ func TestAddItem_WhenRepositoryFails_ReturnsError(t *testing.T) {
 func TestAddItem_WhenCodeExistsCheckFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	validItem := entity.Item{
 		Nome:    "Produto Valido",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 		Status:  entity.StatusAtivo,
 	}
 
-	mockRepo.On("CodeExists", mock.AnythingOfType("string")).Return(false, assert.AnError)
+	mockRepo.On("CodeExists", mock.Anything, mock.AnythingOfType("string")).Return(false, assert.AnError)
 
 	//ACT
-	result, err := service.AddItem(validItem)
+	result, err := service.AddItem(context.Background(), validItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -196,10 +201,10 @@ func TestAddItem_WhenCodeExistsCheckFails_ReturnsError(t *testing.T) {
 func TestGetItem_WhenIdIsZero_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetItem(0)
+	result, err := service.GetItem(context.Background(), 0)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -211,10 +216,10 @@ func TestGetItem_WhenIdIsZero_ReturnsError(t *testing.T) {
 func TestGetItem_WhenIdIsNegative_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetItem(-1)
+	result, err := service.GetItem(context.Background(), -1)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -226,12 +231,12 @@ func TestGetItem_WhenIdIsNegative_ReturnsError(t *testing.T) {
 func TestGetItem_WhenRepositoryFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
-	mockRepo.On("GetItem", 1).Return((*entity.Item)(nil), assert.AnError)
+	mockRepo.On("GetItem", mock.Anything, 1).Return((*entity.Item)(nil), assert.AnError)
 
 	//ACT
-	result, err := service.GetItem(1)
+	result, err := service.GetItem(context.Background(), 1)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -243,21 +248,21 @@ func TestGetItem_WhenRepositoryFails_ReturnsError(t *testing.T) {
 func TestGetItem_WhenSuccess_ReturnsItem(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	expectedItem := &entity.Item{
 		ID:      1,
 		Nome:    "Produto Teste",
-		Preco:   50,
+		Preco:   dinheiro.Novo(5000, dinheiro.BRL),
 		Estoque: 10,
 		Status:  entity.StatusAtivo,
 		Code:    "PR12345678",
 	}
 
-	mockRepo.On("GetItem", 1).Return(expectedItem, nil)
+	mockRepo.On("GetItem", mock.Anything, 1).Return(expectedItem, nil)
 
 	//ACT
-	result, err := service.GetItem(1)
+	result, err := service.GetItem(context.Background(), 1)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -268,12 +273,12 @@ func TestGetItem_WhenSuccess_ReturnsItem(t *testing.T) {
 func TestGetItens_WhenRepositoryFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
-	mockRepo.On("GetItens").Return(nil, assert.AnError)
+	mockRepo.On("GetItens", mock.Anything, mock.Anything).Return(nil, assert.AnError)
 
 	//ACT
-	result, err := service.GetItens()
+	result, err := service.GetItens(context.Background())
 
 	//ASSERT
 	assert.Error(t, err)
@@ -285,17 +290,17 @@ func TestGetItens_WhenRepositoryFails_ReturnsError(t *testing.T) {
 func TestGetItens_WhenSuccess_ReturnsItems(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	expectedItems := []entity.Item{
-		{ID: 1, Nome: "Item 1", Preco: 10.0, Estoque: 5, Status: entity.StatusAtivo},
-		{ID: 2, Nome: "Item 2", Preco: 20.0, Estoque: 0, Status: entity.StatusInativo},
+		{ID: 1, Nome: "Item 1", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 5, Status: entity.StatusAtivo},
+		{ID: 2, Nome: "Item 2", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 0, Status: entity.StatusInativo},
 	}
 
-	mockRepo.On("GetItens").Return(expectedItems, nil)
+	mockRepo.On("GetItens", mock.Anything, mock.Anything).Return(expectedItems, nil)
 
 	//ACT
-	result, err := service.GetItens()
+	result, err := service.GetItens(context.Background())
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -306,17 +311,17 @@ func TestGetItens_WhenSuccess_ReturnsItems(t *testing.T) {
 func TestUpdateItem_WhenPrecoInvalido_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	invalidItem := entity.Item{
 		ID:      1,
 		Nome:    "Produto Teste",
-		Preco:   0,
+		Preco:   dinheiro.Novo(0, dinheiro.BRL),
 		Estoque: 10,
 	}
 
 	//ACT
-	err := service.UpdateItem(invalidItem)
+	err := service.UpdateItem(context.Background(), invalidItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -324,22 +329,44 @@ func TestUpdateItem_WhenPrecoInvalido_ReturnsError(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestUpdateItem_WhenEANComDigitoErrado_ReturnsError(t *testing.T) {
+	//ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	ean := "7891000315508" // Verificador certo é 7
+	invalidItem := entity.Item{
+		ID:      1,
+		Nome:    "Produto Teste",
+		EAN:     &ean,
+		Preco:   dinheiro.Novo(1000, dinheiro.BRL),
+		Estoque: 10,
+	}
+
+	//ACT
+	err := service.UpdateItem(context.Background(), invalidItem)
+
+	//ASSERT
+	assert.ErrorIs(t, err, entity.ErrEANInvalido)
+	mockRepo.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
+
 func TestUpdateItem_WhenEstoqueNegativo_ReturnsError(t *testing.T) {
 
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	invalidItem := entity.Item{
 		ID:      2,
 		Nome:    "Produto Teste",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: -5,
 	}
 
 	//ACT
 
-	err := service.UpdateItem(invalidItem)
+	err := service.UpdateItem(context.Background(), invalidItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -350,22 +377,22 @@ func TestUpdateItem_WhenEstoqueNegativo_ReturnsError(t *testing.T) {
 func TestUpdateItem_WhenEstoqueZero_SetsStatusInativo(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	item := entity.Item{
 		ID:      1,
 		Nome:    "Produto Teste",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 0,
 		Status:  entity.StatusAtivo,
 	}
 
-	mockRepo.On("UpdateItem", mock.MatchedBy(func(item entity.Item) bool {
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(item entity.Item) bool {
 		return item.Status == entity.StatusInativo
 	})).Return(nil)
 
 	//ACT
-	err := service.UpdateItem(item)
+	err := service.UpdateItem(context.Background(), item)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -375,22 +402,22 @@ func TestUpdateItem_WhenEstoqueZero_SetsStatusInativo(t *testing.T) {
 func TestUpdateItem_WhenEstoquePositivo_SetsStatusAtivo(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	item := entity.Item{
 		ID:      1,
 		Nome:    "Produto Teste",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 		Status:  entity.StatusInativo,
 	}
 
-	mockRepo.On("UpdateItem", mock.MatchedBy(func(item entity.Item) bool {
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(item entity.Item) bool {
 		return item.Status == entity.StatusAtivo
 	})).Return(nil)
 
 	//ACT
-	err := service.UpdateItem(item)
+	err := service.UpdateItem(context.Background(), item)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -400,19 +427,19 @@ func TestUpdateItem_WhenEstoquePositivo_SetsStatusAtivo(t *testing.T) {
 func TestUpdateItem_WhenRepositoryFails_ReturnError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	validItem := entity.Item{
 		ID:      1,
 		Nome:    "Produto Teste",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 	}
 
-	mockRepo.On("UpdateItem", mock.Anything).Return(assert.AnError)
+	mockRepo.On("UpdateItem", mock.Anything, mock.Anything).Return(assert.AnError)
 
 	//ACT
-	err := service.UpdateItem(validItem)
+	err := service.UpdateItem(context.Background(), validItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -423,25 +450,25 @@ func TestUpdateItem_WhenRepositoryFails_ReturnError(t *testing.T) {
 func TestUpdateItem_WhenSucess_UpdateItens(t *testing.T) {
 	// ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	validItem := entity.Item{
 		ID:      1,
 		Nome:    "Produto Teste",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 15,
 	}
 
-	mockRepo.On("UpdateItem", mock.MatchedBy(func(item entity.Item) bool {
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(item entity.Item) bool {
 		return item.ID == 1 &&
 			item.Nome == "Produto Teste" &&
-			item.Preco == 100.0 &&
+			item.Preco.Centavos == 10000 &&
 			item.Estoque == 15 &&
 			item.Status == entity.StatusAtivo
 	})).Return(nil)
 
 	// ACT
-	err := service.UpdateItem(validItem)
+	err := service.UpdateItem(context.Background(), validItem)
 
 	// ASSERT
 	assert.NoError(t, err)
@@ -451,17 +478,17 @@ func TestUpdateItem_WhenSucess_UpdateItens(t *testing.T) {
 func TestUpdateItem_WhenIdIsNegative_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	invalidItem := entity.Item{
 		ID:      0,
 		Nome:    "Produto Teste",
-		Preco:   100.0,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 	}
 
 	//ACT
-	err := service.UpdateItem(invalidItem)
+	err := service.UpdateItem(context.Background(), invalidItem)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -472,10 +499,10 @@ func TestUpdateItem_WhenIdIsNegative_ReturnsError(t *testing.T) {
 func TestDeleteItem_WhenIdIsNegative_ReturnError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	err := service.DeleteItem(-1)
+	err := service.DeleteItem(context.Background(), -1)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -486,12 +513,13 @@ func TestDeleteItem_WhenIdIsNegative_ReturnError(t *testing.T) {
 func TestDeleteItem_WhenRepositoryFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
-	mockRepo.On("DeleteItem", 1).Return(assert.AnError)
+	mockRepo.On("ListarVariantes", mock.Anything, []int{1}).Return(nil, nil)
+	mockRepo.On("DeleteItem", mock.Anything, 1).Return(assert.AnError)
 
 	//ACT
-	err := service.DeleteItem(1)
+	err := service.DeleteItem(context.Background(), 1)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -502,12 +530,13 @@ func TestDeleteItem_WhenRepositoryFails_ReturnsError(t *testing.T) {
 func TestDeleteItem_WhenSucess_DeleteItem(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
-	mockRepo.On("DeleteItem", 1).Return(nil)
+	mockRepo.On("ListarVariantes", mock.Anything, []int{1}).Return(nil, nil)
+	mockRepo.On("DeleteItem", mock.Anything, 1).Return(nil)
 
 	//ACT
-	err := service.DeleteItem(1)
+	err := service.DeleteItem(context.Background(), 1)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -516,17 +545,17 @@ func TestDeleteItem_WhenSucess_DeleteItem(t *testing.T) {
 func TestGetItensPaginados_WhenSuccess_ReturnsItems(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	expectedItens := []entity.Item{
-		{ID: 1, Nome: "Item 1", Preco: 10, Estoque: 5},
-		{ID: 2, Nome: "Item 2", Preco: 20, Estoque: 10},
+		{ID: 1, Nome: "Item 1", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 5},
+		{ID: 2, Nome: "Item 2", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 10},
 	}
 
-	mockRepo.On("GetItensPaginados", 0, 10).Return(expectedItens, 2, nil)
+	mockRepo.On("GetItensPaginados", mock.Anything, 0, 10).Return(expectedItens, 2, nil)
 
 	//ACT
-	result, totalItens, err := service.GetItensPaginados(1, 10)
+	result, totalItens, err := service.GetItensPaginados(context.Background(), 1, 10)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -538,12 +567,12 @@ func TestGetItensPaginados_WhenSuccess_ReturnsItems(t *testing.T) {
 func TestGetItensPaginados_WhenRepositoryFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
-	mockRepo.On("GetItensPaginados", 0, 10).Return(nil, 0, assert.AnError)
+	mockRepo.On("GetItensPaginados", mock.Anything, 0, 10).Return(nil, 0, assert.AnError)
 
 	//ACT
-	result, TotalItens, err := service.GetItensPaginados(1, 10)
+	result, TotalItens, err := service.GetItensPaginados(context.Background(), 1, 10)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -556,12 +585,12 @@ func TestGetItensPaginados_WhenRepositoryFails_ReturnsError(t *testing.T) {
 func TestGetItensPaginados_WhenInvalidParams_NormalizesValues(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
-	mockRepo.On("GetItensPaginados", 0, 10).Return([]entity.Item{}, 0, nil)
+	mockRepo.On("GetItensPaginados", mock.Anything, 0, 10).Return([]entity.Item{}, 0, nil)
 
 	//ACT
-	_, _, err := service.GetItensPaginados(0, 0)
+	_, _, err := service.GetItensPaginados(context.Background(), 0, 0)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -571,17 +600,17 @@ func TestGetItensPaginados_WhenInvalidParams_NormalizesValues(t *testing.T) {
 func TestGetItensFiltradosPaginados_WhenSuccess_ReturnsItems(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	status := entity.StatusAtivo
 	expectedItens := []entity.Item{
 		{ID: 1, Nome: "Item 1", Status: entity.StatusAtivo},
 	}
 
-	mockRepo.On("GetItensFiltradosPaginados", &status, 0, 10).Return(expectedItens, 1, nil)
+	mockRepo.On("GetItensFiltradosPaginados", mock.Anything, &status, 0, 10).Return(expectedItens, 1, nil)
 
 	//ACT
-	result, totalItens, err := service.GetItensFiltradosPaginados(&status, 1, 10)
+	result, totalItens, err := service.GetItensFiltradosPaginados(context.Background(), &status, 1, 10)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -593,13 +622,13 @@ func TestGetItensFiltradosPaginados_WhenSuccess_ReturnsItems(t *testing.T) {
 func TestGetItensFiltradosPaginados_WhenRepositoryFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	status := entity.StatusAtivo
-	mockRepo.On("GetItensFiltradosPaginados", &status, 0, 10).Return(nil, 0, assert.AnError)
+	mockRepo.On("GetItensFiltradosPaginados", mock.Anything, &status, 0, 10).Return(nil, 0, assert.AnError)
 
 	//ACT
-	result, totalItens, err := service.GetItensFiltradosPaginados(&status, 1, 10)
+	result, totalItens, err := service.GetItensFiltradosPaginados(context.Background(), &status, 1, 10)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -611,18 +640,18 @@ func TestGetItensFiltradosPaginados_WhenRepositoryFails_ReturnsError(t *testing.
 func TestGetItensFiltrados_WhenSuccess_ReturnsItems(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	status := entity.StatusAtivo
 	expectedItems := []entity.Item{
 		{ID: 1, Nome: "Item 1", Status: entity.StatusAtivo},
 	}
 
-	mockRepo.On("CountItens", &status).Return(1, nil)
-	mockRepo.On("GetItensFiltrados", &status, 10).Return(expectedItems, nil)
+	mockRepo.On("CountItens", mock.Anything, &status).Return(1, nil)
+	mockRepo.On("GetItensFiltrados", mock.Anything, &status, 10).Return(expectedItems, nil)
 
 	//ACT
-	result, totalItens, totalPages, err := service.GetItensFiltrados(&status, 10)
+	result, totalItens, totalPages, err := service.GetItensFiltrados(context.Background(), &status, 10)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -634,13 +663,13 @@ func TestGetItensFiltrados_WhenSuccess_ReturnsItems(t *testing.T) {
 func TestGetItensFiltrados_WhenCountFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	status := entity.StatusAtivo
-	mockRepo.On("CountItens", &status).Return(0, assert.AnError)
+	mockRepo.On("CountItens", mock.Anything, &status).Return(0, assert.AnError)
 
 	//ACT
-	result, totalItens, totalPages, err := service.GetItensFiltrados(&status, 10)
+	result, totalItens, totalPages, err := service.GetItensFiltrados(context.Background(), &status, 10)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -654,14 +683,14 @@ func TestGetItensFiltrados_WhenCountFails_ReturnsError(t *testing.T) {
 func TestGetItensFiltrados_WhenGetFiltradosFails_ReturnsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewItemRepository(t)
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	status := entity.StatusAtivo
-	mockRepo.On("CountItens", &status).Return(5, nil)
-	mockRepo.On("GetItensFiltrados", &status, 10).Return(nil, assert.AnError)
+	mockRepo.On("CountItens", mock.Anything, &status).Return(5, nil)
+	mockRepo.On("GetItensFiltrados", mock.Anything, &status, 10).Return(nil, assert.AnError)
 
 	//ACT
-	result, totalItens, totalPages, err := service.GetItensFiltrados(&status, 10)
+	result, totalItens, totalPages, err := service.GetItensFiltrados(context.Background(), &status, 10)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -671,3 +700,365 @@ func TestGetItensFiltrados_WhenGetFiltradosFails_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "erro ao buscar itens")
 	mockRepo.AssertExpectations(t)
 }
+
+func TestBuscarItens_WhenSuccess_ReturnsItems(t *testing.T) {
+	//ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	expectedItens := []entity.Item{
+		{ID: 1, Nome: "Mouse Gamer", Status: entity.StatusAtivo},
+	}
+
+	mockRepo.On("BuscarItens", mock.Anything, "mouse", (*entity.Status)(nil), 10, 10).Return(expectedItens, 11, nil)
+
+	//ACT
+	result, totalItens, err := service.BuscarItens(context.Background(), "  mouse ", nil, 2, 10)
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, expectedItens, result)
+	assert.Equal(t, 11, totalItens)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBuscarItens_WhenTermoVazio_UsesFiltradosPaginados(t *testing.T) {
+	//ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	mockRepo.On("GetItensFiltradosPaginados", mock.Anything, (*entity.Status)(nil), 0, 10).Return([]entity.Item{}, 0, nil)
+
+	//ACT
+	_, _, err := service.BuscarItens(context.Background(), "   ", nil, 1, 10)
+
+	//ASSERT
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBuscarItens_WhenRepositoryFails_ReturnsError(t *testing.T) {
+	//ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	mockRepo.On("BuscarItens", mock.Anything, "mouse", (*entity.Status)(nil), 0, 100).Return(nil, 0, assert.AnError)
+
+	//ACT
+	result, totalItens, err := service.BuscarItens(context.Background(), "mouse", nil, 0, 500)
+
+	//ASSERT
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 0, totalItens)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPublicarResumoEstoque_WhenSuccess_EnviaParaMetricas(t *testing.T) {
+	//ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	mockMetricas := mocks.NewMetricas(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), mockMetricas)
+
+	resumo := entity.ResumoEstoque{
+		PorStatus:     map[entity.Status]int{entity.StatusAtivo: 3, entity.StatusInativo: 1},
+		ValorPorMoeda: map[dinheiro.Moeda]dinheiro.Dinheiro{dinheiro.BRL: dinheiro.Novo(150000, dinheiro.BRL)},
+	}
+	mockRepo.On("ResumoEstoque", mock.Anything).Return(resumo, nil)
+	mockMetricas.On("ResumoEstoqueAtualizado", resumo).Return()
+
+	//ACT
+	err := service.PublicarResumoEstoque(context.Background())
+
+	//ASSERT
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockMetricas.AssertExpectations(t)
+}
+
+func TestPublicarResumoEstoque_WhenRepositoryFails_ReturnsError(t *testing.T) {
+	//ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	mockMetricas := mocks.NewMetricas(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), mockMetricas)
+
+	mockRepo.On("ResumoEstoque", mock.Anything).Return(entity.ResumoEstoque{}, assert.AnError)
+
+	//ACT
+	err := service.PublicarResumoEstoque(context.Background())
+
+	//ASSERT
+	assert.Error(t, err)
+	mockMetricas.AssertNotCalled(t, "ResumoEstoqueAtualizado", mock.Anything)
+}
+
+func TestExportarItens_PercorreEmLotes(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	loteCheio := make([]entity.Item, tamanhoLoteExportacao)
+	for i := range loteCheio {
+		loteCheio[i] = entity.Item{ID: i + 1}
+	}
+	ultimoLote := []entity.Item{{ID: tamanhoLoteExportacao + 1}}
+
+	status := entity.StatusAtivo
+	mockRepo.On("ListarLote", mock.Anything, &status, "mouse", 0, tamanhoLoteExportacao).Return(loteCheio, nil).Once()
+	mockRepo.On("ListarLote", mock.Anything, &status, "mouse", tamanhoLoteExportacao, tamanhoLoteExportacao).Return(ultimoLote, nil).Once()
+
+	// ACT
+	var exportados []int
+	err := service.ExportarItens(context.Background(), &status, " mouse ", func(item entity.Item) error {
+		exportados = append(exportados, item.ID)
+		return nil
+	})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, exportados, tamanhoLoteExportacao+1)
+	assert.Equal(t, tamanhoLoteExportacao+1, exportados[len(exportados)-1])
+}
+
+func TestExportarItens_ParaNoErroDoEscritor(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	mockRepo.On("ListarLote", mock.Anything, (*entity.Status)(nil), "", 0, tamanhoLoteExportacao).
+		Return([]entity.Item{{ID: 1}, {ID: 2}}, nil).Once()
+	errDesconectou := errors.New("cliente desconectou")
+
+	// ACT
+	chamadas := 0
+	err := service.ExportarItens(context.Background(), nil, "", func(entity.Item) error {
+		chamadas++
+		return errDesconectou
+	})
+
+	// ASSERT
+	assert.ErrorIs(t, err, errDesconectou)
+	assert.Equal(t, 1, chamadas)
+}
+
+func TestUpdateItem_WhenEstoqueCruzaPontoReposicao_NotificaUmaVez(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	mockNotificador := mocks.NewNotificador(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	service.UsarNotificador(mockNotificador)
+
+	item := entity.Item{
+		ID:                  1,
+		Nome:                "Produto Teste",
+		Preco:               dinheiro.Novo(10000, dinheiro.BRL),
+		Estoque:             3,
+		PontoReposicao:      5,
+		QuantidadeReposicao: 50,
+	}
+
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(item entity.Item) bool {
+		return item.EstoqueEmAlerta
+	})).Return(nil).Once()
+	mockNotificador.On("AlertaEstoque", mock.Anything, mock.MatchedBy(func(a entity.AlertaEstoque) bool {
+		return a.Tipo == entity.AlertaEstoqueBaixo && a.ItemID == 1 && a.QuantidadeReposicao == 50
+	})).Return(nil).Once()
+
+	//ACT
+	err := service.UpdateItem(context.Background(), item)
+
+	//ASSERT
+	assert.NoError(t, err)
+}
+
+func TestUpdateItem_WhenEmTransacao_NotificaSoDepoisDoCommit(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	mockNotificador := mocks.NewNotificador(t)
+	mockTransacoes := mocks.NewTransacionador(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	service.UsarNotificador(mockNotificador)
+	service.UsarTransacoes(mockTransacoes)
+
+	item := entity.Item{
+		ID:             1,
+		Nome:           "Produto Teste",
+		Preco:          dinheiro.Novo(10000, dinheiro.BRL),
+		Estoque:        3,
+		PontoReposicao: 5,
+	}
+
+	var aposCommit []func()
+	mockTransacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao).Once()
+	mockRepo.On("UpdateItem", mock.Anything, mock.Anything).Return(nil).Once()
+	mockTransacoes.On("AposCommit", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		aposCommit = append(aposCommit, args.Get(1).(func()))
+	}).Once()
+	mockNotificador.On("AlertaEstoque", mock.Anything, mock.Anything).Return(nil).Once()
+
+	//ACT
+	err := service.UpdateItem(context.Background(), item)
+
+	//ASSERT: nada sai antes do commit
+	assert.NoError(t, err)
+	mockNotificador.AssertNotCalled(t, "AlertaEstoque", mock.Anything, mock.Anything)
+	assert.Len(t, aposCommit, 1)
+
+	aposCommit[0]() // commit
+	mockNotificador.AssertNumberOfCalls(t, "AlertaEstoque", 1)
+}
+
+func TestUpdateItem_WhenJaEstavaEmAlerta_NaoNotifica(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	mockNotificador := mocks.NewNotificador(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	service.UsarNotificador(mockNotificador)
+
+	item := entity.Item{
+		ID:              1,
+		Nome:            "Produto Teste",
+		Preco:           dinheiro.Novo(10000, dinheiro.BRL),
+		Estoque:         2,
+		PontoReposicao:  5,
+		EstoqueEmAlerta: true,
+	}
+
+	mockRepo.On("UpdateItem", mock.Anything, mock.Anything).Return(nil)
+
+	//ACT
+	err := service.UpdateItem(context.Background(), item)
+
+	//ASSERT
+	assert.NoError(t, err)
+	mockNotificador.AssertNotCalled(t, "AlertaEstoque", mock.Anything, mock.Anything)
+}
+
+func TestUpdateItem_WhenNotificadorFalha_NaoFalhaAlteracao(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	mockNotificador := mocks.NewNotificador(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	service.UsarNotificador(mockNotificador)
+
+	item := entity.Item{
+		ID:             1,
+		Nome:           "Produto Teste",
+		Preco:          dinheiro.Novo(10000, dinheiro.BRL),
+		Estoque:        0,
+		PontoReposicao: 1,
+	}
+
+	mockRepo.On("UpdateItem", mock.Anything, mock.Anything).Return(nil)
+	mockNotificador.On("AlertaEstoque", mock.Anything, mock.Anything).Return(errors.New("webhook fora do ar"))
+
+	//ACT
+	err := service.UpdateItem(context.Background(), item)
+
+	//ASSERT
+	assert.NoError(t, err)
+}
+
+func TestUpdateItem_WhenVarianteSemPrecoProprio_SegueOProduto(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	produtoID := 3
+	variante := entity.Item{ID: 10, ProdutoID: &produtoID, Preco: dinheiro.Novo(100, dinheiro.BRL), Estoque: 2}
+
+	mockRepo.On("GetItem", mock.Anything, 3).Return(&entity.Item{ID: 3, Preco: dinheiro.Novo(4990, dinheiro.BRL)}, nil)
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 10 && i.Preco == dinheiro.Novo(4990, dinheiro.BRL)
+	})).Return(nil).Once()
+
+	// ACT
+	err := service.UpdateItem(context.Background(), variante)
+
+	// ASSERT
+	assert.NoError(t, err)
+}
+
+func TestUpdateItem_WhenProdutoTrocaPreco_AtualizaVariantesQueSeguem(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	produtoID := 3
+	novoPreco := dinheiro.Novo(5990, dinheiro.BRL)
+	produto := entity.Item{ID: 3, Preco: novoPreco, Estoque: 0, Eixos: []entity.Eixo{{Nome: "Cor", Valores: []string{"Azul", "Preto"}}}}
+	segue := entity.Item{ID: 10, ProdutoID: &produtoID, Preco: dinheiro.Novo(4990, dinheiro.BRL), Estoque: 1}
+	propria := entity.Item{ID: 11, ProdutoID: &produtoID, Preco: dinheiro.Novo(3990, dinheiro.BRL), PrecoProprio: true, Estoque: 1}
+
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool { return i.ID == 3 })).Return(nil).Once()
+	mockRepo.On("ListarVariantes", mock.Anything, []int{3}).Return([]entity.Item{segue, propria}, nil).Twice() // Relidas depois da trava
+	mockRepo.On("TravarItens", mock.Anything, []int{10}).Return(nil).Once()
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 10 && i.Preco == novoPreco
+	})).Return(nil).Once()
+
+	// ACT
+	err := service.UpdateItem(context.Background(), produto)
+
+	// ASSERT
+	assert.NoError(t, err)
+	mockRepo.AssertNumberOfCalls(t, "UpdateItem", 2) // A variante com preço próprio fica como está
+}
+
+func TestDeleteItem_WhenProdutoTemVariantes_ReturnsError(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	mockRepo.On("ListarVariantes", mock.Anything, []int{3}).Return([]entity.Item{{ID: 10}}, nil)
+
+	// ACT
+	err := service.DeleteItem(context.Background(), 3)
+
+	// ASSERT
+	assert.ErrorIs(t, err, entity.ErrProdutoInvalido)
+	mockRepo.AssertNotCalled(t, "DeleteItem", mock.Anything, mock.Anything)
+}
+
+func TestAlterarItem_TravaEReleAntesDeAlterar(t *testing.T) {
+	// ARRANGE: a leitura de quem pediu a alteração tinha estoque 5; uma venda
+	// baixou para 2 antes da trava, e é esse 2 que precisa ser gravado
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+	relido := &entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(10000, dinheiro.BRL), Estoque: 2}
+
+	var ordem []string
+	mockRepo.On("TravarItens", mock.Anything, []int{1}).Run(func(mock.Arguments) { ordem = append(ordem, "TravarItens") }).Return(nil).Once()
+	mockRepo.On("GetItem", mock.Anything, 1).Run(func(mock.Arguments) { ordem = append(ordem, "GetItem") }).Return(relido, nil).Once()
+	mockRepo.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Nome == "Mouse sem fio" && i.Estoque == 2 && i.Status == entity.StatusAtivo
+	})).Return(nil).Once()
+
+	// ACT
+	alterado, err := service.AlterarItem(context.Background(), 1, func(item *entity.Item) error {
+		item.Nome = "Mouse sem fio"
+		return nil
+	})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TravarItens", "GetItem"}, ordem)
+	assert.Equal(t, 2, alterado.Estoque)
+	assert.Equal(t, entity.StatusAtivo, alterado.Status) // Status recalculado volta para quem chamou
+}
+
+func TestAlterarItem_WhenAlterarRecusa_NaoGrava(t *testing.T) {
+	// ARRANGE
+	mockRepo := mocks.NewItemRepository(t)
+	service := NewItemService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
+
+	mockRepo.On("TravarItens", mock.Anything, []int{1}).Return(nil).Once()
+	mockRepo.On("GetItem", mock.Anything, 1).Return(&entity.Item{ID: 1, Nome: "Mouse"}, nil).Once()
+
+	// ACT
+	_, err := service.AlterarItem(context.Background(), 1, func(item *entity.Item) error {
+		return entity.ErrEdicaoNaoPermitida
+	})
+
+	// ASSERT
+	assert.ErrorIs(t, err, entity.ErrEdicaoNaoPermitida)
+	mockRepo.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
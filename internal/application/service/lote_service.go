@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/lote"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"strings"
+)
+
+// errLoteDesfeito faz o Transacionador dar rollback quando algum item do
+// lote tudo_ou_nada falhou; não chega ao chamador
+var errLoteDesfeito = errors.New("lote desfeito")
+
+type loteService struct {
+	repoItens  repositories.ItemRepository
+	itens      services.ItemService // Cada item segue as mesmas regras do CRUD
+	transacoes repositories.Transacionador
+	logger     *slog.Logger
+}
+
+func NewLoteService(repoItens repositories.ItemRepository, itens services.ItemService, transacoes repositories.Transacionador, logger *slog.Logger) *loteService {
+	return &loteService{repoItens: repoItens, itens: itens, transacoes: transacoes, logger: logger}
+}
+
+// AtualizarItens aplica a mesma alteração parcial a todos os itens da
+// seleção, com a regra do PUT: só admin ou o criador alteram o item
+func (s *loteService) AtualizarItens(ctx context.Context, selecao lote.Selecao, alteracao lote.Alteracao, opcoes lote.Opcoes) (lote.Relatorio, error) {
+	ctx, span := tracer.Start(ctx, "loteService.AtualizarItens", trace.WithAttributes(attribute.String("modo", string(opcoes.Modo))))
+	defer span.End()
+
+	if err := alteracao.Validar(); err != nil {
+		return lote.Relatorio{}, err
+	}
+
+	return s.executar(ctx, "atualizacao", selecao, opcoes, lote.AcaoAtualizado, func(ctx context.Context, id int) error {
+		_, err := s.itens.AlterarItem(ctx, id, func(item *entity.Item) error {
+			if !item.PodeSerEditadoPor(opcoes.UsuarioID, opcoes.Admin) {
+				return entity.ErrEdicaoNaoPermitida
+			}
+			alteracao.AplicarEm(item)
+			item.UpdateBy = &opcoes.UsuarioID
+			return nil
+		})
+		return err
+	})
+}
+
+// RemoverItens apaga os itens da seleção (a rota é só de admin, como o
+// DELETE /itens/:id)
+func (s *loteService) RemoverItens(ctx context.Context, selecao lote.Selecao, opcoes lote.Opcoes) (lote.Relatorio, error) {
+	ctx, span := tracer.Start(ctx, "loteService.RemoverItens", trace.WithAttributes(attribute.String("modo", string(opcoes.Modo))))
+	defer span.End()
+
+	return s.executar(ctx, "remocao", selecao, opcoes, lote.AcaoRemovido, func(ctx context.Context, id int) error {
+		return s.itens.DeleteItem(ctx, id)
+	})
+}
+
+func (s *loteService) executar(ctx context.Context, operacao string, selecao lote.Selecao, opcoes lote.Opcoes, acao lote.Acao, aplicar func(ctx context.Context, id int) error) (lote.Relatorio, error) {
+	if !opcoes.Modo.Valido() {
+		return lote.Relatorio{}, fmt.Errorf("modo deve ser '%s' ou '%s'", lote.ModoTudoOuNada, lote.ModoMelhorEsforco)
+	}
+	if err := selecao.Validar(); err != nil {
+		return lote.Relatorio{}, err
+	}
+
+	ids, err := s.resolverIDs(ctx, selecao, opcoes)
+	if err != nil {
+		return lote.Relatorio{}, err
+	}
+
+	relatorio := lote.Relatorio{Modo: opcoes.Modo}
+	processar := func(ctx context.Context) error {
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			resultado := lote.ResultadoItem{ItemID: id, Acao: acao}
+			if err := aplicar(ctx, id); err != nil {
+				resultado.Acao = lote.AcaoErro
+				resultado.Erro = err.Error()
+			}
+			relatorio.Registrar(resultado)
+		}
+
+		if opcoes.Modo == lote.ModoTudoOuNada && relatorio.Erros > 0 {
+			return errLoteDesfeito
+		}
+		return nil
+	}
+
+	// 🔒 TUDO OU NADA: uma transação só; qualquer erro desfaz o lote inteiro
+	if opcoes.Modo == lote.ModoTudoOuNada {
+		err = s.transacoes.EmTransacao(ctx, processar)
+	} else {
+		err = processar(ctx)
+	}
+
+	switch {
+	case errors.Is(err, errLoteDesfeito):
+		relatorio.Desfazer()
+	case err != nil:
+		return lote.Relatorio{}, fmt.Errorf("Erro ao processar lote: %w", err)
+	default:
+		relatorio.Aplicado = relatorio.Sucessos > 0
+	}
+
+	s.logger.InfoContext(ctx, "lote de itens processado",
+		"operacao", operacao,
+		"modo", opcoes.Modo,
+		"total", relatorio.Total,
+		"sucessos", relatorio.Sucessos,
+		"erros", relatorio.Erros,
+		"aplicado", relatorio.Aplicado,
+		"usuario_id", opcoes.UsuarioID)
+
+	return relatorio, nil
+}
+
+// resolverIDs devolve os IDs do lote sem repetição. No filtro, quem não
+// é admin só pega os próprios itens (os dos outros falhariam de qualquer
+// jeito).
+func (s *loteService) resolverIDs(ctx context.Context, selecao lote.Selecao, opcoes lote.Opcoes) ([]int, error) {
+	if !selecao.PorFiltro() {
+		vistos := make(map[int]bool, len(selecao.IDs))
+		ids := make([]int, 0, len(selecao.IDs))
+		for _, id := range selecao.IDs {
+			if !vistos[id] {
+				vistos[id] = true
+				ids = append(ids, id)
+			}
+		}
+		return ids, nil
+	}
+
+	termo := strings.TrimSpace(selecao.Busca)
+	var ids []int
+	aposID := 0
+	for {
+		itens, err := s.repoItens.ListarLote(ctx, selecao.Status, termo, aposID, tamanhoLoteExportacao)
+		if err != nil {
+			return nil, fmt.Errorf("Erro ao selecionar itens do lote: %w", err)
+		}
+
+		for _, item := range itens {
+			if item.PodeSerEditadoPor(opcoes.UsuarioID, opcoes.Admin) {
+				ids = append(ids, item.ID)
+			}
+		}
+		if len(ids) > lote.MaxItens {
+			return nil, lote.ErrMuitosItens
+		}
+
+		if len(itens) < tamanhoLoteExportacao {
+			return ids, nil
+		}
+		aposID = itens[len(itens)-1].ID
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/lote"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestAtualizarItens_MelhorEsforcoRelataCadaItem(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewLoteService(d.itens, d.itemService(), d.transacoes, d.logger)
+	dono, outro := 7, 9
+	preco := dinheiro.Novo(5000, "") // Na moeda de cada item
+
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Return(nil)
+	d.itens.On("TravarItens", mock.Anything, []int{2}).Return(nil)
+	d.itens.On("TravarItens", mock.Anything, []int{3}).Return(errors.New("Item não encontrado"))
+	d.itens.On("GetItem", mock.Anything, 1).Return(&entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 2, CreatedBy: &dono}, nil)
+	d.itens.On("GetItem", mock.Anything, 2).Return(&entity.Item{ID: 2, Nome: "Teclado", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 2, CreatedBy: &outro}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 1 && i.Preco == dinheiro.Novo(5000, dinheiro.BRL) && *i.UpdateBy == dono
+	})).Return(nil).Once()
+
+	// ACT - sem transação no melhor esforço: o mock falharia se fosse usado
+	relatorio, err := service.AtualizarItens(context.Background(),
+		lote.Selecao{IDs: []int{1, 2, 3, 1}},
+		lote.Alteracao{Preco: &preco},
+		lote.Opcoes{Modo: lote.ModoMelhorEsforco, UsuarioID: dono})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.True(t, relatorio.Aplicado)
+	assert.Equal(t, 3, relatorio.Total)
+	assert.Equal(t, 1, relatorio.Sucessos)
+	assert.Equal(t, 2, relatorio.Erros)
+	assert.Equal(t, lote.ResultadoItem{ItemID: 1, Acao: lote.AcaoAtualizado}, relatorio.Itens[0])
+	assert.Equal(t, "Você só pode editar itens que criou", relatorio.Itens[1].Erro)
+	assert.Equal(t, "Item não encontrado", relatorio.Itens[2].Erro)
+}
+
+func TestAtualizarItens_TudoOuNadaDesfazQuandoAlgumFalha(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewLoteService(d.itens, d.itemService(), d.transacoes, d.logger)
+	estoque := 0
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Return(nil)
+	d.itens.On("TravarItens", mock.Anything, []int{2}).Return(errors.New("Item não encontrado"))
+	d.itens.On("GetItem", mock.Anything, 1).Return(&entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 2}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 1 && i.Status == entity.StatusInativo
+	})).Return(nil)
+
+	// ACT
+	relatorio, err := service.AtualizarItens(context.Background(),
+		lote.Selecao{IDs: []int{1, 2}},
+		lote.Alteracao{Estoque: &estoque},
+		lote.Opcoes{Modo: lote.ModoTudoOuNada, UsuarioID: 1, Admin: true})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.False(t, relatorio.Aplicado)
+	assert.Equal(t, 0, relatorio.Sucessos)
+	assert.Equal(t, 1, relatorio.Erros)
+	assert.Equal(t, lote.AcaoDesfeito, relatorio.Itens[0].Acao)
+	assert.Equal(t, lote.AcaoErro, relatorio.Itens[1].Acao)
+}
+
+func TestAtualizarItens_FiltroSoPegaItensDoUsuario(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewLoteService(d.itens, d.itemService(), d.transacoes, d.logger)
+	dono, outro := 7, 9
+	ativo := entity.StatusAtivo
+	descricao := "Promoção"
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.itens.On("ListarLote", mock.Anything, &ativo, "mouse", 0, tamanhoLoteExportacao).Return([]entity.Item{
+		{ID: 1, CreatedBy: &dono},
+		{ID: 2, CreatedBy: &outro},
+	}, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 1).Return(&entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(1000, dinheiro.BRL), Estoque: 2, CreatedBy: &dono}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 1 && i.Descricao == "Promoção"
+	})).Return(nil)
+
+	// ACT
+	relatorio, err := service.AtualizarItens(context.Background(),
+		lote.Selecao{Status: &ativo, Busca: " mouse "},
+		lote.Alteracao{Descricao: &descricao},
+		lote.Opcoes{Modo: lote.ModoTudoOuNada, UsuarioID: dono})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.True(t, relatorio.Aplicado)
+	assert.Equal(t, 1, relatorio.Total)
+	assert.Equal(t, 1, relatorio.Itens[0].ItemID)
+}
+
+func TestAtualizarItens_AlteracaoInvalida(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewLoteService(d.itens, d.itemService(), d.transacoes, d.logger)
+	preco := dinheiro.Novo(-100, dinheiro.BRL)
+
+	// ACT
+	_, err := service.AtualizarItens(context.Background(),
+		lote.Selecao{IDs: []int{1}},
+		lote.Alteracao{Preco: &preco},
+		lote.Opcoes{Modo: lote.ModoTudoOuNada})
+
+	// ASSERT
+	assert.EqualError(t, err, "Preço deve ser maior que zero")
+}
+
+func TestRemoverItens_MelhorEsforco(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewLoteService(d.itens, d.itemService(), d.transacoes, d.logger)
+
+	d.itens.On("ListarVariantes", mock.Anything, mock.Anything).Return(nil, nil)
+	d.itens.On("DeleteItem", mock.Anything, 1).Return(nil)
+	d.itens.On("DeleteItem", mock.Anything, 2).Return(errors.New("item com ID 2 não encontrado"))
+
+	// ACT
+	relatorio, err := service.RemoverItens(context.Background(),
+		lote.Selecao{IDs: []int{1, 2}},
+		lote.Opcoes{Modo: lote.ModoMelhorEsforco, UsuarioID: 1, Admin: true})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.True(t, relatorio.Aplicado)
+	assert.Equal(t, lote.AcaoRemovido, relatorio.Itens[0].Acao)
+	assert.Equal(t, lote.AcaoErro, relatorio.Itens[1].Acao)
+}
+
+func TestRemoverItens_ModoInvalido(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewLoteService(d.itens, d.itemService(), d.transacoes, d.logger)
+
+	// ACT
+	_, err := service.RemoverItens(context.Background(), lote.Selecao{IDs: []int{1}}, lote.Opcoes{Modo: "talvez"})
+
+	// ASSERT
+	assert.EqualError(t, err, "modo deve ser 'tudo_ou_nada' ou 'melhor_esforco'")
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BlobStore is an autogenerated mock type for the BlobStore type
+type BlobStore struct {
+	mock.Mock
+}
+
+// Abrir provides a mock function with given fields: ctx, chave
+func (_m *BlobStore) Abrir(ctx context.Context, chave string) (io.ReadCloser, string, error) {
+	ret := _m.Called(ctx, chave)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Abrir")
+	}
+
+	var r0 io.ReadCloser
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, string, error)); ok {
+		return rf(ctx, chave)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(ctx, chave)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = rf(ctx, chave)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, chave)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Remover provides a mock function with given fields: ctx, chave
+func (_m *BlobStore) Remover(ctx context.Context, chave string) error {
+	ret := _m.Called(ctx, chave)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remover")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, chave)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Salvar provides a mock function with given fields: ctx, chave, contentType, dados
+func (_m *BlobStore) Salvar(ctx context.Context, chave string, contentType string, dados []byte) error {
+	ret := _m.Called(ctx, chave, contentType, dados)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Salvar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, chave, contentType, dados)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBlobStore creates a new instance of BlobStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBlobStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BlobStore {
+	mock := &BlobStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	cambio "desafio-itens-app/internal/domain/cambio"
+
+	dinheiro "desafio-itens-app/internal/domain/dinheiro"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CambioRepository is an autogenerated mock type for the CambioRepository type
+type CambioRepository struct {
+	mock.Mock
+}
+
+// Listar provides a mock function with given fields: ctx
+func (_m *CambioRepository) Listar(ctx context.Context) ([]cambio.Taxa, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listar")
+	}
+
+	var r0 []cambio.Taxa
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]cambio.Taxa, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []cambio.Taxa); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]cambio.Taxa)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remover provides a mock function with given fields: ctx, de, para
+func (_m *CambioRepository) Remover(ctx context.Context, de dinheiro.Moeda, para dinheiro.Moeda) error {
+	ret := _m.Called(ctx, de, para)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remover")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dinheiro.Moeda, dinheiro.Moeda) error); ok {
+		r0 = rf(ctx, de, para)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Salvar provides a mock function with given fields: ctx, taxa
+func (_m *CambioRepository) Salvar(ctx context.Context, taxa cambio.Taxa) error {
+	ret := _m.Called(ctx, taxa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Salvar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, cambio.Taxa) error); ok {
+		r0 = rf(ctx, taxa)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCambioRepository creates a new instance of CambioRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCambioRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CambioRepository {
+	mock := &CambioRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	deposito "desafio-itens-app/internal/domain/deposito"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EstoqueRepository is an autogenerated mock type for the EstoqueRepository type
+type EstoqueRepository struct {
+	mock.Mock
+}
+
+// CriarDeposito provides a mock function with given fields: ctx, d
+func (_m *EstoqueRepository) CriarDeposito(ctx context.Context, d deposito.Deposito) (deposito.Deposito, error) {
+	ret := _m.Called(ctx, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CriarDeposito")
+	}
+
+	var r0 deposito.Deposito
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, deposito.Deposito) (deposito.Deposito, error)); ok {
+		return rf(ctx, d)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, deposito.Deposito) deposito.Deposito); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Get(0).(deposito.Deposito)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, deposito.Deposito) error); ok {
+		r1 = rf(ctx, d)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DefinirSaldo provides a mock function with given fields: ctx, itemID, depositoID, quantidade
+func (_m *EstoqueRepository) DefinirSaldo(ctx context.Context, itemID int, depositoID int, quantidade int) (int, error) {
+	ret := _m.Called(ctx, itemID, depositoID, quantidade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DefinirSaldo")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) (int, error)); ok {
+		return rf(ctx, itemID, depositoID, quantidade)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) int); ok {
+		r0 = rf(ctx, itemID, depositoID, quantidade)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, itemID, depositoID, quantidade)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListarDepositos provides a mock function with given fields: ctx
+func (_m *EstoqueRepository) ListarDepositos(ctx context.Context) ([]deposito.Deposito, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarDepositos")
+	}
+
+	var r0 []deposito.Deposito
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]deposito.Deposito, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []deposito.Deposito); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]deposito.Deposito)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Saldos provides a mock function with given fields: ctx, itemID
+func (_m *EstoqueRepository) Saldos(ctx context.Context, itemID int) ([]deposito.Saldo, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Saldos")
+	}
+
+	var r0 []deposito.Saldo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]deposito.Saldo, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []deposito.Saldo); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]deposito.Saldo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Transferencias provides a mock function with given fields: ctx, itemID, limite
+func (_m *EstoqueRepository) Transferencias(ctx context.Context, itemID int, limite int) ([]deposito.Transferencia, error) {
+	ret := _m.Called(ctx, itemID, limite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transferencias")
+	}
+
+	var r0 []deposito.Transferencia
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]deposito.Transferencia, error)); ok {
+		return rf(ctx, itemID, limite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []deposito.Transferencia); ok {
+		r0 = rf(ctx, itemID, limite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]deposito.Transferencia)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, itemID, limite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Transferir provides a mock function with given fields: ctx, t
+func (_m *EstoqueRepository) Transferir(ctx context.Context, t deposito.Transferencia) (deposito.Transferencia, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transferir")
+	}
+
+	var r0 deposito.Transferencia
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, deposito.Transferencia) (deposito.Transferencia, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, deposito.Transferencia) deposito.Transferencia); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Get(0).(deposito.Transferencia)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, deposito.Transferencia) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEstoqueRepository creates a new instance of EstoqueRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEstoqueRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EstoqueRepository {
+	mock := &EstoqueRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
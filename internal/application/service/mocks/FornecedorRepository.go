@@ -0,0 +1,251 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	fornecedor "desafio-itens-app/internal/domain/fornecedor"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FornecedorRepository is an autogenerated mock type for the FornecedorRepository type
+type FornecedorRepository struct {
+	mock.Mock
+}
+
+// Atualizar provides a mock function with given fields: ctx, f
+func (_m *FornecedorRepository) Atualizar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Atualizar")
+	}
+
+	var r0 fornecedor.Fornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, fornecedor.Fornecedor) (fornecedor.Fornecedor, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, fornecedor.Fornecedor) fornecedor.Fornecedor); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Get(0).(fornecedor.Fornecedor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, fornecedor.Fornecedor) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Criar provides a mock function with given fields: ctx, f
+func (_m *FornecedorRepository) Criar(ctx context.Context, f fornecedor.Fornecedor) (fornecedor.Fornecedor, error) {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Criar")
+	}
+
+	var r0 fornecedor.Fornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, fornecedor.Fornecedor) (fornecedor.Fornecedor, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, fornecedor.Fornecedor) fornecedor.Fornecedor); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Get(0).(fornecedor.Fornecedor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, fornecedor.Fornecedor) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Desvincular provides a mock function with given fields: ctx, itemID, fornecedorID
+func (_m *FornecedorRepository) Desvincular(ctx context.Context, itemID int, fornecedorID int) error {
+	ret := _m.Called(ctx, itemID, fornecedorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Desvincular")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, itemID, fornecedorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *FornecedorRepository) GetByID(ctx context.Context, id int) (*fornecedor.Fornecedor, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *fornecedor.Fornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*fornecedor.Fornecedor, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *fornecedor.Fornecedor); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fornecedor.Fornecedor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Listar provides a mock function with given fields: ctx
+func (_m *FornecedorRepository) Listar(ctx context.Context) ([]fornecedor.Fornecedor, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listar")
+	}
+
+	var r0 []fornecedor.Fornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]fornecedor.Fornecedor, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []fornecedor.Fornecedor); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]fornecedor.Fornecedor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vincular provides a mock function with given fields: ctx, v
+func (_m *FornecedorRepository) Vincular(ctx context.Context, v fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error) {
+	ret := _m.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vincular")
+	}
+
+	var r0 fornecedor.ItemFornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, fornecedor.ItemFornecedor) (fornecedor.ItemFornecedor, error)); ok {
+		return rf(ctx, v)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, fornecedor.ItemFornecedor) fornecedor.ItemFornecedor); ok {
+		r0 = rf(ctx, v)
+	} else {
+		r0 = ret.Get(0).(fornecedor.ItemFornecedor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, fornecedor.ItemFornecedor) error); ok {
+		r1 = rf(ctx, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vinculo provides a mock function with given fields: ctx, itemID, fornecedorID
+func (_m *FornecedorRepository) Vinculo(ctx context.Context, itemID int, fornecedorID int) (*fornecedor.ItemFornecedor, error) {
+	ret := _m.Called(ctx, itemID, fornecedorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vinculo")
+	}
+
+	var r0 *fornecedor.ItemFornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*fornecedor.ItemFornecedor, error)); ok {
+		return rf(ctx, itemID, fornecedorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *fornecedor.ItemFornecedor); ok {
+		r0 = rf(ctx, itemID, fornecedorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fornecedor.ItemFornecedor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, itemID, fornecedorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vinculos provides a mock function with given fields: ctx, itemID
+func (_m *FornecedorRepository) Vinculos(ctx context.Context, itemID int) ([]fornecedor.ItemFornecedor, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vinculos")
+	}
+
+	var r0 []fornecedor.ItemFornecedor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]fornecedor.ItemFornecedor, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []fornecedor.ItemFornecedor); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]fornecedor.ItemFornecedor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFornecedorRepository creates a new instance of FornecedorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFornecedorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FornecedorRepository {
+	mock := &FornecedorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	imagem "desafio-itens-app/internal/domain/imagem"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImagemRepository is an autogenerated mock type for the ImagemRepository type
+type ImagemRepository struct {
+	mock.Mock
+}
+
+// Atualizar provides a mock function with given fields: ctx, imagens
+func (_m *ImagemRepository) Atualizar(ctx context.Context, imagens []imagem.Imagem) error {
+	ret := _m.Called(ctx, imagens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Atualizar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []imagem.Imagem) error); ok {
+		r0 = rf(ctx, imagens)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Criar provides a mock function with given fields: ctx, imagens
+func (_m *ImagemRepository) Criar(ctx context.Context, imagens []imagem.Imagem) ([]imagem.Imagem, error) {
+	ret := _m.Called(ctx, imagens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Criar")
+	}
+
+	var r0 []imagem.Imagem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []imagem.Imagem) ([]imagem.Imagem, error)); ok {
+		return rf(ctx, imagens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []imagem.Imagem) []imagem.Imagem); ok {
+		r0 = rf(ctx, imagens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]imagem.Imagem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []imagem.Imagem) error); ok {
+		r1 = rf(ctx, imagens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Listar provides a mock function with given fields: ctx, itemID
+func (_m *ImagemRepository) Listar(ctx context.Context, itemID int) ([]imagem.Imagem, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listar")
+	}
+
+	var r0 []imagem.Imagem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]imagem.Imagem, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []imagem.Imagem); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]imagem.Imagem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PorItens provides a mock function with given fields: ctx, itemIDs
+func (_m *ImagemRepository) PorItens(ctx context.Context, itemIDs []int) (map[int][]imagem.Imagem, error) {
+	ret := _m.Called(ctx, itemIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PorItens")
+	}
+
+	var r0 map[int][]imagem.Imagem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int][]imagem.Imagem, error)); ok {
+		return rf(ctx, itemIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int][]imagem.Imagem); ok {
+		r0 = rf(ctx, itemIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]imagem.Imagem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, itemIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remover provides a mock function with given fields: ctx, id
+func (_m *ImagemRepository) Remover(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remover")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TravarItem provides a mock function with given fields: ctx, itemID
+func (_m *ImagemRepository) TravarItem(ctx context.Context, itemID int) error {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TravarItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewImagemRepository creates a new instance of ImagemRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImagemRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImagemRepository {
+	mock := &ImagemRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	item "desafio-itens-app/internal/domain/item"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type ItemRepository struct {
 	mock.Mock
 }
 
-// AddItem provides a mock function with given fields: _a0
-func (_m *ItemRepository) AddItem(_a0 item.Item) (item.Item, error) {
-	ret := _m.Called(_a0)
+// AddItem provides a mock function with given fields: ctx, _a1
+func (_m *ItemRepository) AddItem(ctx context.Context, _a1 item.Item) (item.Item, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddItem")
@@ -23,17 +24,17 @@ func (_m *ItemRepository) AddItem(_a0 item.Item) (item.Item, error) {
 
 	var r0 item.Item
 	var r1 error
-	if rf, ok := ret.Get(0).(func(item.Item) (item.Item, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, item.Item) (item.Item, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(item.Item) item.Item); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, item.Item) item.Item); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		r0 = ret.Get(0).(item.Item)
 	}
 
-	if rf, ok := ret.Get(1).(func(item.Item) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, item.Item) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +42,46 @@ func (_m *ItemRepository) AddItem(_a0 item.Item) (item.Item, error) {
 	return r0, r1
 }
 
-// CodeExists provides a mock function with given fields: code
-func (_m *ItemRepository) CodeExists(code string) (bool, error) {
-	ret := _m.Called(code)
+// BuscarItens provides a mock function with given fields: ctx, termo, status, offset, limit
+func (_m *ItemRepository) BuscarItens(ctx context.Context, termo string, status *item.Status, offset int, limit int) ([]item.Item, int, error) {
+	ret := _m.Called(ctx, termo, status, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuscarItens")
+	}
+
+	var r0 []item.Item
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *item.Status, int, int) ([]item.Item, int, error)); ok {
+		return rf(ctx, termo, status, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *item.Status, int, int) []item.Item); ok {
+		r0 = rf(ctx, termo, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]item.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *item.Status, int, int) int); ok {
+		r1 = rf(ctx, termo, status, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *item.Status, int, int) error); ok {
+		r2 = rf(ctx, termo, status, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CodeExists provides a mock function with given fields: ctx, code
+func (_m *ItemRepository) CodeExists(ctx context.Context, code string) (bool, error) {
+	ret := _m.Called(ctx, code)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CodeExists")
@@ -51,17 +89,17 @@ func (_m *ItemRepository) CodeExists(code string) (bool, error) {
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
-		return rf(code)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, code)
 	}
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(code)
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, code)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(code)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,9 +107,9 @@ func (_m *ItemRepository) CodeExists(code string) (bool, error) {
 	return r0, r1
 }
 
-// CountItens provides a mock function with given fields: status
-func (_m *ItemRepository) CountItens(status *item.Status) (int, error) {
-	ret := _m.Called(status)
+// CountItens provides a mock function with given fields: ctx, status
+func (_m *ItemRepository) CountItens(ctx context.Context, status *item.Status) (int, error) {
+	ret := _m.Called(ctx, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountItens")
@@ -79,17 +117,17 @@ func (_m *ItemRepository) CountItens(status *item.Status) (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*item.Status) (int, error)); ok {
-		return rf(status)
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status) (int, error)); ok {
+		return rf(ctx, status)
 	}
-	if rf, ok := ret.Get(0).(func(*item.Status) int); ok {
-		r0 = rf(status)
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status) int); ok {
+		r0 = rf(ctx, status)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(*item.Status) error); ok {
-		r1 = rf(status)
+	if rf, ok := ret.Get(1).(func(context.Context, *item.Status) error); ok {
+		r1 = rf(ctx, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -97,17 +135,17 @@ func (_m *ItemRepository) CountItens(status *item.Status) (int, error) {
 	return r0, r1
 }
 
-// DeleteItem provides a mock function with given fields: id
-func (_m *ItemRepository) DeleteItem(id int) error {
-	ret := _m.Called(id)
+// DeleteItem provides a mock function with given fields: ctx, id
+func (_m *ItemRepository) DeleteItem(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteItem")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -115,9 +153,9 @@ func (_m *ItemRepository) DeleteItem(id int) error {
 	return r0
 }
 
-// GetItem provides a mock function with given fields: id
-func (_m *ItemRepository) GetItem(id int) (*item.Item, error) {
-	ret := _m.Called(id)
+// GetItem provides a mock function with given fields: ctx, id
+func (_m *ItemRepository) GetItem(ctx context.Context, id int) (*item.Item, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetItem")
@@ -125,19 +163,19 @@ func (_m *ItemRepository) GetItem(id int) (*item.Item, error) {
 
 	var r0 *item.Item
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (*item.Item, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*item.Item, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) *item.Item); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *item.Item); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*item.Item)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -145,9 +183,39 @@ func (_m *ItemRepository) GetItem(id int) (*item.Item, error) {
 	return r0, r1
 }
 
-// GetItens provides a mock function with no fields
-func (_m *ItemRepository) GetItens() ([]item.Item, error) {
-	ret := _m.Called()
+// GetItemByCode provides a mock function with given fields: ctx, code
+func (_m *ItemRepository) GetItemByCode(ctx context.Context, code string) (*item.Item, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemByCode")
+	}
+
+	var r0 *item.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*item.Item, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *item.Item); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*item.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItens provides a mock function with given fields: ctx
+func (_m *ItemRepository) GetItens(ctx context.Context) ([]item.Item, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetItens")
@@ -155,19 +223,19 @@ func (_m *ItemRepository) GetItens() ([]item.Item, error) {
 
 	var r0 []item.Item
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]item.Item, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]item.Item, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []item.Item); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []item.Item); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]item.Item)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -175,9 +243,9 @@ func (_m *ItemRepository) GetItens() ([]item.Item, error) {
 	return r0, r1
 }
 
-// GetItensFiltrados provides a mock function with given fields: status, limit
-func (_m *ItemRepository) GetItensFiltrados(status *item.Status, limit int) ([]item.Item, error) {
-	ret := _m.Called(status, limit)
+// GetItensFiltrados provides a mock function with given fields: ctx, status, limit
+func (_m *ItemRepository) GetItensFiltrados(ctx context.Context, status *item.Status, limit int) ([]item.Item, error) {
+	ret := _m.Called(ctx, status, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetItensFiltrados")
@@ -185,19 +253,19 @@ func (_m *ItemRepository) GetItensFiltrados(status *item.Status, limit int) ([]i
 
 	var r0 []item.Item
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*item.Status, int) ([]item.Item, error)); ok {
-		return rf(status, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status, int) ([]item.Item, error)); ok {
+		return rf(ctx, status, limit)
 	}
-	if rf, ok := ret.Get(0).(func(*item.Status, int) []item.Item); ok {
-		r0 = rf(status, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status, int) []item.Item); ok {
+		r0 = rf(ctx, status, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]item.Item)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*item.Status, int) error); ok {
-		r1 = rf(status, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, *item.Status, int) error); ok {
+		r1 = rf(ctx, status, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -205,9 +273,9 @@ func (_m *ItemRepository) GetItensFiltrados(status *item.Status, limit int) ([]i
 	return r0, r1
 }
 
-// GetItensFiltradosPaginados provides a mock function with given fields: status, page, pageSize
-func (_m *ItemRepository) GetItensFiltradosPaginados(status *item.Status, page int, pageSize int) ([]item.Item, int, error) {
-	ret := _m.Called(status, page, pageSize)
+// GetItensFiltradosPaginados provides a mock function with given fields: ctx, status, page, pageSize
+func (_m *ItemRepository) GetItensFiltradosPaginados(ctx context.Context, status *item.Status, page int, pageSize int) ([]item.Item, int, error) {
+	ret := _m.Called(ctx, status, page, pageSize)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetItensFiltradosPaginados")
@@ -216,25 +284,25 @@ func (_m *ItemRepository) GetItensFiltradosPaginados(status *item.Status, page i
 	var r0 []item.Item
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(*item.Status, int, int) ([]item.Item, int, error)); ok {
-		return rf(status, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status, int, int) ([]item.Item, int, error)); ok {
+		return rf(ctx, status, page, pageSize)
 	}
-	if rf, ok := ret.Get(0).(func(*item.Status, int, int) []item.Item); ok {
-		r0 = rf(status, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status, int, int) []item.Item); ok {
+		r0 = rf(ctx, status, page, pageSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]item.Item)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*item.Status, int, int) int); ok {
-		r1 = rf(status, page, pageSize)
+	if rf, ok := ret.Get(1).(func(context.Context, *item.Status, int, int) int); ok {
+		r1 = rf(ctx, status, page, pageSize)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(*item.Status, int, int) error); ok {
-		r2 = rf(status, page, pageSize)
+	if rf, ok := ret.Get(2).(func(context.Context, *item.Status, int, int) error); ok {
+		r2 = rf(ctx, status, page, pageSize)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -242,9 +310,9 @@ func (_m *ItemRepository) GetItensFiltradosPaginados(status *item.Status, page i
 	return r0, r1, r2
 }
 
-// GetItensPaginados provides a mock function with given fields: ofsset, limit
-func (_m *ItemRepository) GetItensPaginados(ofsset int, limit int) ([]item.Item, int, error) {
-	ret := _m.Called(ofsset, limit)
+// GetItensPaginados provides a mock function with given fields: ctx, ofsset, limit
+func (_m *ItemRepository) GetItensPaginados(ctx context.Context, ofsset int, limit int) ([]item.Item, int, error) {
+	ret := _m.Called(ctx, ofsset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetItensPaginados")
@@ -253,25 +321,122 @@ func (_m *ItemRepository) GetItensPaginados(ofsset int, limit int) ([]item.Item,
 	var r0 []item.Item
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]item.Item, int, error)); ok {
-		return rf(ofsset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]item.Item, int, error)); ok {
+		return rf(ctx, ofsset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []item.Item); ok {
+		r0 = rf(ctx, ofsset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]item.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, ofsset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, ofsset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListarEstoqueBaixo provides a mock function with given fields: ctx
+func (_m *ItemRepository) ListarEstoqueBaixo(ctx context.Context) ([]item.Item, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarEstoqueBaixo")
+	}
+
+	var r0 []item.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]item.Item, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []item.Item); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]item.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListarLote provides a mock function with given fields: ctx, status, termo, aposID, limite
+func (_m *ItemRepository) ListarLote(ctx context.Context, status *item.Status, termo string, aposID int, limite int) ([]item.Item, error) {
+	ret := _m.Called(ctx, status, termo, aposID, limite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarLote")
+	}
+
+	var r0 []item.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status, string, int, int) ([]item.Item, error)); ok {
+		return rf(ctx, status, termo, aposID, limite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *item.Status, string, int, int) []item.Item); ok {
+		r0 = rf(ctx, status, termo, aposID, limite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]item.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *item.Status, string, int, int) error); ok {
+		r1 = rf(ctx, status, termo, aposID, limite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListarProdutos provides a mock function with given fields: ctx, offset, limit
+func (_m *ItemRepository) ListarProdutos(ctx context.Context, offset int, limit int) ([]item.Item, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarProdutos")
+	}
+
+	var r0 []item.Item
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]item.Item, int, error)); ok {
+		return rf(ctx, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []item.Item); ok {
-		r0 = rf(ofsset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []item.Item); ok {
+		r0 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]item.Item)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) int); ok {
-		r1 = rf(ofsset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(int, int) error); ok {
-		r2 = rf(ofsset, limit)
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -279,17 +444,93 @@ func (_m *ItemRepository) GetItensPaginados(ofsset int, limit int) ([]item.Item,
 	return r0, r1, r2
 }
 
-// UpdateItem provides a mock function with given fields: _a0
-func (_m *ItemRepository) UpdateItem(_a0 item.Item) error {
-	ret := _m.Called(_a0)
+// ListarVariantes provides a mock function with given fields: ctx, produtoIDs
+func (_m *ItemRepository) ListarVariantes(ctx context.Context, produtoIDs []int) ([]item.Item, error) {
+	ret := _m.Called(ctx, produtoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarVariantes")
+	}
+
+	var r0 []item.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]item.Item, error)); ok {
+		return rf(ctx, produtoIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []item.Item); ok {
+		r0 = rf(ctx, produtoIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]item.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, produtoIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumoEstoque provides a mock function with given fields: ctx
+func (_m *ItemRepository) ResumoEstoque(ctx context.Context) (item.ResumoEstoque, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumoEstoque")
+	}
+
+	var r0 item.ResumoEstoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (item.ResumoEstoque, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) item.ResumoEstoque); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(item.ResumoEstoque)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TravarItens provides a mock function with given fields: ctx, ids
+func (_m *ItemRepository) TravarItens(ctx context.Context, ids []int) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TravarItens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateItem provides a mock function with given fields: ctx, _a1
+func (_m *ItemRepository) UpdateItem(ctx context.Context, _a1 item.Item) error {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateItem")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(item.Item) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, item.Item) error); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,38 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	item "desafio-itens-app/internal/domain/item"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Metricas is an autogenerated mock type for the Metricas type
+type Metricas struct {
+	mock.Mock
+}
+
+// LoginRealizado provides a mock function with given fields: sucesso
+func (_m *Metricas) LoginRealizado(sucesso bool) {
+	_m.Called(sucesso)
+}
+
+// ResumoEstoqueAtualizado provides a mock function with given fields: resumo
+func (_m *Metricas) ResumoEstoqueAtualizado(resumo item.ResumoEstoque) {
+	_m.Called(resumo)
+}
+
+// NewMetricas creates a new instance of Metricas. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetricas(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Metricas {
+	mock := &Metricas{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	item "desafio-itens-app/internal/domain/item"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Notificador is an autogenerated mock type for the Notificador type
+type Notificador struct {
+	mock.Mock
+}
+
+// AlertaEstoque provides a mock function with given fields: ctx, alerta
+func (_m *Notificador) AlertaEstoque(ctx context.Context, alerta item.AlertaEstoque) error {
+	ret := _m.Called(ctx, alerta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertaEstoque")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, item.AlertaEstoque) error); ok {
+		r0 = rf(ctx, alerta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificador creates a new instance of Notificador. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificador(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Notificador {
+	mock := &Notificador{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
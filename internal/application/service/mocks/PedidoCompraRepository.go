@@ -0,0 +1,223 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	compra "desafio-itens-app/internal/domain/compra"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PedidoCompraRepository is an autogenerated mock type for the PedidoCompraRepository type
+type PedidoCompraRepository struct {
+	mock.Mock
+}
+
+// Atualizar provides a mock function with given fields: ctx, p
+func (_m *PedidoCompraRepository) Atualizar(ctx context.Context, p compra.Pedido) (compra.Pedido, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Atualizar")
+	}
+
+	var r0 compra.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, compra.Pedido) (compra.Pedido, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, compra.Pedido) compra.Pedido); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(compra.Pedido)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, compra.Pedido) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Criar provides a mock function with given fields: ctx, p
+func (_m *PedidoCompraRepository) Criar(ctx context.Context, p compra.Pedido) (compra.Pedido, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Criar")
+	}
+
+	var r0 compra.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, compra.Pedido) (compra.Pedido, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, compra.Pedido) compra.Pedido); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(compra.Pedido)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, compra.Pedido) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *PedidoCompraRepository) GetByID(ctx context.Context, id int) (*compra.Pedido, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *compra.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*compra.Pedido, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *compra.Pedido); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*compra.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Listar provides a mock function with given fields: ctx, filtro
+func (_m *PedidoCompraRepository) Listar(ctx context.Context, filtro compra.Filtro) ([]compra.Pedido, error) {
+	ret := _m.Called(ctx, filtro)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listar")
+	}
+
+	var r0 []compra.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, compra.Filtro) ([]compra.Pedido, error)); ok {
+		return rf(ctx, filtro)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, compra.Filtro) []compra.Pedido); ok {
+		r0 = rf(ctx, filtro)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]compra.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, compra.Filtro) error); ok {
+		r1 = rf(ctx, filtro)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Recebimentos provides a mock function with given fields: ctx, pedidoID
+func (_m *PedidoCompraRepository) Recebimentos(ctx context.Context, pedidoID int) ([]compra.Recebimento, error) {
+	ret := _m.Called(ctx, pedidoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recebimentos")
+	}
+
+	var r0 []compra.Recebimento
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]compra.Recebimento, error)); ok {
+		return rf(ctx, pedidoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []compra.Recebimento); ok {
+		r0 = rf(ctx, pedidoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]compra.Recebimento)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, pedidoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistrarRecebimentos provides a mock function with given fields: ctx, recebimentos
+func (_m *PedidoCompraRepository) RegistrarRecebimentos(ctx context.Context, recebimentos []compra.Recebimento) error {
+	ret := _m.Called(ctx, recebimentos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarRecebimentos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []compra.Recebimento) error); ok {
+		r0 = rf(ctx, recebimentos)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Travar provides a mock function with given fields: ctx, id
+func (_m *PedidoCompraRepository) Travar(ctx context.Context, id int) (*compra.Pedido, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Travar")
+	}
+
+	var r0 *compra.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*compra.Pedido, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *compra.Pedido); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*compra.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPedidoCompraRepository creates a new instance of PedidoCompraRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPedidoCompraRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PedidoCompraRepository {
+	mock := &PedidoCompraRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	venda "desafio-itens-app/internal/domain/venda"
+)
+
+// PedidoVendaRepository is an autogenerated mock type for the PedidoVendaRepository type
+type PedidoVendaRepository struct {
+	mock.Mock
+}
+
+// Atualizar provides a mock function with given fields: ctx, p
+func (_m *PedidoVendaRepository) Atualizar(ctx context.Context, p venda.Pedido) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Atualizar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, venda.Pedido) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Criar provides a mock function with given fields: ctx, p
+func (_m *PedidoVendaRepository) Criar(ctx context.Context, p venda.Pedido) (venda.Pedido, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Criar")
+	}
+
+	var r0 venda.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, venda.Pedido) (venda.Pedido, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, venda.Pedido) venda.Pedido); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(venda.Pedido)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, venda.Pedido) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *PedidoVendaRepository) GetByID(ctx context.Context, id int) (*venda.Pedido, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *venda.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*venda.Pedido, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *venda.Pedido); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*venda.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListarPorUsuario provides a mock function with given fields: ctx, usuarioID, offset, limit
+func (_m *PedidoVendaRepository) ListarPorUsuario(ctx context.Context, usuarioID int, offset int, limit int) ([]venda.Pedido, int, error) {
+	ret := _m.Called(ctx, usuarioID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarPorUsuario")
+	}
+
+	var r0 []venda.Pedido
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) ([]venda.Pedido, int, error)); ok {
+		return rf(ctx, usuarioID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) []venda.Pedido); ok {
+		r0 = rf(ctx, usuarioID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]venda.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) int); ok {
+		r1 = rf(ctx, usuarioID, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int, int) error); ok {
+		r2 = rf(ctx, usuarioID, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Travar provides a mock function with given fields: ctx, id
+func (_m *PedidoVendaRepository) Travar(ctx context.Context, id int) (*venda.Pedido, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Travar")
+	}
+
+	var r0 *venda.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*venda.Pedido, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *venda.Pedido); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*venda.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TravarItens provides a mock function with given fields: ctx, itemIDs
+func (_m *PedidoVendaRepository) TravarItens(ctx context.Context, itemIDs []int) error {
+	ret := _m.Called(ctx, itemIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TravarItens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) error); ok {
+		r0 = rf(ctx, itemIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPedidoVendaRepository creates a new instance of PedidoVendaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPedidoVendaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PedidoVendaRepository {
+	mock := &PedidoVendaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
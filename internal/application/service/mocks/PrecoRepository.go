@@ -0,0 +1,185 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	preco "desafio-itens-app/internal/domain/preco"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PrecoRepository is an autogenerated mock type for the PrecoRepository type
+type PrecoRepository struct {
+	mock.Mock
+}
+
+// Agendar provides a mock function with given fields: ctx, agendamento
+func (_m *PrecoRepository) Agendar(ctx context.Context, agendamento preco.Agendamento) (preco.Agendamento, error) {
+	ret := _m.Called(ctx, agendamento)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Agendar")
+	}
+
+	var r0 preco.Agendamento
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, preco.Agendamento) (preco.Agendamento, error)); ok {
+		return rf(ctx, agendamento)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, preco.Agendamento) preco.Agendamento); ok {
+		r0 = rf(ctx, agendamento)
+	} else {
+		r0 = ret.Get(0).(preco.Agendamento)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, preco.Agendamento) error); ok {
+		r1 = rf(ctx, agendamento)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelarAgendamento provides a mock function with given fields: ctx, itemID, id
+func (_m *PrecoRepository) CancelarAgendamento(ctx context.Context, itemID int, id int) error {
+	ret := _m.Called(ctx, itemID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarAgendamento")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, itemID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Concluir provides a mock function with given fields: ctx, agendamento
+func (_m *PrecoRepository) Concluir(ctx context.Context, agendamento preco.Agendamento) error {
+	ret := _m.Called(ctx, agendamento)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Concluir")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, preco.Agendamento) error); ok {
+		r0 = rf(ctx, agendamento)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListarAgendamentos provides a mock function with given fields: ctx, itemID
+func (_m *PrecoRepository) ListarAgendamentos(ctx context.Context, itemID int) ([]preco.Agendamento, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarAgendamentos")
+	}
+
+	var r0 []preco.Agendamento
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]preco.Agendamento, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []preco.Agendamento); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]preco.Agendamento)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListarHistorico provides a mock function with given fields: ctx, itemID
+func (_m *PrecoRepository) ListarHistorico(ctx context.Context, itemID int) ([]preco.Alteracao, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListarHistorico")
+	}
+
+	var r0 []preco.Alteracao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]preco.Alteracao, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []preco.Alteracao); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]preco.Alteracao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vencidos provides a mock function with given fields: ctx, ate, limite
+func (_m *PrecoRepository) Vencidos(ctx context.Context, ate time.Time, limite int) ([]preco.Agendamento, error) {
+	ret := _m.Called(ctx, ate, limite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vencidos")
+	}
+
+	var r0 []preco.Agendamento
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]preco.Agendamento, error)); ok {
+		return rf(ctx, ate, limite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []preco.Agendamento); ok {
+		r0 = rf(ctx, ate, limite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]preco.Agendamento)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, ate, limite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPrecoRepository creates a new instance of PrecoRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrecoRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrecoRepository {
+	mock := &PrecoRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,185 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	promocao "desafio-itens-app/internal/domain/promocao"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PromocaoRepository is an autogenerated mock type for the PromocaoRepository type
+type PromocaoRepository struct {
+	mock.Mock
+}
+
+// Atualizar provides a mock function with given fields: ctx, p
+func (_m *PromocaoRepository) Atualizar(ctx context.Context, p promocao.Promocao) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Atualizar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, promocao.Promocao) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Criar provides a mock function with given fields: ctx, p
+func (_m *PromocaoRepository) Criar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Criar")
+	}
+
+	var r0 promocao.Promocao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, promocao.Promocao) (promocao.Promocao, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, promocao.Promocao) promocao.Promocao); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(promocao.Promocao)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, promocao.Promocao) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *PromocaoRepository) GetByID(ctx context.Context, id int) (*promocao.Promocao, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *promocao.Promocao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*promocao.Promocao, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *promocao.Promocao); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*promocao.Promocao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Listar provides a mock function with given fields: ctx
+func (_m *PromocaoRepository) Listar(ctx context.Context) ([]promocao.Promocao, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listar")
+	}
+
+	var r0 []promocao.Promocao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]promocao.Promocao, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []promocao.Promocao); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]promocao.Promocao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remover provides a mock function with given fields: ctx, id
+func (_m *PromocaoRepository) Remover(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remover")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Vigentes provides a mock function with given fields: ctx, agora
+func (_m *PromocaoRepository) Vigentes(ctx context.Context, agora time.Time) ([]promocao.Promocao, error) {
+	ret := _m.Called(ctx, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vigentes")
+	}
+
+	var r0 []promocao.Promocao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]promocao.Promocao, error)); ok {
+		return rf(ctx, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []promocao.Promocao); ok {
+		r0 = rf(ctx, agora)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]promocao.Promocao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPromocaoRepository creates a new instance of PromocaoRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPromocaoRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PromocaoRepository {
+	mock := &PromocaoRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,284 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	reserva "desafio-itens-app/internal/domain/reserva"
+
+	time "time"
+)
+
+// ReservaRepository is an autogenerated mock type for the ReservaRepository type
+type ReservaRepository struct {
+	mock.Mock
+}
+
+// Concluir provides a mock function with given fields: ctx, r
+func (_m *ReservaRepository) Concluir(ctx context.Context, r reserva.Reserva) error {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Concluir")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reserva.Reserva) error); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Dividir provides a mock function with given fields: ctx, restante, parte
+func (_m *ReservaRepository) Dividir(ctx context.Context, restante reserva.Reserva, parte reserva.Reserva) (reserva.Reserva, error) {
+	ret := _m.Called(ctx, restante, parte)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dividir")
+	}
+
+	var r0 reserva.Reserva
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reserva.Reserva, reserva.Reserva) (reserva.Reserva, error)); ok {
+		return rf(ctx, restante, parte)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reserva.Reserva, reserva.Reserva) reserva.Reserva); ok {
+		r0 = rf(ctx, restante, parte)
+	} else {
+		r0 = ret.Get(0).(reserva.Reserva)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reserva.Reserva, reserva.Reserva) error); ok {
+		r1 = rf(ctx, restante, parte)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Expirar provides a mock function with given fields: ctx, agora
+func (_m *ReservaRepository) Expirar(ctx context.Context, agora time.Time) (int64, error) {
+	ret := _m.Called(ctx, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expirar")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, agora)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *ReservaRepository) GetByID(ctx context.Context, id int) (*reserva.Reserva, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *reserva.Reserva
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*reserva.Reserva, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *reserva.Reserva); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reserva.Reserva)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reservados provides a mock function with given fields: ctx, itemIDs, agora
+func (_m *ReservaRepository) Reservados(ctx context.Context, itemIDs []int, agora time.Time) (map[int]int, error) {
+	ret := _m.Called(ctx, itemIDs, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reservados")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time) (map[int]int, error)); ok {
+		return rf(ctx, itemIDs, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time) map[int]int); ok {
+		r0 = rf(ctx, itemIDs, agora)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, time.Time) error); ok {
+		r1 = rf(ctx, itemIDs, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservadosPorOutros provides a mock function with given fields: ctx, itemIDs, usuarioID, agora
+func (_m *ReservaRepository) ReservadosPorOutros(ctx context.Context, itemIDs []int, usuarioID int, agora time.Time) (map[int]int, error) {
+	ret := _m.Called(ctx, itemIDs, usuarioID, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservadosPorOutros")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int, time.Time) (map[int]int, error)); ok {
+		return rf(ctx, itemIDs, usuarioID, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int, time.Time) map[int]int); ok {
+		r0 = rf(ctx, itemIDs, usuarioID, agora)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int, time.Time) error); ok {
+		r1 = rf(ctx, itemIDs, usuarioID, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reservar provides a mock function with given fields: ctx, r
+func (_m *ReservaRepository) Reservar(ctx context.Context, r reserva.Reserva) (reserva.Reserva, error) {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reservar")
+	}
+
+	var r0 reserva.Reserva
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reserva.Reserva) (reserva.Reserva, error)); ok {
+		return rf(ctx, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reserva.Reserva) reserva.Reserva); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Get(0).(reserva.Reserva)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reserva.Reserva) error); ok {
+		r1 = rf(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Travar provides a mock function with given fields: ctx, id
+func (_m *ReservaRepository) Travar(ctx context.Context, id int) (*reserva.Reserva, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Travar")
+	}
+
+	var r0 *reserva.Reserva
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*reserva.Reserva, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *reserva.Reserva); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reserva.Reserva)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VigentesDoUsuario provides a mock function with given fields: ctx, itemIDs, usuarioID, agora
+func (_m *ReservaRepository) VigentesDoUsuario(ctx context.Context, itemIDs []int, usuarioID int, agora time.Time) ([]reserva.Reserva, error) {
+	ret := _m.Called(ctx, itemIDs, usuarioID, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VigentesDoUsuario")
+	}
+
+	var r0 []reserva.Reserva
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int, time.Time) ([]reserva.Reserva, error)); ok {
+		return rf(ctx, itemIDs, usuarioID, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int, time.Time) []reserva.Reserva); ok {
+		r0 = rf(ctx, itemIDs, usuarioID, agora)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reserva.Reserva)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int, time.Time) error); ok {
+		r1 = rf(ctx, itemIDs, usuarioID, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReservaRepository creates a new instance of ReservaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReservaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReservaRepository {
+	mock := &ReservaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
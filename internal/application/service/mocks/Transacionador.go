@@ -0,0 +1,51 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Transacionador is an autogenerated mock type for the Transacionador type
+type Transacionador struct {
+	mock.Mock
+}
+
+// AposCommit provides a mock function with given fields: ctx, fn
+func (_m *Transacionador) AposCommit(ctx context.Context, fn func()) {
+	_m.Called(ctx, fn)
+}
+
+// EmTransacao provides a mock function with given fields: ctx, fn
+func (_m *Transacionador) EmTransacao(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmTransacao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTransacionador creates a new instance of Transacionador. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransacionador(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Transacionador {
+	mock := &Transacionador{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,3 +1,5 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
 package mocks
 
 import (
@@ -13,9 +15,9 @@ type UserRepository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: _a0
-func (_m *UserRepository) Create(_a0 user.User) (user.User, error) {
-	ret := _m.Called(_a0)
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *UserRepository) Create(ctx context.Context, _a1 user.User) (user.User, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -23,17 +25,17 @@ func (_m *UserRepository) Create(_a0 user.User) (user.User, error) {
 
 	var r0 user.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(user.User) (user.User, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, user.User) (user.User, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(user.User) user.User); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, user.User) user.User); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		r0 = ret.Get(0).(user.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(user.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, user.User) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,17 +43,17 @@ func (_m *UserRepository) Create(_a0 user.User) (user.User, error) {
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *UserRepository) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *UserRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -59,9 +61,9 @@ func (_m *UserRepository) Delete(id int) error {
 	return r0
 }
 
-// EmailExists provides a mock function with given fields: email
-func (_m *UserRepository) EmailExists(email string) (bool, error) {
-	ret := _m.Called(email)
+// EmailExists provides a mock function with given fields: ctx, email
+func (_m *UserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	ret := _m.Called(ctx, email)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EmailExists")
@@ -69,17 +71,17 @@ func (_m *UserRepository) EmailExists(email string) (bool, error) {
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
-		return rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, email)
 	}
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, email)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -87,9 +89,9 @@ func (_m *UserRepository) EmailExists(email string) (bool, error) {
 	return r0, r1
 }
 
-// GetByEmail provides a mock function with given fields: email
-func (_m *UserRepository) GetByEmail(email string) (*user.User, error) {
-	ret := _m.Called(email)
+// GetByEmail provides a mock function with given fields: ctx, email
+func (_m *UserRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	ret := _m.Called(ctx, email)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByEmail")
@@ -97,19 +99,19 @@ func (_m *UserRepository) GetByEmail(email string) (*user.User, error) {
 
 	var r0 *user.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*user.User, error)); ok {
-		return rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.User, error)); ok {
+		return rf(ctx, email)
 	}
-	if rf, ok := ret.Get(0).(func(string) *user.User); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.User); ok {
+		r0 = rf(ctx, email)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*user.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -117,9 +119,9 @@ func (_m *UserRepository) GetByEmail(email string) (*user.User, error) {
 	return r0, r1
 }
 
-// GetById provides a mock function with given fields: id
-func (_m *UserRepository) GetById(id int) (*user.User, error) {
-	ret := _m.Called(id)
+// GetById provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetById(ctx context.Context, id int) (*user.User, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetById")
@@ -127,19 +129,19 @@ func (_m *UserRepository) GetById(id int) (*user.User, error) {
 
 	var r0 *user.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (*user.User, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*user.User, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) *user.User); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *user.User); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*user.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -147,9 +149,9 @@ func (_m *UserRepository) GetById(id int) (*user.User, error) {
 	return r0, r1
 }
 
-// GetByUsername provides a mock function with given fields: username
-func (_m *UserRepository) GetByUsername(username string) (*user.User, error) {
-	ret := _m.Called(username)
+// GetByUsername provides a mock function with given fields: ctx, username
+func (_m *UserRepository) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	ret := _m.Called(ctx, username)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByUsername")
@@ -157,19 +159,19 @@ func (_m *UserRepository) GetByUsername(username string) (*user.User, error) {
 
 	var r0 *user.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*user.User, error)); ok {
-		return rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.User, error)); ok {
+		return rf(ctx, username)
 	}
-	if rf, ok := ret.Get(0).(func(string) *user.User); ok {
-		r0 = rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.User); ok {
+		r0 = rf(ctx, username)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*user.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(username)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -214,17 +216,17 @@ func (_m *UserRepository) List(ctx context.Context, limit int, offset int) ([]*u
 	return r0, r1, r2
 }
 
-// Update provides a mock function with given fields: _a0
-func (_m *UserRepository) Update(_a0 user.User) error {
-	ret := _m.Called(_a0)
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *UserRepository) Update(ctx context.Context, _a1 user.User) error {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(user.User) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, user.User) error); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -232,9 +234,9 @@ func (_m *UserRepository) Update(_a0 user.User) error {
 	return r0
 }
 
-// UserNameExists provides a mock function with given fields: username
-func (_m *UserRepository) UserNameExists(username string) (bool, error) {
-	ret := _m.Called(username)
+// UserNameExists provides a mock function with given fields: ctx, username
+func (_m *UserRepository) UserNameExists(ctx context.Context, username string) (bool, error) {
+	ret := _m.Called(ctx, username)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UserNameExists")
@@ -242,17 +244,17 @@ func (_m *UserRepository) UserNameExists(username string) (bool, error) {
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
-		return rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, username)
 	}
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, username)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(username)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
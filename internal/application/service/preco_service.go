@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/preco"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+// limiteAgendadosPorRodada: agendamentos aplicados por transação
+const limiteAgendadosPorRodada = 100
+
+type precoService struct {
+	repoItens  repositories.ItemRepository
+	itens      services.ItemService // O preço agendado passa pelas regras do UpdateItem
+	repo       repositories.PrecoRepository
+	transacoes repositories.Transacionador
+	logger     *slog.Logger
+}
+
+func NewPrecoService(repoItens repositories.ItemRepository, itens services.ItemService, repo repositories.PrecoRepository, transacoes repositories.Transacionador, logger *slog.Logger) *precoService {
+	return &precoService{repoItens: repoItens, itens: itens, repo: repo, transacoes: transacoes, logger: logger}
+}
+
+func (s *precoService) Historico(ctx context.Context, itemID int) ([]preco.Alteracao, error) {
+	ctx, span := tracer.Start(ctx, "precoService.Historico", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	historico, err := s.repo.ListarHistorico(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar histórico de preços: %w", err)
+	}
+	return historico, nil
+}
+
+func (s *precoService) Agendamentos(ctx context.Context, itemID int) ([]preco.Agendamento, error) {
+	ctx, span := tracer.Start(ctx, "precoService.Agendamentos", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	agendamentos, err := s.repo.ListarAgendamentos(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao buscar preços agendados: %w", err)
+	}
+	return agendamentos, nil
+}
+
+func (s *precoService) Agendar(ctx context.Context, agendamento preco.Agendamento) (preco.Agendamento, error) {
+	ctx, span := tracer.Start(ctx, "precoService.Agendar", trace.WithAttributes(attribute.Int("item.id", agendamento.ItemID)))
+	defer span.End()
+
+	criado, err := s.repo.Agendar(ctx, agendamento)
+	if err != nil {
+		return preco.Agendamento{}, fmt.Errorf("Erro ao agendar preço: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "preço agendado",
+		"item_id", criado.ItemID,
+		"agendamento_id", criado.ID,
+		"preco", criado.Preco.String(),
+		"moeda", criado.Preco.Moeda,
+		"vigente_a_partir_de", criado.VigenteAPartirDe,
+		"criado_por", criado.CriadoPor)
+
+	return criado, nil
+}
+
+func (s *precoService) CancelarAgendamento(ctx context.Context, itemID, id int) error {
+	ctx, span := tracer.Start(ctx, "precoService.CancelarAgendamento", trace.WithAttributes(attribute.Int("item.id", itemID)))
+	defer span.End()
+
+	if err := s.repo.CancelarAgendamento(ctx, itemID, id); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "preço agendado cancelado", "item_id", itemID, "agendamento_id", id)
+	return nil
+}
+
+// AplicarAgendados aplica os vencidos em rodadas de limiteAgendadosPorRodada,
+// cada uma numa transação: erro de banco desfaz a rodada, que volta a ser
+// tentada na próxima execução
+func (s *precoService) AplicarAgendados(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "precoService.AplicarAgendados")
+	defer span.End()
+
+	total := 0
+	for {
+		vencidos := 0
+		err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+			agendamentos, err := s.repo.Vencidos(ctx, time.Now(), limiteAgendadosPorRodada)
+			if err != nil {
+				return err
+			}
+			vencidos = len(agendamentos)
+
+			for _, agendamento := range agendamentos {
+				if err := s.aplicar(ctx, &agendamento); err != nil {
+					return err
+				}
+				if err := s.repo.Concluir(ctx, agendamento); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Erro ao aplicar preços agendados: %w", err)
+		}
+
+		total += vencidos
+		if vencidos < limiteAgendadosPorRodada {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("agendamentos", total))
+	if total > 0 {
+		s.logger.InfoContext(ctx, "preços agendados processados", "total", total)
+	}
+	return nil
+}
+
+// aplicar troca o preço do item em nome de quem agendou. Item que sumiu ou
+// que as regras recusam encerra o agendamento como falhou; qualquer outro
+// erro volta para desfazer a rodada, que a próxima varredura repete.
+func (s *precoService) aplicar(ctx context.Context, agendamento *preco.Agendamento) error {
+	// 🔒 Trava antes de ler: o item gravado é o lido, com o preço novo
+	err := s.repoItens.TravarItens(ctx, []int{agendamento.ItemID})
+	if err != nil {
+		return s.falharSePermanente(ctx, agendamento, err)
+	}
+	item, err := s.repoItens.GetItem(ctx, agendamento.ItemID)
+	if err != nil {
+		return s.falharSePermanente(ctx, agendamento, err)
+	}
+
+	atualizado := *item
+	atualizado.DefinirPreco(agendamento.Preco)
+	atualizado.UpdateBy = &agendamento.CriadoPor // Auditoria: quem agendou
+	if err := s.itens.UpdateItem(ctx, atualizado); err != nil {
+		return s.falharSePermanente(ctx, agendamento, err)
+	}
+
+	agendamento.Aplicar(time.Now())
+	s.logger.InfoContext(ctx, "preço agendado aplicado",
+		"item_id", agendamento.ItemID,
+		"agendamento_id", agendamento.ID,
+		"preco", agendamento.Preco.String(),
+		"moeda", agendamento.Preco.Moeda)
+	return nil
+}
+
+// falharSePermanente encerra o agendamento quando repetir não adianta (item
+// sumiu ou foi recusado pelas regras) e devolve os demais erros
+func (s *precoService) falharSePermanente(ctx context.Context, agendamento *preco.Agendamento, err error) error {
+	if !errors.Is(err, entity.ErrItemNaoEncontrado) && !errors.Is(err, entity.ErrItemInvalido) {
+		return err
+	}
+
+	agendamento.Falhar(time.Now(), err)
+	s.logger.WarnContext(ctx, "preço agendado não aplicado",
+		"item_id", agendamento.ItemID,
+		"agendamento_id", agendamento.ID,
+		"erro", err)
+	return nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/preco"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestAplicarAgendados_AplicaEmNomeDeQuemAgendou(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPrecoService(d.itens, d.itemService(), d.precos, d.transacoes, d.logger)
+	vigente := time.Now().Add(-time.Minute)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.precos.On("Vencidos", mock.Anything, mock.AnythingOfType("time.Time"), limiteAgendadosPorRodada).Return([]preco.Agendamento{
+		{ID: 10, ItemID: 1, Preco: dinheiro.Novo(2500, dinheiro.BRL), VigenteAPartirDe: vigente, Status: preco.StatusPendente, CriadoPor: 7},
+		{ID: 11, ItemID: 2, Preco: dinheiro.Novo(900, dinheiro.BRL), VigenteAPartirDe: vigente, Status: preco.StatusPendente, CriadoPor: 7},
+	}, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Return(nil)
+	d.itens.On("TravarItens", mock.Anything, []int{2}).Return(fmt.Errorf("item 2: %w", entity.ErrItemNaoEncontrado))
+	d.itens.On("GetItem", mock.Anything, 1).Return(&entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 3}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.ID == 1 && i.Preco == dinheiro.Novo(2500, dinheiro.BRL) && *i.UpdateBy == 7
+	})).Return(nil).Once()
+	d.precos.On("Concluir", mock.Anything, mock.MatchedBy(func(a preco.Agendamento) bool {
+		return a.ID == 10 && a.Status == preco.StatusAplicado && a.AplicadoEm != nil
+	})).Return(nil).Once()
+	d.precos.On("Concluir", mock.Anything, mock.MatchedBy(func(a preco.Agendamento) bool {
+		return a.ID == 11 && a.Status == preco.StatusFalhou && a.Erro == "item 2: Item não encontrado"
+	})).Return(nil).Once()
+
+	// ACT
+	err := service.AplicarAgendados(context.Background())
+
+	// ASSERT
+	assert.NoError(t, err)
+}
+
+func TestAplicarAgendados_ErroNoUpdateDesfazARodada(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPrecoService(d.itens, d.itemService(), d.precos, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.precos.On("Vencidos", mock.Anything, mock.Anything, limiteAgendadosPorRodada).Return([]preco.Agendamento{
+		{ID: 10, ItemID: 1, Preco: dinheiro.Novo(2500, dinheiro.BRL), Status: preco.StatusPendente, CriadoPor: 7},
+	}, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 1).Return(&entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 3}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.Anything).Return(errors.New("conexão perdida"))
+
+	// ACT
+	err := service.AplicarAgendados(context.Background())
+
+	// ASSERT
+	assert.ErrorContains(t, err, "conexão perdida")
+	d.precos.AssertNotCalled(t, "Concluir", mock.Anything, mock.Anything)
+}
+
+func TestAplicarAgendados_ErroAoLerItemDesfazARodada(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPrecoService(d.itens, d.itemService(), d.precos, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.precos.On("Vencidos", mock.Anything, mock.Anything, limiteAgendadosPorRodada).Return([]preco.Agendamento{
+		{ID: 10, ItemID: 1, Preco: dinheiro.Novo(2500, dinheiro.BRL), Status: preco.StatusPendente, CriadoPor: 7},
+	}, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 1).Return(nil, errors.New("Erro ao buscar item: conexão perdida"))
+
+	// ACT
+	err := service.AplicarAgendados(context.Background())
+
+	// ASSERT
+	assert.ErrorContains(t, err, "conexão perdida")
+	d.precos.AssertNotCalled(t, "Concluir", mock.Anything, mock.Anything)
+}
+
+func TestAplicarAgendados_ItemRecusadoFalhaSoOAgendamento(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPrecoService(d.itens, d.itemService(), d.precos, d.transacoes, d.logger)
+	var ordem []string
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.precos.On("Vencidos", mock.Anything, mock.Anything, limiteAgendadosPorRodada).Return([]preco.Agendamento{
+		{ID: 10, ItemID: 1, Preco: dinheiro.Novo(2500, dinheiro.BRL), Status: preco.StatusPendente, CriadoPor: 7},
+	}, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{1}).Run(func(mock.Arguments) { ordem = append(ordem, "TravarItens") }).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 1).Run(func(mock.Arguments) { ordem = append(ordem, "GetItem") }).
+		Return(&entity.Item{ID: 1, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 3, PontoReposicao: -1}, nil)
+	d.precos.On("Concluir", mock.Anything, mock.MatchedBy(func(a preco.Agendamento) bool {
+		return a.ID == 10 && a.Status == preco.StatusFalhou && a.Erro == "Ponto de reposição não pode ser negativo"
+	})).Return(nil).Once()
+
+	// ACT
+	err := service.AplicarAgendados(context.Background())
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TravarItens", "GetItem"}, ordem)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
+
+func TestAplicarAgendados_SemVencidos(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPrecoService(d.itens, d.itemService(), d.precos, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.precos.On("Vencidos", mock.Anything, mock.Anything, limiteAgendadosPorRodada).Return([]preco.Agendamento{}, nil).Once()
+
+	// ACT
+	err := service.AplicarAgendados(context.Background())
+
+	// ASSERT
+	assert.NoError(t, err)
+}
+
+func TestCancelarAgendamento_NaoEncontrado(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPrecoService(d.itens, d.itemService(), d.precos, d.transacoes, d.logger)
+	d.precos.On("CancelarAgendamento", mock.Anything, 1, 99).Return(preco.ErrAgendamentoNaoEncontrado)
+
+	// ACT
+	err := service.CancelarAgendamento(context.Background(), 1, 99)
+
+	// ASSERT
+	assert.ErrorIs(t, err, preco.ErrAgendamentoNaoEncontrado)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/promocao"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+type promocaoService struct {
+	repo   repositories.PromocaoRepository
+	logger *slog.Logger
+}
+
+func NewPromocaoService(repo repositories.PromocaoRepository, logger *slog.Logger) *promocaoService {
+	return &promocaoService{repo: repo, logger: logger}
+}
+
+func (s *promocaoService) Listar(ctx context.Context) ([]promocao.Promocao, error) {
+	ctx, span := tracer.Start(ctx, "promocaoService.Listar")
+	defer span.End()
+
+	promocoes, err := s.repo.Listar(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao listar promoções: %w", err)
+	}
+	return promocoes, nil
+}
+
+func (s *promocaoService) Buscar(ctx context.Context, id int) (*promocao.Promocao, error) {
+	ctx, span := tracer.Start(ctx, "promocaoService.Buscar", trace.WithAttributes(attribute.Int("promocao.id", id)))
+	defer span.End()
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *promocaoService) Criar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error) {
+	ctx, span := tracer.Start(ctx, "promocaoService.Criar")
+	defer span.End()
+
+	p.CriadoEm = time.Now().UTC()
+	p.AtualizadoEm = p.CriadoEm
+
+	criada, err := s.repo.Criar(ctx, p)
+	if err != nil {
+		return promocao.Promocao{}, err
+	}
+
+	s.logger.InfoContext(ctx, "promoção criada",
+		"promocao_id", criada.ID,
+		"tipo", criada.Tipo,
+		"alvo", criada.Alvo,
+		"inicio", criada.Inicio,
+		"fim", criada.Fim,
+		"criado_por", criada.CriadoPor)
+	return criada, nil
+}
+
+// Atualizar substitui a promoção inteira, mantendo quem criou e quando
+func (s *promocaoService) Atualizar(ctx context.Context, p promocao.Promocao) (promocao.Promocao, error) {
+	ctx, span := tracer.Start(ctx, "promocaoService.Atualizar", trace.WithAttributes(attribute.Int("promocao.id", p.ID)))
+	defer span.End()
+
+	atual, err := s.repo.GetByID(ctx, p.ID)
+	if err != nil {
+		return promocao.Promocao{}, err
+	}
+	p.CriadoPor = atual.CriadoPor
+	p.CriadoEm = atual.CriadoEm
+	p.AtualizadoEm = time.Now().UTC()
+
+	if err := s.repo.Atualizar(ctx, p); err != nil {
+		return promocao.Promocao{}, err
+	}
+
+	s.logger.InfoContext(ctx, "promoção atualizada", "promocao_id", p.ID, "ativa", p.Ativa)
+	return p, nil
+}
+
+func (s *promocaoService) Remover(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "promocaoService.Remover", trace.WithAttributes(attribute.Int("promocao.id", id)))
+	defer span.End()
+
+	if err := s.repo.Remover(ctx, id); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "promoção removida", "promocao_id", id)
+	return nil
+}
+
+// Aplicar lê as promoções vigentes uma vez e calcula o preço de cada item
+func (s *promocaoService) Aplicar(ctx context.Context, itens []entity.Item) ([]promocao.Resultado, error) {
+	ctx, span := tracer.Start(ctx, "promocaoService.Aplicar", trace.WithAttributes(attribute.Int("itens", len(itens))))
+	defer span.End()
+
+	agora := time.Now()
+	vigentes, err := s.repo.Vigentes(ctx, agora)
+	if err != nil {
+		return nil, fmt.Errorf("Erro ao carregar promoções: %w", err)
+	}
+
+	resultados := make([]promocao.Resultado, 0, len(itens))
+	for _, item := range itens {
+		resultados = append(resultados, promocao.Calcular(item, vigentes, agora))
+	}
+	return resultados, nil
+}
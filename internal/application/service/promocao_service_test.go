@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/promocao"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestAplicarPromocoes_CalculaPorItem(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPromocaoService(d.promocoes, d.logger)
+	agora := time.Now()
+	blackFriday := promocao.Promocao{ID: 1, Nome: "Black Friday", Tipo: promocao.TipoPercentual, Percentual: 2000,
+		Alvo: promocao.AlvoTag, Tag: "gamer", Inicio: agora.Add(-time.Hour), Fim: agora.Add(time.Hour), Ativa: true}
+	d.promocoes.On("Vigentes", mock.Anything, mock.AnythingOfType("time.Time")).Return([]promocao.Promocao{blackFriday}, nil).Once()
+
+	itens := []entity.Item{
+		{ID: 1, Tags: []string{"gamer"}, Preco: dinheiro.Novo(10000, dinheiro.BRL)},
+		{ID: 2, Preco: dinheiro.Novo(5000, dinheiro.BRL)},
+	}
+
+	// ACT
+	resultados, err := service.Aplicar(context.Background(), itens)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, resultados, 2)
+	assert.Equal(t, dinheiro.Novo(8000, dinheiro.BRL), resultados[0].PrecoEfetivo)
+	assert.Equal(t, "Black Friday", resultados[0].Aplicadas[0].Nome)
+	assert.Equal(t, dinheiro.Novo(5000, dinheiro.BRL), resultados[1].PrecoEfetivo)
+	assert.Empty(t, resultados[1].Aplicadas)
+}
+
+func TestAtualizarPromocao_MantemCriacao(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPromocaoService(d.promocoes, d.logger)
+	criadaEm := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	d.promocoes.On("GetByID", mock.Anything, 3).Return(&promocao.Promocao{ID: 3, CriadoPor: 1, CriadoEm: criadaEm}, nil)
+	d.promocoes.On("Atualizar", mock.Anything, mock.MatchedBy(func(p promocao.Promocao) bool {
+		return p.ID == 3 && p.CriadoPor == 1 && p.CriadoEm.Equal(criadaEm) && !p.AtualizadoEm.IsZero()
+	})).Return(nil)
+
+	// ACT
+	atualizada, err := service.Atualizar(context.Background(), promocao.Promocao{ID: 3, Nome: "Nova", CriadoPor: 99})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, atualizada.CriadoPor)
+}
+
+func TestAtualizarPromocao_NaoEncontrada(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPromocaoService(d.promocoes, d.logger)
+	d.promocoes.On("GetByID", mock.Anything, 3).Return(nil, promocao.ErrPromocaoNaoEncontrada)
+
+	// ACT
+	_, err := service.Atualizar(context.Background(), promocao.Promocao{ID: 3})
+
+	// ASSERT
+	assert.ErrorIs(t, err, promocao.ErrPromocaoNaoEncontrada)
+	d.promocoes.AssertNotCalled(t, "Atualizar", mock.Anything, mock.Anything)
+}
+
+func TestAplicarPromocoes_ErroNoRepositorio(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewPromocaoService(d.promocoes, d.logger)
+	d.promocoes.On("Vigentes", mock.Anything, mock.Anything).Return(nil, errors.New("timeout"))
+
+	// ACT
+	_, err := service.Aplicar(context.Background(), []entity.Item{{ID: 1}})
+
+	// ASSERT
+	assert.ErrorContains(t, err, "Erro ao carregar promoções")
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/reserva"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+type reservaService struct {
+	repoItens  repositories.ItemRepository
+	itens      services.ItemService // A confirmação baixa o estoque pelas regras do UpdateItem
+	repo       repositories.ReservaRepository
+	transacoes repositories.Transacionador
+	logger     *slog.Logger
+}
+
+func NewReservaService(repoItens repositories.ItemRepository, itens services.ItemService, repo repositories.ReservaRepository, transacoes repositories.Transacionador, logger *slog.Logger) *reservaService {
+	return &reservaService{repoItens: repoItens, itens: itens, repo: repo, transacoes: transacoes, logger: logger}
+}
+
+// Reservar grava a reserva se há estoque disponível; a conferência é feita
+// no repositório com o item travado
+func (s *reservaService) Reservar(ctx context.Context, r reserva.Reserva) (reserva.Reserva, error) {
+	ctx, span := tracer.Start(ctx, "reservaService.Reservar", trace.WithAttributes(attribute.Int("item.id", r.ItemID)))
+	defer span.End()
+
+	criada, err := s.repo.Reservar(ctx, r)
+	if err != nil {
+		return reserva.Reserva{}, err
+	}
+
+	s.logger.InfoContext(ctx, "estoque reservado",
+		"reserva_id", criada.ID,
+		"item_id", criada.ItemID,
+		"quantidade", criada.Quantidade,
+		"expira_em", criada.ExpiraEm,
+		"usuario_id", criada.UsuarioID)
+	return criada, nil
+}
+
+func (s *reservaService) Buscar(ctx context.Context, id int) (*reserva.Reserva, error) {
+	ctx, span := tracer.Start(ctx, "reservaService.Buscar")
+	defer span.End()
+
+	return s.repo.GetByID(ctx, id)
+}
+
+// Confirmar baixa o estoque do item e encerra a reserva na mesma transação.
+// O status do item segue a regra de sempre. Trava o item antes da reserva,
+// na mesma ordem da confirmação de pedidos: as duas mexem nas mesmas linhas
+// e, em ordens diferentes, se travariam mutuamente.
+func (s *reservaService) Confirmar(ctx context.Context, id, usuarioID int) (reserva.Reserva, error) {
+	ctx, span := tracer.Start(ctx, "reservaService.Confirmar", trace.WithAttributes(attribute.Int("reserva.id", id)))
+	defer span.End()
+
+	var confirmada reserva.Reserva
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		// A leitura sem trava só descobre o item; o item de uma reserva não muda
+		lida, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// 🔒 Item antes da reserva; o estoque lido é o que vai ser baixado
+		if err := s.repoItens.TravarItens(ctx, []int{lida.ItemID}); err != nil {
+			return err
+		}
+		r, err := s.repo.Travar(ctx, id) // Relida travada: o status pode ter mudado
+		if err != nil {
+			return err
+		}
+		if err := r.Confirmar(time.Now()); err != nil {
+			return err
+		}
+
+		item, err := s.repoItens.GetItem(ctx, r.ItemID)
+		if err != nil {
+			return err
+		}
+		if item.Estoque < r.Quantidade { // Estoque ajustado para baixo depois da reserva
+			return fmt.Errorf("%w: estoque %d", reserva.ErrEstoqueIndisponivel, item.Estoque)
+		}
+		atualizado := *item
+		atualizado.Estoque -= r.Quantidade
+		atualizado.UpdateBy = &usuarioID // Auditoria
+		if err := s.itens.UpdateItem(ctx, atualizado); err != nil {
+			return err
+		}
+
+		confirmada = *r
+		return s.repo.Concluir(ctx, confirmada)
+	})
+	if err != nil {
+		return reserva.Reserva{}, fmt.Errorf("Erro ao confirmar reserva: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "reserva confirmada",
+		"reserva_id", confirmada.ID,
+		"item_id", confirmada.ItemID,
+		"quantidade", confirmada.Quantidade,
+		"updated_by", usuarioID)
+	return confirmada, nil
+}
+
+// Cancelar devolve a quantidade ao disponível; o estoque não muda
+func (s *reservaService) Cancelar(ctx context.Context, id int) (reserva.Reserva, error) {
+	ctx, span := tracer.Start(ctx, "reservaService.Cancelar", trace.WithAttributes(attribute.Int("reserva.id", id)))
+	defer span.End()
+
+	var cancelada reserva.Reserva
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		r, err := s.repo.Travar(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := r.Cancelar(time.Now()); err != nil {
+			return err
+		}
+
+		cancelada = *r
+		return s.repo.Concluir(ctx, cancelada)
+	})
+	if err != nil {
+		return reserva.Reserva{}, fmt.Errorf("Erro ao cancelar reserva: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "reserva cancelada", "reserva_id", cancelada.ID, "item_id", cancelada.ItemID)
+	return cancelada, nil
+}
+
+func (s *reservaService) Reservados(ctx context.Context, itemIDs []int) (map[int]int, error) {
+	ctx, span := tracer.Start(ctx, "reservaService.Reservados")
+	defer span.End()
+
+	return s.repo.Reservados(ctx, itemIDs, time.Now())
+}
+
+// ExpirarVencidas libera as reservas que passaram do prazo. Vencidas já não
+// contam como reservadas; isto só acerta o status delas.
+func (s *reservaService) ExpirarVencidas(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "reservaService.ExpirarVencidas")
+	defer span.End()
+
+	expiradas, err := s.repo.Expirar(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if expiradas > 0 {
+		s.logger.InfoContext(ctx, "reservas expiradas", "total", expiradas)
+	}
+	return nil
+}
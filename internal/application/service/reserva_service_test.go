@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/reserva"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestConfirmarReserva_BaixaEstoqueDoItem(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewReservaService(d.itens, d.itemService(), d.reservas, d.transacoes, d.logger)
+	ativa := &reserva.Reserva{ID: 3, ItemID: 5, Quantidade: 2, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: time.Now().Add(time.Minute)}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.reservas.On("GetByID", mock.Anything, 3).Return(ativa, nil)
+	d.reservas.On("Travar", mock.Anything, 3).Return(ativa, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{5}).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 2, Status: entity.StatusAtivo}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 0 && i.Status == entity.StatusInativo && *i.UpdateBy == 7
+	})).Return(nil).Once()
+	d.reservas.On("Concluir", mock.Anything, mock.MatchedBy(func(r reserva.Reserva) bool {
+		return r.ID == 3 && r.Status == reserva.StatusConfirmada && r.ConcluidaEm != nil
+	})).Return(nil).Once()
+
+	// ACT
+	confirmada, err := service.Confirmar(context.Background(), 3, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, reserva.StatusConfirmada, confirmada.Status)
+}
+
+func TestConfirmarReserva_TravaItemAntesDaReservaEDaLeitura(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewReservaService(d.itens, d.itemService(), d.reservas, d.transacoes, d.logger)
+	ativa := &reserva.Reserva{ID: 3, ItemID: 5, Quantidade: 1, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: time.Now().Add(time.Minute)}
+	var ordem []string
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.reservas.On("GetByID", mock.Anything, 3).Return(ativa, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{5}).Run(func(mock.Arguments) { ordem = append(ordem, "TravarItens") }).Return(nil)
+	d.reservas.On("Travar", mock.Anything, 3).Run(func(mock.Arguments) { ordem = append(ordem, "Travar") }).Return(ativa, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Run(func(mock.Arguments) { ordem = append(ordem, "GetItem") }).
+		Return(&entity.Item{ID: 5, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 3, Status: entity.StatusAtivo}, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.Anything).Return(nil)
+	d.reservas.On("Concluir", mock.Anything, mock.Anything).Return(nil)
+
+	// ACT
+	_, err := service.Confirmar(context.Background(), 3, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TravarItens", "Travar", "GetItem"}, ordem) // Item antes da reserva, como no pedido
+}
+
+func TestConfirmarReserva_Vencida(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewReservaService(d.itens, d.itemService(), d.reservas, d.transacoes, d.logger)
+	vencida := &reserva.Reserva{ID: 3, ItemID: 5, Quantidade: 2, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: time.Now().Add(-time.Second)}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.reservas.On("GetByID", mock.Anything, 3).Return(vencida, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{5}).Return(nil)
+	d.reservas.On("Travar", mock.Anything, 3).Return(vencida, nil)
+
+	// ACT
+	_, err := service.Confirmar(context.Background(), 3, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, reserva.ErrReservaEncerrada)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+	d.reservas.AssertNotCalled(t, "Concluir", mock.Anything, mock.Anything)
+}
+
+func TestConfirmarReserva_EstoqueAjustadoAbaixoDaReserva(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewReservaService(d.itens, d.itemService(), d.reservas, d.transacoes, d.logger)
+	ativa := &reserva.Reserva{ID: 3, ItemID: 5, Quantidade: 4, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: time.Now().Add(time.Minute)}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.reservas.On("GetByID", mock.Anything, 3).Return(ativa, nil)
+	d.reservas.On("Travar", mock.Anything, 3).Return(ativa, nil)
+	d.itens.On("TravarItens", mock.Anything, []int{5}).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(&entity.Item{ID: 5, Nome: "Mouse", Preco: dinheiro.Novo(2000, dinheiro.BRL), Estoque: 1}, nil)
+
+	// ACT
+	_, err := service.Confirmar(context.Background(), 3, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, reserva.ErrEstoqueIndisponivel)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
+
+func TestCancelarReserva_JaConfirmada(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewReservaService(d.itens, d.itemService(), d.reservas, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.reservas.On("Travar", mock.Anything, 3).Return(&reserva.Reserva{ID: 3, Status: reserva.StatusConfirmada}, nil)
+
+	// ACT
+	_, err := service.Cancelar(context.Background(), 3)
+
+	// ASSERT
+	assert.ErrorIs(t, err, reserva.ErrReservaEncerrada)
+	d.reservas.AssertNotCalled(t, "Concluir", mock.Anything, mock.Anything)
+}
+
+func TestExpirarVencidas(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewReservaService(d.itens, d.itemService(), d.reservas, d.transacoes, d.logger)
+
+	d.reservas.On("Expirar", mock.Anything, mock.AnythingOfType("time.Time")).Return(int64(2), nil).Once()
+
+	// ACT
+	err := service.ExpirarVencidas(context.Background())
+
+	// ASSERT
+	assert.NoError(t, err)
+}
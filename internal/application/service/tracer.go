@@ -0,0 +1,7 @@
+package service
+
+import "go.opentelemetry.io/otel"
+
+// tracer abre um span por método dos services. Sem exporter configurado
+// (OTEL_TRACES_EXPORTER=none) os spans não são gravados e custam quase nada.
+var tracer = otel.Tracer("desafio-itens-app/internal/application/service")
@@ -3,37 +3,44 @@ package service
 import (
 	"context"
 	"desafio-itens-app/internal/adapters/http/dto"
+	"desafio-itens-app/internal/application/ports/metrics"
 	"desafio-itens-app/internal/application/ports/repositories"
 	"desafio-itens-app/internal/application/ports/services"
 	userDomain "desafio-itens-app/internal/domain/user"
 	"errors"
 	"fmt"
 	"golang.org/x/crypto/bcrypt"
+	"log/slog"
 	"math"
 	"strings"
 )
 
 type userService struct {
-	repo repositories.UserRepository
+	repo     repositories.UserRepository
+	logger   *slog.Logger
+	metricas metrics.Metricas
 }
 
-func NewUserService(repo repositories.UserRepository) services.UserService {
-	return &userService{repo: repo}
+func NewUserService(repo repositories.UserRepository, logger *slog.Logger, metricas metrics.Metricas) services.UserService {
+	return &userService{repo: repo, logger: logger, metricas: metricas}
 }
 
-func (s *userService) CreateUser(user userDomain.User) (userDomain.User, error) {
+func (s *userService) CreateUser(ctx context.Context, user userDomain.User) (userDomain.User, error) {
+	ctx, span := tracer.Start(ctx, "userService.CreateUser")
+	defer span.End()
+
 	// PASSO 1: VALIDAR dados básicos
 	if err := user.IsValid(); err != nil {
 		return userDomain.User{}, err
 	}
 
 	// PASSO 2: VERIFICAR se username já existe
-	exists, err := s.repo.UserNameExists(user.Username)
+	exists, err := s.repo.UserNameExists(ctx, user.Username)
 	if err != nil {
 		return userDomain.User{}, fmt.Errorf("erro ao verificar username: %w", err)
 	}
 	if exists {
-		return userDomain.User{}, errors.New("username já está em uso")
+		return userDomain.User{}, userDomain.ErrUsernameEmUso
 	}
 
 	hashedPassword, err := s.hashPassword(user.Password)
@@ -42,23 +49,31 @@ func (s *userService) CreateUser(user userDomain.User) (userDomain.User, error)
 	}
 	user.Password = hashedPassword
 
-	createdUser, err := s.repo.Create(user)
+	createdUser, err := s.repo.Create(ctx, user)
 	if err != nil {
 		return userDomain.User{}, fmt.Errorf("erro ao criar o usuário: %w", err)
 	}
 
+	s.logger.InfoContext(ctx, "usuário criado", "user_id", createdUser.ID, "role", createdUser.Role)
+
 	return createdUser, nil
 }
 
-func (s *userService) GetUser(id int) (*userDomain.User, error) {
+func (s *userService) GetUser(ctx context.Context, id int) (*userDomain.User, error) {
+	ctx, span := tracer.Start(ctx, "userService.GetUser")
+	defer span.End()
+
 	if id <= 0 {
 		return nil, errors.New("ID deve ser maior que zero")
 	}
 
-	return s.repo.GetById(id)
+	return s.repo.GetById(ctx, id)
 }
 
 func (s *userService) ListUsers(ctx context.Context, page, limit int) (*dto.ListUsersResponse, error) {
+	ctx, span := tracer.Start(ctx, "userService.ListUsers")
+	defer span.End()
+
 	if page < 1 {
 		page = 1
 	}
@@ -96,59 +111,81 @@ func (s *userService) ListUsers(ctx context.Context, page, limit int) (*dto.List
 	}, nil
 }
 
-func (s *userService) GetUserByUsername(username string) (*userDomain.User, error) {
+func (s *userService) GetUserByUsername(ctx context.Context, username string) (*userDomain.User, error) {
+	ctx, span := tracer.Start(ctx, "userService.GetUserByUsername")
+	defer span.End()
+
 	username = strings.TrimSpace(username)
 
 	if username == "" {
 		return nil, errors.New("username não pode está vazio")
 	}
 
-	return s.repo.GetByUsername(username)
+	return s.repo.GetByUsername(ctx, username)
 }
 
-func (s *userService) UpdateUser(user userDomain.User) error {
+func (s *userService) UpdateUser(ctx context.Context, user userDomain.User) error {
+	ctx, span := tracer.Start(ctx, "userService.UpdateUser")
+	defer span.End()
+
 	if err := user.IsValid(); err != nil {
 		return err
 	}
 
-	existing, err := s.repo.GetById(user.ID)
+	existing, err := s.repo.GetById(ctx, user.ID)
 	if err != nil {
 		return err
 	}
 
 	if existing.Username != user.Username {
-		exists, err := s.repo.UserNameExists(user.Username)
+		exists, err := s.repo.UserNameExists(ctx, user.Username)
 		if err != nil {
 			return fmt.Errorf("erro ao verificar username: %w", err)
 		}
 		if exists {
-			return errors.New("username já está em uso")
+			return userDomain.ErrUsernameEmUso
 		}
 	}
 
-	return s.repo.Update(user)
+	return s.repo.Update(ctx, user)
 }
 
-func (s *userService) DeleteUser(id int) error {
+func (s *userService) DeleteUser(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "userService.DeleteUser")
+	defer span.End()
+
 	if id <= 0 {
 		return errors.New("ID deve ser maior que zero")
 	}
 
-	return s.repo.Delete(id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "usuário deletado", "user_id", id)
+	return nil
 }
 
-func (s *userService) ValidateCredentials(username, password string) (*userDomain.User, error) {
-	user, err := s.repo.GetByUsername(username)
+func (s *userService) ValidateCredentials(ctx context.Context, username, password string) (*userDomain.User, error) {
+	ctx, span := tracer.Start(ctx, "userService.ValidateCredentials")
+	defer span.End()
+
+	user, err := s.repo.GetByUsername(ctx, username)
 	if err != nil {
 		// SEGURANÇA: Não revela se usuário existe ou não
+		s.logger.WarnContext(ctx, "login falhou", "username", username, "motivo", "usuário não encontrado")
+		s.metricas.LoginRealizado(false)
 		return nil, errors.New("credenciais inválidas")
 	}
 
 	// PASSO 2: VERIFICAR se senha está correta
 	if !s.checkPassword(password, user.Password) {
+		s.logger.WarnContext(ctx, "login falhou", "username", username, "motivo", "senha incorreta")
+		s.metricas.LoginRealizado(false)
 		return nil, errors.New("credenciais inválidas")
 	}
 	// PASSO 3: CREDENCIAIS CORRETAS - retorna usuário
+	s.metricas.LoginRealizado(true)
 	return user, nil
 }
 
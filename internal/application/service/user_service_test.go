@@ -1,20 +1,23 @@
 package service
 
 import (
+	"context"
+	"desafio-itens-app/internal/application/ports/metrics"
 	"desafio-itens-app/internal/application/service/mocks"
 	domain "desafio-itens-app/internal/domain/user"
 	"errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
+	"log/slog"
 	"testing"
 )
 
 func TestUserService_CreateUser_Success(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("UserNameExists", "userexistente").Return(false, nil)
-	mockRepo.On("Create", mock.MatchedBy(func(user domain.User) bool {
+	mockRepo.On("UserNameExists", mock.Anything, "userexistente").Return(false, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(user domain.User) bool {
 		return user.Username == "userexistente" && user.Email == "teste@email.com"
 	})).Return(domain.User{
 		ID:       1,
@@ -24,7 +27,7 @@ func TestUserService_CreateUser_Success(t *testing.T) {
 		Role:     domain.RoleUser,
 	}, nil)
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	testUser := domain.User{
 		Username: "userexistente",
@@ -34,7 +37,7 @@ func TestUserService_CreateUser_Success(t *testing.T) {
 	}
 
 	//ACT
-	result, err := service.CreateUser(testUser)
+	result, err := service.CreateUser(context.Background(), testUser)
 
 	// ASSERT
 	assert.NoError(t, err)
@@ -47,7 +50,7 @@ func TestUserService_CreateUser_Success(t *testing.T) {
 func TestUserService_CreateUser_InvalidUser(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	testUser := domain.User{
 		Username: "",
@@ -57,7 +60,7 @@ func TestUserService_CreateUser_InvalidUser(t *testing.T) {
 	}
 
 	//ACT
-	result, err := service.CreateUser(testUser)
+	result, err := service.CreateUser(context.Background(), testUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -68,9 +71,9 @@ func TestUserService_CreateUser_InvalidUser(t *testing.T) {
 func TestUserService_CreateUser_UsernameExists(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("UserNameExists", "userexistente").Return(true, nil)
+	mockRepo.On("UserNameExists", mock.Anything, "userexistente").Return(true, nil)
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	testUser := domain.User{
 		Username: "userexistente",
@@ -80,7 +83,7 @@ func TestUserService_CreateUser_UsernameExists(t *testing.T) {
 	}
 
 	//ACT
-	result, err := service.CreateUser(testUser)
+	result, err := service.CreateUser(context.Background(), testUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -92,7 +95,7 @@ func TestUserService_CreateUser_UsernameExists(t *testing.T) {
 func TestUserService_CreateUser_UserNameExistsError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("UserNameExists", "Bonfim").Return(false, errors.New("erro ao verificar username"))
+	mockRepo.On("UserNameExists", mock.Anything, "Bonfim").Return(false, errors.New("erro ao verificar username"))
 
 	testUser := domain.User{
 		Username: "Bonfim",
@@ -101,10 +104,10 @@ func TestUserService_CreateUser_UserNameExistsError(t *testing.T) {
 		Role:     domain.RoleUser,
 	}
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.CreateUser(testUser)
+	result, err := service.CreateUser(context.Background(), testUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -116,10 +119,10 @@ func TestUserService_CreateUser_UserNameExistsError(t *testing.T) {
 func TestUserService_CreateUser_RepositoryCreateError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("UserNameExists", "Bonfim").Return(false, nil)
-	mockRepo.On("Create", mock.Anything).Return(domain.User{}, errors.New("erro ao criar usuário no banco"))
+	mockRepo.On("UserNameExists", mock.Anything, "Bonfim").Return(false, nil)
+	mockRepo.On("Create", mock.Anything, mock.Anything).Return(domain.User{}, errors.New("erro ao criar usuário no banco"))
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	testUser := domain.User{
 		Username: "Bonfim",
@@ -129,7 +132,7 @@ func TestUserService_CreateUser_RepositoryCreateError(t *testing.T) {
 	}
 
 	//ACT
-	result, err := service.CreateUser(testUser)
+	result, err := service.CreateUser(context.Background(), testUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -146,11 +149,11 @@ func TestUserService_GetUser_Success(t *testing.T) {
 		Email:    "test@email.com",
 		Role:     domain.RoleUser,
 	}
-	mockRepo.On("GetById", 1).Return(expectedUser, nil)
-	service := NewUserService(mockRepo)
+	mockRepo.On("GetById", mock.Anything, 1).Return(expectedUser, nil)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetUser(1)
+	result, err := service.GetUser(context.Background(), 1)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -162,10 +165,10 @@ func TestUserService_GetUser_Success(t *testing.T) {
 func TestUserService_GetUser_InvalidID(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetUser(0)
+	result, err := service.GetUser(context.Background(), 0)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -178,12 +181,12 @@ func TestUserService_GetUser_InvalidID(t *testing.T) {
 func TestUserService_GetUser_RepositoryError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("GetById", 1).Return((*domain.User)(nil),
+	mockRepo.On("GetById", mock.Anything, 1).Return((*domain.User)(nil),
 		errors.New("erro ao buscar usuário no banco"))
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetUser(1)
+	result, err := service.GetUser(context.Background(), 1)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -201,11 +204,11 @@ func TestUserService_GetUserByUsername_Success(t *testing.T) {
 		Email:    "test@email.com",
 		Role:     domain.RoleUser,
 	}
-	mockRepo.On("GetByUsername", "testuser").Return(expectedUser, nil)
-	service := NewUserService(mockRepo)
+	mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(expectedUser, nil)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetUserByUsername("testuser")
+	result, err := service.GetUserByUsername(context.Background(), "testuser")
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -217,10 +220,10 @@ func TestUserService_GetUserByUsername_Success(t *testing.T) {
 func TestUserService_GetUserByUsername_EmptyUsername(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetUserByUsername("")
+	result, err := service.GetUserByUsername(context.Background(), "")
 
 	//ASSERT
 	assert.Error(t, err)
@@ -232,12 +235,12 @@ func TestUserService_GetUserByUsername_EmptyUsername(t *testing.T) {
 func TestUserService_GetUserByUsername_RepositoryError(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("GetByUsername", "testuser").Return((*domain.User)(nil),
+	mockRepo.On("GetByUsername", mock.Anything, "testuser").Return((*domain.User)(nil),
 		errors.New("erro ao buscar usuário"))
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.GetUserByUsername("testuser")
+	result, err := service.GetUserByUsername(context.Background(), "testuser")
 
 	//ASSERT
 	assert.Error(t, err)
@@ -256,13 +259,13 @@ func TestUserService_UpdateUser_Success(t *testing.T) {
 		Role:     domain.RoleUser,
 	}
 
-	mockRepo.On("GetById", 1).Return(existingUser, nil)
-	mockRepo.On("UserNameExists", "newuser").Return(false, nil)
-	mockRepo.On("Update", mock.MatchedBy(func(user domain.User) bool {
+	mockRepo.On("GetById", mock.Anything, 1).Return(existingUser, nil)
+	mockRepo.On("UserNameExists", mock.Anything, "newuser").Return(false, nil)
+	mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(user domain.User) bool {
 		return user.ID == 1 && user.Username == "newuser" && user.Email == "new@email.com"
 	})).Return(nil)
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	updateUser := domain.User{
 		ID:       1,
@@ -273,7 +276,7 @@ func TestUserService_UpdateUser_Success(t *testing.T) {
 	}
 
 	//ACT
-	err := service.UpdateUser(updateUser)
+	err := service.UpdateUser(context.Background(), updateUser)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -283,7 +286,7 @@ func TestUserService_UpdateUser_Success(t *testing.T) {
 func TestUserService_UpdateUser_InvalidUser(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	invalidUser := domain.User{
 		ID:       1,
@@ -294,7 +297,7 @@ func TestUserService_UpdateUser_InvalidUser(t *testing.T) {
 	}
 
 	//ACT
-	err := service.UpdateUser(invalidUser)
+	err := service.UpdateUser(context.Background(), invalidUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -304,9 +307,9 @@ func TestUserService_UpdateUser_InvalidUser(t *testing.T) {
 func TestUserService_UpdateUser_UserNotFound(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("GetById", 1).Return((*domain.User)(nil), errors.New("usuário não encontrado"))
+	mockRepo.On("GetById", mock.Anything, 1).Return((*domain.User)(nil), errors.New("usuário não encontrado"))
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	updateUser := domain.User{
 		ID:       1,
@@ -317,7 +320,7 @@ func TestUserService_UpdateUser_UserNotFound(t *testing.T) {
 	}
 
 	//ACT
-	err := service.UpdateUser(updateUser)
+	err := service.UpdateUser(context.Background(), updateUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -335,10 +338,10 @@ func TestUserService_UpdateUser_UsernameExists(t *testing.T) {
 		Role:     domain.RoleUser,
 	}
 
-	mockRepo.On("GetById", 1).Return(existingUser, nil)
-	mockRepo.On("UserNameExists", "newuser").Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, 1).Return(existingUser, nil)
+	mockRepo.On("UserNameExists", mock.Anything, "newuser").Return(true, nil)
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	updateUser := domain.User{
 		ID:       1,
@@ -349,7 +352,7 @@ func TestUserService_UpdateUser_UsernameExists(t *testing.T) {
 	}
 
 	//ACT
-	err := service.UpdateUser(updateUser)
+	err := service.UpdateUser(context.Background(), updateUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -368,10 +371,10 @@ func TestUserService_UpdateUser_RepositoryError(t *testing.T) {
 		Role:     domain.RoleUser,
 	}
 
-	mockRepo.On("GetById", 1).Return(existingUser, nil)
-	mockRepo.On("Update", mock.Anything).Return(errors.New("erro ao atualizar usuário no banco"))
+	mockRepo.On("GetById", mock.Anything, 1).Return(existingUser, nil)
+	mockRepo.On("Update", mock.Anything, mock.Anything).Return(errors.New("erro ao atualizar usuário no banco"))
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	updateUser := domain.User{
 		ID:       1,
@@ -382,7 +385,7 @@ func TestUserService_UpdateUser_RepositoryError(t *testing.T) {
 	}
 
 	//ACT
-	err := service.UpdateUser(updateUser)
+	err := service.UpdateUser(context.Background(), updateUser)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -392,11 +395,11 @@ func TestUserService_UpdateUser_RepositoryError(t *testing.T) {
 func TestUserService_DeleteUser_Success(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("Delete", 1).Return(nil)
-	service := NewUserService(mockRepo)
+	mockRepo.On("Delete", mock.Anything, 1).Return(nil)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	err := service.DeleteUser(1)
+	err := service.DeleteUser(context.Background(), 1)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -406,10 +409,10 @@ func TestUserService_DeleteUser_Success(t *testing.T) {
 func TestUserService_DeleteUser_InvalidID(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	err := service.DeleteUser(0)
+	err := service.DeleteUser(context.Background(), 0)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -421,11 +424,11 @@ func TestUserService_DeleteUser_RepositoryError(t *testing.T) {
 
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("Delete", 1).Return(errors.New("erro ao deletar usuário"))
-	service := NewUserService(mockRepo)
+	mockRepo.On("Delete", mock.Anything, 1).Return(errors.New("erro ao deletar usuário"))
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	err := service.DeleteUser(1)
+	err := service.DeleteUser(context.Background(), 1)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -443,33 +446,39 @@ func TestUserService_ValidateCredentials_Success(t *testing.T) {
 		Password: string(hashedPassword),
 		Role:     domain.RoleUser,
 	}
-	mockRepo.On("GetByUsername", "testuser").Return(expectedUser, nil)
-	service := NewUserService(mockRepo)
+	mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(expectedUser, nil)
+	mockMetricas := mocks.NewMetricas(t)
+	mockMetricas.On("LoginRealizado", true).Return()
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), mockMetricas)
 
 	//ACT
-	result, err := service.ValidateCredentials("testuser", "123456")
+	result, err := service.ValidateCredentials(context.Background(), "testuser", "123456")
 
 	//ASSERT
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, expectedUser, result)
 	mockRepo.AssertExpectations(t)
+	mockMetricas.AssertExpectations(t)
 }
 
 func TestUserService_ValidateCredentials_UserNotFound(t *testing.T) {
 	//ARRANGE
 	mockRepo := mocks.NewUserRepository(t)
-	mockRepo.On("GetByUsername", "testuser").Return((*domain.User)(nil), errors.New("usuário não encontrado"))
-	service := NewUserService(mockRepo)
+	mockRepo.On("GetByUsername", mock.Anything, "testuser").Return((*domain.User)(nil), errors.New("usuário não encontrado"))
+	mockMetricas := mocks.NewMetricas(t)
+	mockMetricas.On("LoginRealizado", false).Return()
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), mockMetricas)
 
 	//ACT
-	result, err := service.ValidateCredentials("testuser", "123456")
+	result, err := service.ValidateCredentials(context.Background(), "testuser", "123456")
 
 	//ASSERT
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "credenciais inválidas")
 	mockRepo.AssertExpectations(t)
+	mockMetricas.AssertExpectations(t)
 }
 
 func TestUserService_ValidateCredentials_WrongPassword(t *testing.T) {
@@ -483,17 +492,20 @@ func TestUserService_ValidateCredentials_WrongPassword(t *testing.T) {
 		Password: string(hashedPassword),
 		Role:     domain.RoleUser,
 	}
-	mockRepo.On("GetByUsername", "testuser").Return(user, nil)
-	service := NewUserService(mockRepo)
+	mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(user, nil)
+	mockMetricas := mocks.NewMetricas(t)
+	mockMetricas.On("LoginRealizado", false).Return()
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), mockMetricas)
 
 	//ACT
-	result, err := service.ValidateCredentials("testuser", "wrongpassword")
+	result, err := service.ValidateCredentials(context.Background(), "testuser", "wrongpassword")
 
 	//ASSERT
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "credenciais inválidas")
 	mockRepo.AssertExpectations(t)
+	mockMetricas.AssertExpectations(t)
 }
 
 func TestUserService_ListUsers_Success(t *testing.T) {
@@ -504,10 +516,10 @@ func TestUserService_ListUsers_Success(t *testing.T) {
 		{ID: 2, Username: "user2", Email: "user2@test.com", Role: domain.RoleAdmin},
 	}
 	mockRepo.On("List", mock.Anything, 10, 0).Return(users, int64(2), nil)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.ListUsers(nil, 1, 10)
+	result, err := service.ListUsers(context.Background(), 1, 10)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -525,10 +537,10 @@ func TestUserService_ListUsers_DefaultPagination(t *testing.T) {
 	mockRepo := mocks.NewUserRepository(t)
 	users := []*domain.User{}
 	mockRepo.On("List", mock.Anything, 10, 0).Return(users, int64(0), nil)
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.ListUsers(nil, 0, 0)
+	result, err := service.ListUsers(context.Background(), 0, 0)
 
 	//ASSERT
 	assert.NoError(t, err)
@@ -543,10 +555,10 @@ func TestUserService_ListUsers_RepositoryError(t *testing.T) {
 	mockRepo := mocks.NewUserRepository(t)
 	mockRepo.On("List", mock.Anything, 10, 0).Return([]*domain.User{}, int64(0), errors.New("erro ao listar usuários"))
 
-	service := NewUserService(mockRepo)
+	service := NewUserService(mockRepo, slog.New(slog.DiscardHandler), metrics.Nop{})
 
 	//ACT
-	result, err := service.ListUsers(nil, 1, 10)
+	result, err := service.ListUsers(context.Background(), 1, 10)
 
 	//ASSERT
 	assert.Error(t, err)
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+)
+
+type varianteService struct {
+	repoItens repositories.ItemRepository
+	itens     services.ItemService // Variantes são itens: criação e preço passam pelas regras dele
+	logger    *slog.Logger
+}
+
+func NewVarianteService(repoItens repositories.ItemRepository, itens services.ItemService, logger *slog.Logger) *varianteService {
+	return &varianteService{repoItens: repoItens, itens: itens, logger: logger}
+}
+
+func (s *varianteService) ListarProdutos(ctx context.Context, page, pageSize int) ([]entity.Produto, int, error) {
+	ctx, span := tracer.Start(ctx, "varianteService.ListarProdutos", trace.WithAttributes(attribute.Int("page", page), attribute.Int("page_size", pageSize)))
+	defer span.End()
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	itens, total, err := s.repoItens.ListarProdutos(ctx, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 📦 Uma consulta só para as variantes da página inteira
+	ids := make([]int, 0, len(itens))
+	for _, it := range itens {
+		if it.TemEixos() {
+			ids = append(ids, it.ID)
+		}
+	}
+	variantes, err := s.repoItens.ListarVariantes(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	porProduto := make(map[int][]entity.Item, len(ids))
+	for _, v := range variantes {
+		porProduto[*v.ProdutoID] = append(porProduto[*v.ProdutoID], v)
+	}
+
+	produtos := make([]entity.Produto, 0, len(itens))
+	for _, it := range itens {
+		produtos = append(produtos, entity.Produto{Item: it, Variantes: porProduto[it.ID]})
+	}
+	return produtos, total, nil
+}
+
+func (s *varianteService) Variantes(ctx context.Context, produtoID int) ([]entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "varianteService.Variantes", trace.WithAttributes(attribute.Int("item.id", produtoID)))
+	defer span.End()
+
+	return s.repoItens.ListarVariantes(ctx, []int{produtoID})
+}
+
+func (s *varianteService) DefinirEixos(ctx context.Context, produto entity.Item, eixos []entity.Eixo, usuarioID int) (entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "varianteService.DefinirEixos", trace.WithAttributes(attribute.Int("item.id", produto.ID)))
+	defer span.End()
+
+	// PASSO 1: Variante não vira produto (sem netos)
+	if produto.EhVariante() {
+		return entity.Item{}, fmt.Errorf("%w: o item %d já é variante de outro produto", entity.ErrProdutoInvalido, produto.ID)
+	}
+	eixos = entity.NormalizarEixos(eixos)
+	if err := entity.ValidarEixos(eixos); err != nil {
+		return entity.Item{}, err
+	}
+
+	// PASSO 2: Gravar pelo itemService (status, alertas e preço das
+	// variantes), sobre o produto travado e relido
+	produto, err := s.itens.AlterarItem(ctx, produto.ID, func(produto *entity.Item) error {
+		// As variantes existentes continuam cabendo nos novos eixos
+		variantes, err := s.repoItens.ListarVariantes(ctx, []int{produto.ID})
+		if err != nil {
+			return err
+		}
+		produto.Eixos = eixos
+		for _, v := range variantes {
+			if len(eixos) == 0 {
+				return fmt.Errorf("%w: o item tem %d variantes, não dá para tirar os eixos", entity.ErrProdutoInvalido, len(variantes))
+			}
+			if _, err := produto.NormalizarOpcoes(v.Opcoes); err != nil {
+				return fmt.Errorf("a variante %s deixaria de valer: %w", v.Code, err)
+			}
+		}
+
+		if len(eixos) == 0 {
+			produto.Eixos = nil
+		}
+		produto.UpdateBy = &usuarioID
+		return nil
+	})
+	if err != nil {
+		return entity.Item{}, err
+	}
+
+	s.logger.InfoContext(ctx, "eixos do produto definidos", "item_id", produto.ID, "eixos", len(eixos), "updated_by", usuarioID)
+	return produto, nil
+}
+
+func (s *varianteService) CriarVariante(ctx context.Context, produto entity.Item, opcoes map[string]string, preco *dinheiro.Dinheiro, estoque, usuarioID int) (entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "varianteService.CriarVariante", trace.WithAttributes(attribute.Int("item.id", produto.ID)))
+	defer span.End()
+
+	opcoes, err := produto.NormalizarOpcoes(opcoes)
+	if err != nil {
+		return entity.Item{}, err
+	}
+	existentes, err := s.chavesExistentes(ctx, produto)
+	if err != nil {
+		return entity.Item{}, err
+	}
+	if existentes[entity.ChaveOpcoes(opcoes)] {
+		return entity.Item{}, entity.ErrVarianteDuplicada
+	}
+	if len(existentes) >= entity.MaxVariantes {
+		return entity.Item{}, fmt.Errorf("%w: o produto já tem %d variantes", entity.ErrProdutoInvalido, entity.MaxVariantes)
+	}
+
+	variante := produto.NovaVariante(opcoes, estoque)
+	if preco != nil {
+		if preco.Moeda == "" {
+			preco.Moeda = produto.Preco.Moeda
+		}
+		variante.Preco = *preco
+		variante.PrecoProprio = true
+	}
+	return s.criar(ctx, produto, variante, usuarioID)
+}
+
+func (s *varianteService) GerarVariantes(ctx context.Context, produto entity.Item, usuarioID int) ([]entity.Item, error) {
+	ctx, span := tracer.Start(ctx, "varianteService.GerarVariantes", trace.WithAttributes(attribute.Int("item.id", produto.ID)))
+	defer span.End()
+
+	if !produto.TemEixos() {
+		return nil, fmt.Errorf("%w: o item %d não tem eixos de variação", entity.ErrProdutoInvalido, produto.ID)
+	}
+	existentes, err := s.chavesExistentes(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	var faltando []map[string]string
+	for _, opcoes := range produto.Combinacoes() {
+		if !existentes[entity.ChaveOpcoes(opcoes)] {
+			faltando = append(faltando, opcoes)
+		}
+	}
+	if len(existentes)+len(faltando) > entity.MaxVariantes {
+		return nil, fmt.Errorf("%w: a grade teria %d variantes (máximo %d)", entity.ErrProdutoInvalido, len(existentes)+len(faltando), entity.MaxVariantes)
+	}
+
+	criadas := make([]entity.Item, 0, len(faltando))
+	for _, opcoes := range faltando {
+		variante, err := s.criar(ctx, produto, produto.NovaVariante(opcoes, 0), usuarioID)
+		if err != nil {
+			return criadas, err // As já criadas ficam; gerar de novo completa o resto
+		}
+		criadas = append(criadas, variante)
+	}
+	return criadas, nil
+}
+
+// chavesExistentes lê as combinações que o produto já tem
+func (s *varianteService) chavesExistentes(ctx context.Context, produto entity.Item) (map[string]bool, error) {
+	if produto.EhVariante() {
+		return nil, fmt.Errorf("%w: o item %d é uma variante", entity.ErrProdutoInvalido, produto.ID)
+	}
+	variantes, err := s.repoItens.ListarVariantes(ctx, []int{produto.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	chaves := make(map[string]bool, len(variantes))
+	for _, v := range variantes {
+		chaves[entity.ChaveOpcoes(v.Opcoes)] = true
+	}
+	return chaves, nil
+}
+
+func (s *varianteService) criar(ctx context.Context, produto, variante entity.Item, usuarioID int) (entity.Item, error) {
+	variante.Code = produto.CodigoVariante(variante.Opcoes) // Base; o itemService garante que é único
+	variante.CreatedBy = &usuarioID
+
+	criada, err := s.itens.AddItem(ctx, variante)
+	if err != nil {
+		return entity.Item{}, err
+	}
+
+	s.logger.InfoContext(ctx, "variante criada",
+		"item_id", criada.ID,
+		"produto_id", produto.ID,
+		"code", criada.Code)
+	return criada, nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func produtoCamiseta() entity.Item {
+	return entity.Item{
+		ID:      3,
+		Code:    "CA12345678",
+		Nome:    "Camiseta",
+		Preco:   dinheiro.Novo(4990, dinheiro.BRL),
+		Estoque: 0,
+		Status:  entity.StatusInativo,
+		Eixos: []entity.Eixo{
+			{Nome: "Tamanho", Valores: []string{"P", "M"}},
+			{Nome: "Cor", Valores: []string{"Azul", "Preto"}},
+		},
+	}
+}
+
+func varianteDe(produto entity.Item, id int, opcoes map[string]string) entity.Item {
+	v := produto.NovaVariante(opcoes, 1)
+	v.ID = id
+	return v
+}
+
+func TestCriarVariante_CodigoDerivadoDoProduto(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	produto := produtoCamiseta()
+
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).Return(nil, nil)
+	d.itens.On("CodeExists", mock.Anything, "CA12345678-M-AZU").Return(false, nil)
+	d.itens.On("AddItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Code == "CA12345678-M-AZU" && i.Nome == "Camiseta M Azul" && *i.ProdutoID == 3 &&
+			i.Preco == produto.Preco && !i.PrecoProprio && i.Status == entity.StatusAtivo
+	})).Return(func(_ context.Context, i entity.Item) (entity.Item, error) {
+		i.ID = 10
+		return i, nil
+	}).Once()
+
+	// ACT
+	variante, err := service.CriarVariante(context.Background(), produto, map[string]string{"cor": "azul", "tamanho": "m"}, nil, 5, 1)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 10, variante.ID)
+	assert.Equal(t, map[string]string{"Cor": "Azul", "Tamanho": "M"}, variante.Opcoes)
+}
+
+func TestCriarVariante_PrecoProprio(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	preco := dinheiro.Novo(5490, "")
+
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).Return(nil, nil)
+	d.itens.On("CodeExists", mock.Anything, mock.Anything).Return(false, nil)
+	d.itens.On("AddItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.PrecoProprio && i.Preco == dinheiro.Novo(5490, dinheiro.BRL) // Moeda do produto
+	})).Return(func(_ context.Context, i entity.Item) (entity.Item, error) { return i, nil }).Once()
+
+	// ACT
+	_, err := service.CriarVariante(context.Background(), produtoCamiseta(), map[string]string{"Cor": "Preto", "Tamanho": "P"}, &preco, 0, 1)
+
+	// ASSERT
+	assert.NoError(t, err)
+}
+
+func TestCriarVariante_CombinacaoRepetida(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	produto := produtoCamiseta()
+
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).
+		Return([]entity.Item{varianteDe(produto, 10, map[string]string{"Tamanho": "M", "Cor": "Azul"})}, nil)
+
+	// ACT
+	_, err := service.CriarVariante(context.Background(), produto, map[string]string{"Cor": "AZUL", "Tamanho": "m"}, nil, 0, 1)
+
+	// ASSERT
+	assert.ErrorIs(t, err, entity.ErrVarianteDuplicada)
+	d.itens.AssertNotCalled(t, "AddItem", mock.Anything, mock.Anything)
+}
+
+func TestCriarVariante_CodigoRepetidoGanhaSufixo(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	produto := produtoCamiseta()
+	produto.Eixos[1].Valores = []string{"Azul", "Azul-claro"}
+
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).Return(nil, nil)
+	d.itens.On("CodeExists", mock.Anything, "CA12345678-P-AZU").Return(true, nil)
+	d.itens.On("CodeExists", mock.Anything, "CA12345678-P-AZU-2").Return(false, nil)
+	d.itens.On("AddItem", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, i entity.Item) (entity.Item, error) { return i, nil }).Once()
+
+	// ACT
+	variante, err := service.CriarVariante(context.Background(), produto, map[string]string{"Cor": "Azul-claro", "Tamanho": "P"}, nil, 0, 1)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, "CA12345678-P-AZU-2", variante.Code)
+}
+
+func TestGerarVariantes_SoAsQueFaltam(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	produto := produtoCamiseta()
+
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).
+		Return([]entity.Item{varianteDe(produto, 10, map[string]string{"Tamanho": "M", "Cor": "Azul"})}, nil)
+	d.itens.On("CodeExists", mock.Anything, mock.Anything).Return(false, nil)
+	d.itens.On("AddItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 0 && i.Status == entity.StatusInativo
+	})).Return(func(_ context.Context, i entity.Item) (entity.Item, error) { return i, nil }).Times(3)
+
+	// ACT
+	criadas, err := service.GerarVariantes(context.Background(), produto, 1)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, criadas, 3)
+}
+
+func TestDefinirEixos_VarianteExistenteFicariaInvalida(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	produto := produtoCamiseta()
+
+	d.itens.On("TravarItens", mock.Anything, []int{3}).Return(nil)
+	d.itens.On("GetItem", mock.Anything, 3).Return(&produto, nil)
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).
+		Return([]entity.Item{varianteDe(produto, 10, map[string]string{"Tamanho": "M", "Cor": "Azul"})}, nil)
+
+	// ACT: tirar "Azul" deixaria a variante 10 sem valor válido
+	_, err := service.DefinirEixos(context.Background(), produto, []entity.Eixo{
+		{Nome: "Tamanho", Valores: []string{"P", "M"}},
+		{Nome: "Cor", Valores: []string{"Preto"}},
+	}, 1)
+
+	// ASSERT
+	assert.ErrorIs(t, err, entity.ErrVarianteInvalida)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
+
+func TestDefinirEixos_VarianteNaoViraProduto(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	variante := varianteDe(produtoCamiseta(), 10, map[string]string{"Tamanho": "M", "Cor": "Azul"})
+
+	// ACT
+	_, err := service.DefinirEixos(context.Background(), variante, []entity.Eixo{{Nome: "Manga", Valores: []string{"Curta"}}}, 1)
+
+	// ASSERT
+	assert.ErrorIs(t, err, entity.ErrProdutoInvalido)
+}
+
+func TestListarProdutos_AgrupaVariantes(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVarianteService(d.itens, d.itemService(), d.logger)
+	produto := produtoCamiseta()
+	avulso := entity.Item{ID: 4, Nome: "Caneca"}
+
+	d.itens.On("ListarProdutos", mock.Anything, 0, 10).Return([]entity.Item{produto, avulso}, 2, nil)
+	d.itens.On("ListarVariantes", mock.Anything, []int{3}).Return([]entity.Item{
+		varianteDe(produto, 10, map[string]string{"Tamanho": "M", "Cor": "Azul"}),
+		varianteDe(produto, 11, map[string]string{"Tamanho": "P", "Cor": "Azul"}),
+	}, nil)
+
+	// ACT
+	produtos, total, err := service.ListarProdutos(context.Background(), 1, 10)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, produtos[0].Variantes, 2)
+	assert.Empty(t, produtos[1].Variantes)
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/application/ports/repositories"
+	"desafio-itens-app/internal/application/ports/services"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/reserva"
+	"desafio-itens-app/internal/domain/venda"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+type vendaService struct {
+	repoItens  repositories.ItemRepository
+	itens      services.ItemService // Baixa e devolução seguem as regras do UpdateItem
+	repo       repositories.PedidoVendaRepository
+	promocoes  services.PromocaoService // O preço guardado na linha já tem as promoções
+	reservas   repositories.ReservaRepository
+	transacoes repositories.Transacionador
+	logger     *slog.Logger
+}
+
+func NewVendaService(repoItens repositories.ItemRepository, itens services.ItemService, repo repositories.PedidoVendaRepository, promocoes services.PromocaoService, reservas repositories.ReservaRepository, transacoes repositories.Transacionador, logger *slog.Logger) *vendaService {
+	return &vendaService{repoItens: repoItens, itens: itens, repo: repo, promocoes: promocoes, reservas: reservas, transacoes: transacoes, logger: logger}
+}
+
+// Criar grava o pedido como pendente, com nome e preço efetivo de cada item
+// naquele momento. O estoque só é conferido aqui; a baixa é na confirmação.
+// As reservas do próprio comprador não contam contra ele.
+func (s *vendaService) Criar(ctx context.Context, usuarioID int, linhas []venda.Linha) (venda.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "vendaService.Criar", trace.WithAttributes(attribute.Int("linhas", len(linhas))))
+	defer span.End()
+
+	itens := make([]entity.Item, 0, len(linhas))
+	ids := make([]int, 0, len(linhas))
+	for i, l := range linhas {
+		item, err := s.repoItens.GetItem(ctx, l.ItemID)
+		if err != nil {
+			return venda.Pedido{}, fmt.Errorf("%w: linha %d: %v", venda.ErrPedidoInvalido, i+1, err)
+		}
+		itens = append(itens, *item)
+		ids = append(ids, item.ID)
+	}
+
+	precos, err := s.promocoes.Aplicar(ctx, itens)
+	if err != nil {
+		return venda.Pedido{}, err
+	}
+	reservados, err := s.reservas.ReservadosPorOutros(ctx, ids, usuarioID, time.Now())
+	if err != nil {
+		return venda.Pedido{}, err
+	}
+
+	completas := make([]venda.Linha, len(linhas))
+	for i, item := range itens {
+		if err := venda.VerificarItem(item, linhas[i].Quantidade, reserva.Disponivel(item.Estoque, reservados[item.ID])); err != nil {
+			return venda.Pedido{}, err
+		}
+		completas[i] = venda.Linha{
+			ItemID:        item.ID,
+			Code:          item.Code,
+			Nome:          item.Nome,
+			Quantidade:    linhas[i].Quantidade,
+			PrecoUnitario: precos[i].PrecoEfetivo,
+		}
+	}
+
+	novo, err := venda.NovoPedido(usuarioID, completas, time.Now())
+	if err != nil {
+		return venda.Pedido{}, err
+	}
+	criado, err := s.repo.Criar(ctx, novo)
+	if err != nil {
+		return venda.Pedido{}, err
+	}
+
+	s.logger.InfoContext(ctx, "pedido criado", "pedido_id", criado.ID, "usuario_id", usuarioID, "linhas", len(criado.Linhas))
+	return criado, nil
+}
+
+func (s *vendaService) Buscar(ctx context.Context, id int) (*venda.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "vendaService.Buscar")
+	defer span.End()
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *vendaService) ListarDoUsuario(ctx context.Context, usuarioID, page, pageSize int) ([]venda.Pedido, int, error) {
+	ctx, span := tracer.Start(ctx, "vendaService.ListarDoUsuario")
+	defer span.End()
+
+	return s.repo.ListarPorUsuario(ctx, usuarioID, (page-1)*pageSize, pageSize)
+}
+
+// Confirmar baixa o estoque de todas as linhas numa transação só: ou o
+// pedido inteiro sai, ou nada muda. Os itens ficam travados até o commit,
+// então duas confirmações não vendem a mesma unidade. Reservas vigentes de
+// outros clientes continuam protegidas; as do comprador nesses itens viram
+// confirmadas até a quantidade de cada linha, porque o pedido levou o
+// estoque que elas seguravam.
+func (s *vendaService) Confirmar(ctx context.Context, id, usuarioID int) (venda.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "vendaService.Confirmar", trace.WithAttributes(attribute.Int("pedido.id", id)))
+	defer span.End()
+
+	var confirmado venda.Pedido
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		p, err := s.repo.Travar(ctx, id)
+		if err != nil {
+			return err
+		}
+		agora := time.Now()
+		if err := p.Confirmar(agora); err != nil {
+			return err
+		}
+
+		// 🔒 Trava antes de ler: o estoque lido é o que vai ser baixado
+		if err := s.repo.TravarItens(ctx, p.ItemIDs()); err != nil {
+			return err
+		}
+		reservados, err := s.reservas.ReservadosPorOutros(ctx, p.ItemIDs(), p.UsuarioID, agora)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range p.Linhas {
+			item, err := s.repoItens.GetItem(ctx, l.ItemID)
+			if err != nil {
+				return fmt.Errorf("item %d: %w", l.ItemID, err)
+			}
+			if err := venda.VerificarItem(*item, l.Quantidade, reserva.Disponivel(item.Estoque, reservados[item.ID])); err != nil {
+				return err
+			}
+			if err := s.moverEstoque(ctx, *item, -l.Quantidade, usuarioID); err != nil {
+				return err
+			}
+		}
+		if err := s.consumirReservas(ctx, *p, agora); err != nil {
+			return err
+		}
+
+		confirmado = *p
+		return s.repo.Atualizar(ctx, confirmado)
+	})
+	if err != nil {
+		return venda.Pedido{}, fmt.Errorf("Erro ao confirmar pedido: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "pedido confirmado", "pedido_id", confirmado.ID, "updated_by", usuarioID)
+	return confirmado, nil
+}
+
+// consumirReservas confirma as reservas vigentes do comprador até a quantidade
+// de cada linha, das que vencem primeiro às últimas. A reserva que passa da
+// quantidade é dividida: a parte levada sai confirmada e o resto segue
+// segurando estoque para ele.
+func (s *vendaService) consumirReservas(ctx context.Context, p venda.Pedido, agora time.Time) error {
+	vigentes, err := s.reservas.VigentesDoUsuario(ctx, p.ItemIDs(), p.UsuarioID, agora)
+	if err != nil {
+		return err
+	}
+	porItem := make(map[int][]reserva.Reserva, len(p.Linhas))
+	for _, r := range vigentes {
+		porItem[r.ItemID] = append(porItem[r.ItemID], r)
+	}
+
+	for _, l := range p.Linhas {
+		falta := l.Quantidade
+		for _, r := range porItem[l.ItemID] {
+			if falta == 0 {
+				break
+			}
+			if r.Quantidade > falta {
+				parte, err := r.Dividir(falta)
+				if err != nil {
+					return err
+				}
+				if err := parte.Confirmar(agora); err != nil {
+					return err
+				}
+				if _, err := s.reservas.Dividir(ctx, r, parte); err != nil {
+					return err
+				}
+				break
+			}
+			if err := r.Confirmar(agora); err != nil {
+				return err
+			}
+			if err := s.reservas.Concluir(ctx, r); err != nil {
+				return err
+			}
+			falta -= r.Quantidade
+		}
+	}
+	return nil
+}
+
+// Cancelar encerra o pedido; se ele já estava confirmado, o estoque volta
+func (s *vendaService) Cancelar(ctx context.Context, id, usuarioID int) (venda.Pedido, error) {
+	ctx, span := tracer.Start(ctx, "vendaService.Cancelar", trace.WithAttributes(attribute.Int("pedido.id", id)))
+	defer span.End()
+
+	var (
+		cancelado venda.Pedido
+		devolver  bool
+	)
+	err := s.transacoes.EmTransacao(ctx, func(ctx context.Context) error {
+		p, err := s.repo.Travar(ctx, id)
+		if err != nil {
+			return err
+		}
+		devolver, err = p.Cancelar(time.Now())
+		if err != nil {
+			return err
+		}
+
+		if devolver {
+			if err := s.repo.TravarItens(ctx, p.ItemIDs()); err != nil {
+				return err
+			}
+			for _, l := range p.Linhas {
+				item, err := s.repoItens.GetItem(ctx, l.ItemID)
+				if err != nil {
+					return fmt.Errorf("item %d: %w", l.ItemID, err)
+				}
+				if err := s.moverEstoque(ctx, *item, l.Quantidade, usuarioID); err != nil {
+					return err
+				}
+			}
+		}
+
+		cancelado = *p
+		return s.repo.Atualizar(ctx, cancelado)
+	})
+	if err != nil {
+		return venda.Pedido{}, fmt.Errorf("Erro ao cancelar pedido: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "pedido cancelado", "pedido_id", cancelado.ID, "estoque_devolvido", devolver, "updated_by", usuarioID)
+	return cancelado, nil
+}
+
+// moverEstoque soma quantidade (negativa na baixa) ao estoque do item
+func (s *vendaService) moverEstoque(ctx context.Context, item entity.Item, quantidade, usuarioID int) error {
+	item.Estoque += quantidade
+	item.UpdateBy = &usuarioID // Auditoria
+	if err := s.itens.UpdateItem(ctx, item); err != nil {
+		return fmt.Errorf("item %d: %w", item.ID, err)
+	}
+	return nil
+}
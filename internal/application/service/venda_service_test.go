@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"desafio-itens-app/internal/domain/promocao"
+	"desafio-itens-app/internal/domain/reserva"
+	"desafio-itens-app/internal/domain/venda"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func mouse(estoque int) *entity.Item {
+	return &entity.Item{ID: 5, Code: "MO12345678", Nome: "Mouse", Tags: []string{"gamer"}, Preco: dinheiro.Novo(10000, dinheiro.BRL), Estoque: estoque, Status: entity.StatusAtivo}
+}
+
+func TestCriarPedido_GuardaPrecoComPromocao(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+	agora := time.Now()
+	promo := promocao.Promocao{ID: 1, Tipo: promocao.TipoPercentual, Percentual: 2000, Alvo: promocao.AlvoTag, Tag: "gamer",
+		Inicio: agora.Add(-time.Hour), Fim: agora.Add(time.Hour), Ativa: true}
+
+	d.itens.On("GetItem", mock.Anything, 5).Return(mouse(3), nil)
+	d.promocoes.On("Vigentes", mock.Anything, mock.Anything).Return([]promocao.Promocao{promo}, nil)
+	d.reservas.On("ReservadosPorOutros", mock.Anything, []int{5}, 7, mock.Anything).Return(map[int]int{5: 1}, nil)
+	d.pedidosVenda.On("Criar", mock.Anything, mock.MatchedBy(func(p venda.Pedido) bool {
+		l := p.Linhas[0]
+		return p.UsuarioID == 7 && p.Status == venda.StatusPendente && l.Nome == "Mouse" && l.PrecoUnitario == dinheiro.Novo(8000, dinheiro.BRL)
+	})).Return(func(_ context.Context, p venda.Pedido) (venda.Pedido, error) {
+		p.ID = 1
+		return p, nil
+	}).Once()
+
+	// ACT
+	criado, err := service.Criar(context.Background(), 7, []venda.Linha{{ItemID: 5, Quantidade: 2}})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, criado.ID)
+}
+
+func TestCriarPedido_ReservasDeOutrosContam(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+
+	d.itens.On("GetItem", mock.Anything, 5).Return(mouse(3), nil)
+	d.promocoes.On("Vigentes", mock.Anything, mock.Anything).Return(nil, nil)
+	d.reservas.On("ReservadosPorOutros", mock.Anything, []int{5}, 7, mock.Anything).Return(map[int]int{5: 2}, nil)
+
+	// ACT
+	_, err := service.Criar(context.Background(), 7, []venda.Linha{{ItemID: 5, Quantidade: 2}})
+
+	// ASSERT
+	assert.ErrorIs(t, err, venda.ErrEstoqueInsuficiente)
+	d.pedidosVenda.AssertNotCalled(t, "Criar", mock.Anything, mock.Anything)
+}
+
+func TestConfirmarPedido_BaixaEstoque(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+	pendente := &venda.Pedido{ID: 1, UsuarioID: 7, Status: venda.StatusPendente, Linhas: []venda.Linha{
+		{ItemID: 5, Quantidade: 3, PrecoUnitario: dinheiro.Novo(8000, dinheiro.BRL)},
+	}}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosVenda.On("Travar", mock.Anything, 1).Return(pendente, nil)
+	d.pedidosVenda.On("TravarItens", mock.Anything, []int{5}).Return(nil).Once()
+	d.reservas.On("ReservadosPorOutros", mock.Anything, []int{5}, 7, mock.Anything).Return(map[int]int{}, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(mouse(3), nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 0 && i.Status == entity.StatusInativo && *i.UpdateBy == 7
+	})).Return(nil).Once()
+	d.reservas.On("VigentesDoUsuario", mock.Anything, []int{5}, 7, mock.Anything).Return(nil, nil).Once()
+	d.pedidosVenda.On("Atualizar", mock.Anything, mock.MatchedBy(func(p venda.Pedido) bool {
+		return p.Status == venda.StatusConfirmado && p.ConfirmadoEm != nil
+	})).Return(nil).Once()
+
+	// ACT
+	confirmado, err := service.Confirmar(context.Background(), 1, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, venda.StatusConfirmado, confirmado.Status)
+}
+
+func TestConfirmarPedido_ReservaDoCompradorNaoBloqueia(t *testing.T) {
+	// ARRANGE: o admin (1) confirma o pedido do cliente 7, que reservou as 3
+	// unidades do estoque no checkout
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+	pendente := &venda.Pedido{ID: 1, UsuarioID: 7, Status: venda.StatusPendente, Linhas: []venda.Linha{
+		{ItemID: 5, Quantidade: 3, PrecoUnitario: dinheiro.Novo(8000, dinheiro.BRL)},
+	}}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosVenda.On("Travar", mock.Anything, 1).Return(pendente, nil)
+	d.pedidosVenda.On("TravarItens", mock.Anything, []int{5}).Return(nil)
+	d.reservas.On("ReservadosPorOutros", mock.Anything, []int{5}, 7, mock.Anything).Return(map[int]int{}, nil).Once()
+	d.itens.On("GetItem", mock.Anything, 5).Return(mouse(3), nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool { return i.Estoque == 0 })).Return(nil).Once()
+	d.reservas.On("VigentesDoUsuario", mock.Anything, []int{5}, 7, mock.Anything).Return([]reserva.Reserva{
+		{ID: 9, ItemID: 5, Quantidade: 3, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: time.Now().Add(time.Minute)},
+	}, nil).Once()
+	d.reservas.On("Concluir", mock.Anything, mock.MatchedBy(func(r reserva.Reserva) bool {
+		return r.ID == 9 && r.Status == reserva.StatusConfirmada
+	})).Return(nil).Once()
+	d.pedidosVenda.On("Atualizar", mock.Anything, mock.Anything).Return(nil).Once()
+
+	// ACT
+	confirmado, err := service.Confirmar(context.Background(), 1, 1)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, venda.StatusConfirmado, confirmado.Status)
+	d.reservas.AssertNotCalled(t, "Reservados", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestConfirmarPedido_ConsomeReservasSoAteAQuantidade(t *testing.T) {
+	// ARRANGE: o cliente 7 reservou 2 + 4 unidades e o pedido leva 3; a
+	// primeira reserva sai inteira e a segunda fica com 3 ainda ativas
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+	pendente := &venda.Pedido{ID: 1, UsuarioID: 7, Status: venda.StatusPendente, Linhas: []venda.Linha{
+		{ItemID: 5, Quantidade: 3, PrecoUnitario: dinheiro.Novo(8000, dinheiro.BRL)},
+	}}
+	expira := time.Now().Add(time.Minute)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosVenda.On("Travar", mock.Anything, 1).Return(pendente, nil)
+	d.pedidosVenda.On("TravarItens", mock.Anything, []int{5}).Return(nil)
+	d.reservas.On("ReservadosPorOutros", mock.Anything, []int{5}, 7, mock.Anything).Return(map[int]int{}, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(mouse(6), nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool { return i.Estoque == 3 })).Return(nil).Once()
+	d.reservas.On("VigentesDoUsuario", mock.Anything, []int{5}, 7, mock.Anything).Return([]reserva.Reserva{
+		{ID: 8, ItemID: 5, Quantidade: 2, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: expira},
+		{ID: 9, ItemID: 5, Quantidade: 4, Status: reserva.StatusAtiva, UsuarioID: 7, ExpiraEm: expira.Add(time.Minute)},
+	}, nil).Once()
+	d.reservas.On("Concluir", mock.Anything, mock.MatchedBy(func(r reserva.Reserva) bool {
+		return r.ID == 8 && r.Status == reserva.StatusConfirmada
+	})).Return(nil).Once()
+	d.reservas.On("Dividir", mock.Anything,
+		mock.MatchedBy(func(r reserva.Reserva) bool {
+			return r.ID == 9 && r.Quantidade == 3 && r.Status == reserva.StatusAtiva
+		}),
+		mock.MatchedBy(func(r reserva.Reserva) bool {
+			return r.ID == 0 && r.Quantidade == 1 && r.Status == reserva.StatusConfirmada && r.UsuarioID == 7
+		}),
+	).Return(reserva.Reserva{ID: 10}, nil).Once()
+	d.pedidosVenda.On("Atualizar", mock.Anything, mock.Anything).Return(nil).Once()
+
+	// ACT
+	_, err := service.Confirmar(context.Background(), 1, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+}
+
+func TestConfirmarPedido_EstoqueAcabou(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+	pendente := &venda.Pedido{ID: 1, UsuarioID: 7, Status: venda.StatusPendente, Linhas: []venda.Linha{
+		{ItemID: 5, Quantidade: 3, PrecoUnitario: dinheiro.Novo(8000, dinheiro.BRL)},
+	}}
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosVenda.On("Travar", mock.Anything, 1).Return(pendente, nil)
+	d.pedidosVenda.On("TravarItens", mock.Anything, []int{5}).Return(nil)
+	d.reservas.On("ReservadosPorOutros", mock.Anything, []int{5}, 7, mock.Anything).Return(map[int]int{}, nil)
+	d.itens.On("GetItem", mock.Anything, 5).Return(mouse(2), nil)
+
+	// ACT
+	_, err := service.Confirmar(context.Background(), 1, 7)
+
+	// ASSERT
+	assert.ErrorIs(t, err, venda.ErrEstoqueInsuficiente)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+	d.pedidosVenda.AssertNotCalled(t, "Atualizar", mock.Anything, mock.Anything)
+}
+
+func TestCancelarPedido_ConfirmadoDevolveEstoque(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+	confirmadoEm := time.Now()
+	confirmado := &venda.Pedido{ID: 1, UsuarioID: 7, Status: venda.StatusConfirmado, ConfirmadoEm: &confirmadoEm, Linhas: []venda.Linha{
+		{ItemID: 5, Quantidade: 3, PrecoUnitario: dinheiro.Novo(8000, dinheiro.BRL)},
+	}}
+	semEstoque := mouse(0)
+	semEstoque.Status = entity.StatusInativo
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosVenda.On("Travar", mock.Anything, 1).Return(confirmado, nil)
+	d.pedidosVenda.On("TravarItens", mock.Anything, []int{5}).Return(nil).Once()
+	d.itens.On("GetItem", mock.Anything, 5).Return(semEstoque, nil)
+	d.itens.On("UpdateItem", mock.Anything, mock.MatchedBy(func(i entity.Item) bool {
+		return i.Estoque == 3 && i.Status == entity.StatusAtivo
+	})).Return(nil).Once()
+	d.pedidosVenda.On("Atualizar", mock.Anything, mock.MatchedBy(func(p venda.Pedido) bool {
+		return p.Status == venda.StatusCancelado
+	})).Return(nil).Once()
+
+	// ACT
+	cancelado, err := service.Cancelar(context.Background(), 1, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, venda.StatusCancelado, cancelado.Status)
+}
+
+func TestCancelarPedido_PendenteNaoMexeNoEstoque(t *testing.T) {
+	// ARRANGE
+	d := novasDependencias(t)
+	service := NewVendaService(d.itens, d.itemService(), d.pedidosVenda, NewPromocaoService(d.promocoes, d.logger), d.reservas, d.transacoes, d.logger)
+
+	d.transacoes.On("EmTransacao", mock.Anything, mock.Anything).Return(executarNaTransacao)
+	d.pedidosVenda.On("Travar", mock.Anything, 1).Return(&venda.Pedido{ID: 1, Status: venda.StatusPendente}, nil)
+	d.pedidosVenda.On("Atualizar", mock.Anything, mock.Anything).Return(nil).Once()
+
+	// ACT
+	_, err := service.Cancelar(context.Background(), 1, 7)
+
+	// ASSERT
+	assert.NoError(t, err)
+	d.pedidosVenda.AssertNotCalled(t, "TravarItens", mock.Anything, mock.Anything)
+	d.itens.AssertNotCalled(t, "UpdateItem", mock.Anything, mock.Anything)
+}
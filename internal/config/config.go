@@ -0,0 +1,276 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config reúne as configurações lidas das variáveis de ambiente
+type Config struct {
+	Ambiente     string // APP_ENV: "development" (padrão) ou "production"
+	HTTP         HTTP
+	Database     Database
+	Log          Log
+	Metricas     Metricas
+	Tracing      Tracing
+	Auth         Auth
+	RateLimit    RateLimit
+	Idempotencia Idempotencia
+	Notificacoes Notificacoes
+	Blobs        Blobs
+}
+
+type HTTP struct {
+	Addr string // HTTP_ADDR
+
+	ReadHeaderTimeout time.Duration // HTTP_READ_HEADER_TIMEOUT
+	ReadTimeout       time.Duration // HTTP_READ_TIMEOUT
+	WriteTimeout      time.Duration // HTTP_WRITE_TIMEOUT
+	IdleTimeout       time.Duration // HTTP_IDLE_TIMEOUT
+
+	// Prazo para as requisições em andamento terminarem após SIGTERM
+	ShutdownTimeout time.Duration // HTTP_SHUTDOWN_TIMEOUT
+
+	// TLS opcional: com os dois preenchidos o servidor sobe em HTTPS e
+	// relê o certificado quando os arquivos mudam
+	TLSCertFile string // TLS_CERT_FILE
+	TLSKeyFile  string // TLS_KEY_FILE
+
+	// Proxies cujo X-Forwarded-For vale como IP do cliente; vazio não
+	// confia em nenhum e usa o endereço da conexão
+	ProxiesConfiaveis []string // TRUSTED_PROXIES: IPs ou CIDRs separados por vírgula
+}
+
+func (h HTTP) TLS() bool {
+	return h.TLSCertFile != "" && h.TLSKeyFile != ""
+}
+
+type Database struct {
+	Driver      string   // DB_DRIVER: "mysql" (padrão) ou "postgres"
+	DSN         string   // DB_DSN: vazio usa o padrão de desenvolvimento do driver
+	ReplicaDSNs []string // DB_REPLICA_DSNS: réplicas de leitura separadas por vírgula
+
+	MaxOpenConns    int           // DB_MAX_OPEN_CONNS
+	MaxIdleConns    int           // DB_MAX_IDLE_CONNS
+	ConnMaxLifetime time.Duration // DB_CONN_MAX_LIFETIME
+	ConnMaxIdleTime time.Duration // DB_CONN_MAX_IDLE_TIME
+
+	// Tentativas de conexão no boot, com espera dobrando a cada falha
+	TentativasConexao int           // DB_CONNECT_RETRIES
+	EsperaConexao     time.Duration // DB_CONNECT_BACKOFF
+}
+
+type Log struct {
+	Level     string        // LOG_LEVEL: debug, info (padrão), warn ou error
+	Formato   string        // LOG_FORMAT: json (padrão em produção) ou text
+	SlowQuery time.Duration // DB_SLOW_QUERY: queries acima disso viram Warn
+	SQLParams bool          // LOG_SQL_PARAMS: mostra os valores das queries no log
+}
+
+type Metricas struct {
+	IntervaloResumo time.Duration // METRICS_STOCK_INTERVAL: recálculo dos indicadores de estoque
+}
+
+// Tracing usa os nomes de variável padrão do OpenTelemetry
+type Tracing struct {
+	Exporter    string  // OTEL_TRACES_EXPORTER: none (padrão), otlp, stdout ou file
+	Arquivo     string  // OTEL_TRACES_FILE: destino do exporter "file"
+	NomeServico string  // OTEL_SERVICE_NAME
+	Amostragem  float64 // OTEL_TRACES_SAMPLER_ARG: fração de traces gravados (0 a 1)
+}
+
+type Auth struct {
+	JWTSecret string // JWT_SECRET: obrigatório em produção
+}
+
+type RateLimit struct {
+	Ativo     bool     // RATE_LIMIT_ENABLED
+	Store     string   // RATE_LIMIT_STORE: memory (padrão, cota por réplica) ou db (cota global)
+	ChavesAPI []string // RATE_LIMIT_API_KEYS: X-API-Key aceitas como identidade, separadas por vírgula
+}
+
+type Idempotencia struct {
+	TTL   time.Duration // IDEMPOTENCY_TTL: por quanto tempo uma Idempotency-Key vale
+	Store string        // IDEMPOTENCY_STORE: db (padrão, vale entre réplicas) ou memory
+}
+
+// Notificacoes: para onde vão os alertas de estoque baixo
+type Notificacoes struct {
+	Canais []string // NOTIFY_CHANNELS: log (padrão), webhook e/ou email, separados por vírgula
+	Fila   int      // NOTIFY_QUEUE_SIZE: alertas aguardando envio; cheia, o alerta fica só no log
+
+	WebhookURL     string        // NOTIFY_WEBHOOK_URL
+	WebhookSegredo string        // NOTIFY_WEBHOOK_SECRET: assina o corpo (HMAC-SHA256)
+	WebhookTimeout time.Duration // NOTIFY_WEBHOOK_TIMEOUT
+
+	SMTPAddr    string   // NOTIFY_SMTP_ADDR: host:porta
+	SMTPUsuario string   // NOTIFY_SMTP_USER: vazio envia sem autenticação
+	SMTPSenha   string   // NOTIFY_SMTP_PASSWORD
+	EmailDe     string   // NOTIFY_EMAIL_FROM
+	EmailPara   []string // NOTIFY_EMAIL_TO: destinatários separados por vírgula
+}
+
+// Blobs: onde ficam os arquivos enviados (imagens dos itens)
+type Blobs struct {
+	Store      string // BLOB_STORE: local (padrão) ou s3
+	Diretorio  string // BLOB_DIR: raiz do store local
+	URLPublica string // BLOB_PUBLIC_URL: base das URLs dos arquivos (CDN, bucket público); vazio serve pela API
+
+	S3Endpoint  string        // S3_ENDPOINT: https://s3.amazonaws.com, http://minio:9000...
+	S3Bucket    string        // S3_BUCKET
+	S3Regiao    string        // S3_REGION
+	S3AccessKey string        // S3_ACCESS_KEY_ID
+	S3SecretKey string        // S3_SECRET_ACCESS_KEY
+	S3PathStyle bool          // S3_PATH_STYLE: endpoint/bucket/chave (MinIO e afins)
+	S3Timeout   time.Duration // S3_TIMEOUT
+}
+
+func (c Config) Producao() bool {
+	return c.Ambiente == "production"
+}
+
+func Carregar() Config {
+	ambiente := envString("APP_ENV", "development")
+
+	formatoLog := "text"
+	jwtSecret := "minha-secret-key-super-secreta" // só para desenvolvimento
+	if ambiente == "production" {
+		formatoLog = "json"
+		jwtSecret = ""
+	}
+
+	return Config{
+		Ambiente: ambiente,
+		HTTP: HTTP{
+			Addr:              envString("HTTP_ADDR", ":8080"),
+			ReadHeaderTimeout: envDuration("HTTP_READ_HEADER_TIMEOUT", 5*time.Second),
+			ReadTimeout:       envDuration("HTTP_READ_TIMEOUT", 15*time.Second),
+			WriteTimeout:      envDuration("HTTP_WRITE_TIMEOUT", 30*time.Second),
+			IdleTimeout:       envDuration("HTTP_IDLE_TIMEOUT", 2*time.Minute),
+			ShutdownTimeout:   envDuration("HTTP_SHUTDOWN_TIMEOUT", 25*time.Second),
+			TLSCertFile:       envString("TLS_CERT_FILE", ""),
+			TLSKeyFile:        envString("TLS_KEY_FILE", ""),
+			ProxiesConfiaveis: envLista("TRUSTED_PROXIES"),
+		},
+		Database: Database{
+			Driver:            envString("DB_DRIVER", "mysql"),
+			DSN:               envString("DB_DSN", ""),
+			ReplicaDSNs:       envLista("DB_REPLICA_DSNS"),
+			MaxOpenConns:      envInt("DB_MAX_OPEN_CONNS", 25),
+			MaxIdleConns:      envInt("DB_MAX_IDLE_CONNS", 10),
+			ConnMaxLifetime:   envDuration("DB_CONN_MAX_LIFETIME", 30*time.Minute),
+			ConnMaxIdleTime:   envDuration("DB_CONN_MAX_IDLE_TIME", 5*time.Minute),
+			TentativasConexao: envInt("DB_CONNECT_RETRIES", 10),
+			EsperaConexao:     envDuration("DB_CONNECT_BACKOFF", 500*time.Millisecond),
+		},
+		Log: Log{
+			Level:     envString("LOG_LEVEL", "info"),
+			Formato:   envString("LOG_FORMAT", formatoLog),
+			SlowQuery: envDuration("DB_SLOW_QUERY", 200*time.Millisecond),
+			SQLParams: envBool("LOG_SQL_PARAMS", false),
+		},
+		Metricas: Metricas{
+			IntervaloResumo: envDuration("METRICS_STOCK_INTERVAL", 30*time.Second),
+		},
+		Tracing: Tracing{
+			Exporter:    envString("OTEL_TRACES_EXPORTER", "none"),
+			Arquivo:     envString("OTEL_TRACES_FILE", "traces.json"),
+			NomeServico: envString("OTEL_SERVICE_NAME", "desafio-itens-app"),
+			Amostragem:  envFloat("OTEL_TRACES_SAMPLER_ARG", 1.0),
+		},
+		Auth: Auth{
+			JWTSecret: envString("JWT_SECRET", jwtSecret),
+		},
+		RateLimit: RateLimit{
+			Ativo:     envBool("RATE_LIMIT_ENABLED", true),
+			Store:     envString("RATE_LIMIT_STORE", "memory"),
+			ChavesAPI: envLista("RATE_LIMIT_API_KEYS"),
+		},
+		Idempotencia: Idempotencia{
+			TTL:   envDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			Store: envString("IDEMPOTENCY_STORE", "db"),
+		},
+		Notificacoes: Notificacoes{
+			Canais:         envListaOu("NOTIFY_CHANNELS", []string{"log"}),
+			Fila:           envInt("NOTIFY_QUEUE_SIZE", 1000),
+			WebhookURL:     envString("NOTIFY_WEBHOOK_URL", ""),
+			WebhookSegredo: envString("NOTIFY_WEBHOOK_SECRET", ""),
+			WebhookTimeout: envDuration("NOTIFY_WEBHOOK_TIMEOUT", 5*time.Second),
+			SMTPAddr:       envString("NOTIFY_SMTP_ADDR", ""),
+			SMTPUsuario:    envString("NOTIFY_SMTP_USER", ""),
+			SMTPSenha:      envString("NOTIFY_SMTP_PASSWORD", ""),
+			EmailDe:        envString("NOTIFY_EMAIL_FROM", ""),
+			EmailPara:      envLista("NOTIFY_EMAIL_TO"),
+		},
+		Blobs: Blobs{
+			Store:       envString("BLOB_STORE", "local"),
+			Diretorio:   envString("BLOB_DIR", "dados/arquivos"),
+			URLPublica:  envString("BLOB_PUBLIC_URL", ""),
+			S3Endpoint:  envString("S3_ENDPOINT", ""),
+			S3Bucket:    envString("S3_BUCKET", ""),
+			S3Regiao:    envString("S3_REGION", "us-east-1"),
+			S3AccessKey: envString("S3_ACCESS_KEY_ID", ""),
+			S3SecretKey: envString("S3_SECRET_ACCESS_KEY", ""),
+			S3PathStyle: envBool("S3_PATH_STYLE", false),
+			S3Timeout:   envDuration("S3_TIMEOUT", 30*time.Second),
+		},
+	}
+}
+
+func envString(chave, padrao string) string {
+	if v, ok := os.LookupEnv(chave); ok && strings.TrimSpace(v) != "" {
+		return strings.TrimSpace(v)
+	}
+	return padrao
+}
+
+func envInt(chave string, padrao int) int {
+	v, err := strconv.Atoi(envString(chave, ""))
+	if err != nil {
+		return padrao
+	}
+	return v
+}
+
+func envFloat(chave string, padrao float64) float64 {
+	v, err := strconv.ParseFloat(envString(chave, ""), 64)
+	if err != nil {
+		return padrao
+	}
+	return v
+}
+
+func envDuration(chave string, padrao time.Duration) time.Duration {
+	v, err := time.ParseDuration(envString(chave, ""))
+	if err != nil {
+		return padrao
+	}
+	return v
+}
+
+func envBool(chave string, padrao bool) bool {
+	v, err := strconv.ParseBool(envString(chave, ""))
+	if err != nil {
+		return padrao
+	}
+	return v
+}
+
+func envLista(chave string) []string {
+	var lista []string
+	for _, v := range strings.Split(envString(chave, ""), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			lista = append(lista, v)
+		}
+	}
+	return lista
+}
+
+func envListaOu(chave string, padrao []string) []string {
+	if lista := envLista(chave); len(lista) > 0 {
+		return lista
+	}
+	return padrao
+}
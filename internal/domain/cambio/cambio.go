@@ -0,0 +1,122 @@
+package cambio
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// moedaPivo: sem taxa direta nem inversa, a conversão passa por ela
+// (USD → BRL → EUR)
+const moedaPivo = dinheiro.BRL
+
+// casasTaxa: precisão com que as taxas são guardadas e exibidas
+const casasTaxa = 10
+
+var ErrTaxaNaoEncontrada = errors.New("taxa de câmbio não cadastrada")
+
+// Taxa diz quanto 1 unidade de De vale em Para (1 USD = 5.4321 BRL)
+type Taxa struct {
+	De            dinheiro.Moeda
+	Para          dinheiro.Moeda
+	Valor         *big.Rat
+	AtualizadaEm  time.Time
+	AtualizadaPor int
+}
+
+// NovaTaxa valida o par e o valor (decimal positivo, ex.: "5.4321")
+func NovaTaxa(de, para dinheiro.Moeda, valor string) (Taxa, error) {
+	if !de.Valida() || !para.Valida() {
+		return Taxa{}, dinheiro.ErrMoedaNaoSuportada
+	}
+	if de == para {
+		return Taxa{}, errors.New("as moedas de origem e destino devem ser diferentes")
+	}
+
+	valor = strings.TrimSpace(valor)
+	r, ok := new(big.Rat).SetString(valor)
+	if !ok || strings.Contains(valor, "/") || r.Sign() <= 0 {
+		return Taxa{}, fmt.Errorf("taxa inválida: %q (use um decimal positivo, ex.: 5.4321)", valor)
+	}
+	return Taxa{De: de, Para: para, Valor: r}, nil
+}
+
+// Texto devolve a taxa como decimal, sem zeros sobrando ("5.4321")
+func (t Taxa) Texto() string {
+	return FormatarTaxa(t.Valor)
+}
+
+func FormatarTaxa(r *big.Rat) string {
+	texto := r.FloatString(casasTaxa)
+	texto = strings.TrimRight(texto, "0")
+	return strings.TrimSuffix(texto, ".")
+}
+
+// Conversao é o preço convertido com a taxa efetivamente usada. CotadaEm
+// é a data da taxa mais antiga envolvida.
+type Conversao struct {
+	Valor    dinheiro.Dinheiro
+	Taxa     *big.Rat
+	CotadaEm time.Time
+}
+
+type par struct {
+	de, para dinheiro.Moeda
+}
+
+// Tabela resolve conversões a partir das taxas cadastradas: taxa direta,
+// inversa da taxa contrária ou, por último, passando pela moeda pivô
+type Tabela struct {
+	taxas map[par]Taxa
+}
+
+func NovaTabela(taxas []Taxa) Tabela {
+	t := Tabela{taxas: make(map[par]Taxa, len(taxas))}
+	for _, taxa := range taxas {
+		t.taxas[par{taxa.De, taxa.Para}] = taxa
+	}
+	return t
+}
+
+// Converter devolve o valor na moeda pedida (mesma moeda: taxa 1)
+func (t Tabela) Converter(valor dinheiro.Dinheiro, para dinheiro.Moeda) (Conversao, error) {
+	taxa, cotadaEm, err := t.taxa(valor.Moeda, para)
+	if err != nil {
+		return Conversao{}, err
+	}
+
+	convertido, err := valor.Multiplicar(taxa)
+	if err != nil {
+		return Conversao{}, err
+	}
+	convertido.Moeda = para
+	return Conversao{Valor: convertido, Taxa: taxa, CotadaEm: cotadaEm}, nil
+}
+
+func (t Tabela) taxa(de, para dinheiro.Moeda) (*big.Rat, time.Time, error) {
+	if de == para {
+		return big.NewRat(1, 1), time.Time{}, nil
+	}
+	if direta, ok := t.taxas[par{de, para}]; ok {
+		return direta.Valor, direta.AtualizadaEm, nil
+	}
+	if contraria, ok := t.taxas[par{para, de}]; ok {
+		return new(big.Rat).Inv(contraria.Valor), contraria.AtualizadaEm, nil
+	}
+
+	if de != moedaPivo && para != moedaPivo {
+		ida, emIda, errIda := t.taxa(de, moedaPivo)
+		volta, emVolta, errVolta := t.taxa(moedaPivo, para)
+		if errIda == nil && errVolta == nil {
+			if emVolta.Before(emIda) {
+				emIda = emVolta
+			}
+			return new(big.Rat).Mul(ida, volta), emIda, nil
+		}
+	}
+
+	return nil, time.Time{}, fmt.Errorf("%w: %s → %s", ErrTaxaNaoEncontrada, de, para)
+}
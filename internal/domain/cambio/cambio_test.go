@@ -0,0 +1,84 @@
+package cambio
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func taxa(t *testing.T, de, para dinheiro.Moeda, valor string, em time.Time) Taxa {
+	t.Helper()
+	tx, err := NovaTaxa(de, para, valor)
+	require.NoError(t, err)
+	tx.AtualizadaEm = em
+	return tx
+}
+
+func TestNovaTaxa_Invalida(t *testing.T) {
+	_, err := NovaTaxa(dinheiro.USD, dinheiro.USD, "1")
+	assert.EqualError(t, err, "as moedas de origem e destino devem ser diferentes")
+
+	_, err = NovaTaxa(dinheiro.USD, "JPY", "150")
+	assert.ErrorIs(t, err, dinheiro.ErrMoedaNaoSuportada)
+
+	for _, valor := range []string{"0", "-5", "abc", "1/3", ""} {
+		_, err = NovaTaxa(dinheiro.USD, dinheiro.BRL, valor)
+		assert.Error(t, err, valor)
+	}
+}
+
+func TestTabela_Converter(t *testing.T) {
+	//ARRANGE
+	ontem := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	hoje := ontem.AddDate(0, 0, 1)
+	tabela := NovaTabela([]Taxa{
+		taxa(t, dinheiro.USD, dinheiro.BRL, "5.00", hoje),
+		taxa(t, dinheiro.EUR, dinheiro.BRL, "6.25", ontem),
+	})
+	preco := dinheiro.Novo(10000, dinheiro.BRL) // R$ 100,00
+
+	//ACT
+	usd, errUSD := tabela.Converter(preco, dinheiro.USD)
+	brl, errBRL := tabela.Converter(dinheiro.Novo(1000, dinheiro.USD), dinheiro.BRL)
+	eur, errEUR := tabela.Converter(dinheiro.Novo(1000, dinheiro.USD), dinheiro.EUR)
+	mesma, errMesma := tabela.Converter(preco, dinheiro.BRL)
+
+	//ASSERT
+	assert.NoError(t, errUSD)
+	assert.Equal(t, dinheiro.Novo(2000, dinheiro.USD), usd.Valor) // inversa: 100 / 5
+	assert.Equal(t, "0.2", FormatarTaxa(usd.Taxa))
+	assert.Equal(t, hoje, usd.CotadaEm)
+
+	assert.NoError(t, errBRL)
+	assert.Equal(t, dinheiro.Novo(5000, dinheiro.BRL), brl.Valor) // direta
+
+	assert.NoError(t, errEUR)
+	assert.Equal(t, dinheiro.Novo(800, dinheiro.EUR), eur.Valor) // via BRL: 10 × 5 / 6.25
+	assert.Equal(t, "0.8", FormatarTaxa(eur.Taxa))
+	assert.Equal(t, ontem, eur.CotadaEm) // a taxa mais antiga
+
+	assert.NoError(t, errMesma)
+	assert.Equal(t, preco, mesma.Valor)
+}
+
+func TestTabela_SemTaxa(t *testing.T) {
+	//ARRANGE
+	tabela := NovaTabela(nil)
+
+	//ACT
+	_, err := tabela.Converter(dinheiro.Novo(100, dinheiro.BRL), dinheiro.EUR)
+
+	//ASSERT
+	assert.ErrorIs(t, err, ErrTaxaNaoEncontrada)
+	assert.EqualError(t, err, "taxa de câmbio não cadastrada: BRL → EUR")
+}
+
+func TestFormatarTaxa(t *testing.T) {
+	tx, _ := NovaTaxa(dinheiro.USD, dinheiro.BRL, "5.4321000")
+	assert.Equal(t, "5.4321", tx.Texto())
+
+	tx, _ = NovaTaxa(dinheiro.USD, dinheiro.BRL, "5")
+	assert.Equal(t, "5", tx.Texto())
+}
@@ -0,0 +1,247 @@
+package compra
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusRascunho             Status = "rascunho"
+	StatusEnviado              Status = "enviado"
+	StatusParcialmenteRecebido Status = "parcialmente_recebido"
+	StatusRecebido             Status = "recebido"
+	StatusCancelado            Status = "cancelado"
+)
+
+var (
+	ErrPedidoNaoEncontrado = errors.New("pedido de compra não encontrado")
+	ErrTransicaoInvalida   = errors.New("operação não permitida no status atual do pedido")
+	ErrPedidoInvalido      = errors.New("pedido de compra inválido")
+)
+
+// Pedido é um pedido de compra a um fornecedor. Fluxo: rascunho → enviado →
+// parcialmente_recebido → recebido. Rascunho e enviado (sem nada recebido)
+// podem ser cancelados; só o rascunho pode ter as linhas alteradas.
+type Pedido struct {
+	ID           int
+	FornecedorID int
+	Status       Status
+	Observacao   string
+	Linhas       []Linha
+	CriadoPor    int
+	CriadoEm     time.Time
+	AtualizadoEm time.Time
+	EnviadoEm    *time.Time
+	RecebidoEm   *time.Time // Quando a última linha foi completada
+}
+
+// Linha: um item por linha, com o custo unitário combinado
+type Linha struct {
+	ID            int
+	ItemID        int
+	Quantidade    int
+	Recebida      int
+	CustoUnitario dinheiro.Dinheiro
+}
+
+func (l Linha) Pendente() int {
+	return l.Quantidade - l.Recebida
+}
+
+// Recebimento é a entrada de estoque de uma linha. Pedido e linha vêm de
+// Receber; quem recebeu e quando, do service.
+type Recebimento struct {
+	ID          int
+	PedidoID    int
+	LinhaID     int
+	ItemID      int
+	Quantidade  int
+	RecebidoPor int
+	RecebidoEm  time.Time
+}
+
+// Filtro da listagem; campos vazios não filtram
+type Filtro struct {
+	Status       Status
+	FornecedorID int
+}
+
+func (s Status) Valido() bool {
+	switch s {
+	case StatusRascunho, StatusEnviado, StatusParcialmenteRecebido, StatusRecebido, StatusCancelado:
+		return true
+	}
+	return false
+}
+
+// NovoPedido monta um rascunho já validado
+func NovoPedido(fornecedorID int, observacao string, linhas []Linha, criadoPor int, agora time.Time) (Pedido, error) {
+	agora = agora.UTC()
+	p := Pedido{
+		FornecedorID: fornecedorID,
+		Status:       StatusRascunho,
+		CriadoPor:    criadoPor,
+		CriadoEm:     agora,
+		AtualizadoEm: agora,
+	}
+	if err := p.AlterarRascunho(observacao, linhas, agora); err != nil {
+		return Pedido{}, err
+	}
+	return p, nil
+}
+
+// AlterarRascunho substitui observação e linhas; só vale em rascunho
+func (p *Pedido) AlterarRascunho(observacao string, linhas []Linha, agora time.Time) error {
+	if p.Status != StatusRascunho {
+		return fmt.Errorf("%w: só rascunho pode ser alterado (status %s)", ErrTransicaoInvalida, p.Status)
+	}
+	if p.FornecedorID <= 0 {
+		return invalido("fornecedor é obrigatório")
+	}
+	if len(observacao) > 500 {
+		return invalido("observação deve ter no máximo 500 caracteres")
+	}
+	if err := validarLinhas(linhas); err != nil {
+		return err
+	}
+
+	p.Observacao = observacao
+	p.Linhas = make([]Linha, len(linhas))
+	for i, l := range linhas {
+		p.Linhas[i] = Linha{ItemID: l.ItemID, Quantidade: l.Quantidade, CustoUnitario: l.CustoUnitario}
+	}
+	p.AtualizadoEm = agora.UTC()
+	return nil
+}
+
+func validarLinhas(linhas []Linha) error {
+	if len(linhas) == 0 {
+		return invalido("o pedido precisa de pelo menos uma linha")
+	}
+
+	vistos := make(map[int]bool, len(linhas))
+	for i, l := range linhas {
+		if l.ItemID <= 0 {
+			return invalido("linha %d: item é obrigatório", i+1)
+		}
+		if vistos[l.ItemID] {
+			return invalido("linha %d: item %d repetido no pedido", i+1, l.ItemID)
+		}
+		vistos[l.ItemID] = true
+		if l.Quantidade <= 0 {
+			return invalido("linha %d: quantidade deve ser maior que zero", i+1)
+		}
+		if !l.CustoUnitario.Positivo() {
+			return invalido("linha %d: custo deve ser maior que zero", i+1)
+		}
+		if !l.CustoUnitario.Moeda.Valida() {
+			return invalido("linha %d: %v", i+1, dinheiro.ErrMoedaNaoSuportada)
+		}
+	}
+	return nil
+}
+
+// Enviar: o pedido foi mandado ao fornecedor e as linhas ficam fixas
+func (p *Pedido) Enviar(agora time.Time) error {
+	if p.Status != StatusRascunho {
+		return fmt.Errorf("%w: só rascunho pode ser enviado (status %s)", ErrTransicaoInvalida, p.Status)
+	}
+	agora = agora.UTC()
+	p.Status = StatusEnviado
+	p.EnviadoEm = &agora
+	p.AtualizadoEm = agora
+	return nil
+}
+
+// Cancelar: depois de receber alguma coisa o pedido não é mais cancelado
+func (p *Pedido) Cancelar(agora time.Time) error {
+	if p.Status != StatusRascunho && p.Status != StatusEnviado {
+		return fmt.Errorf("%w: pedido %s não pode ser cancelado", ErrTransicaoInvalida, p.Status)
+	}
+	p.Status = StatusCancelado
+	p.AtualizadoEm = agora.UTC()
+	return nil
+}
+
+// Receber registra a chegada de quantidades por item (itemID → quantidade)
+// e devolve os recebimentos a gravar. Receber mais que o pendente de uma
+// linha é erro; o pedido vira recebido quando nada mais está pendente.
+func (p *Pedido) Receber(quantidades map[int]int, agora time.Time) ([]Recebimento, error) {
+	if p.Status != StatusEnviado && p.Status != StatusParcialmenteRecebido {
+		return nil, fmt.Errorf("%w: só pedido enviado recebe mercadoria (status %s)", ErrTransicaoInvalida, p.Status)
+	}
+	if len(quantidades) == 0 {
+		return nil, invalido("informe ao menos um item recebido")
+	}
+
+	agora = agora.UTC()
+	recebimentos := make([]Recebimento, 0, len(quantidades))
+	for _, itemID := range slices.Sorted(maps.Keys(quantidades)) {
+		quantidade := quantidades[itemID]
+		i := p.linhaDoItem(itemID)
+		if i < 0 {
+			return nil, invalido("item %d não está no pedido", itemID)
+		}
+		if quantidade <= 0 {
+			return nil, invalido("item %d: quantidade recebida deve ser maior que zero", itemID)
+		}
+		if quantidade > p.Linhas[i].Pendente() {
+			return nil, invalido("item %d: recebendo %d, pendente %d", itemID, quantidade, p.Linhas[i].Pendente())
+		}
+		recebimentos = append(recebimentos, Recebimento{
+			PedidoID:   p.ID,
+			LinhaID:    p.Linhas[i].ID,
+			ItemID:     itemID,
+			Quantidade: quantidade,
+			RecebidoEm: agora,
+		})
+	}
+
+	// Só altera o pedido depois de validar tudo
+	for _, r := range recebimentos {
+		p.Linhas[p.linhaDoItem(r.ItemID)].Recebida += r.Quantidade
+	}
+	p.Status = StatusRecebido
+	for _, l := range p.Linhas {
+		if l.Pendente() > 0 {
+			p.Status = StatusParcialmenteRecebido
+			break
+		}
+	}
+	if p.Status == StatusRecebido {
+		p.RecebidoEm = &agora
+	}
+	p.AtualizadoEm = agora
+	return recebimentos, nil
+}
+
+func (p *Pedido) linhaDoItem(itemID int) int {
+	for i, l := range p.Linhas {
+		if l.ItemID == itemID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Total soma quantidade × custo por moeda (linhas podem ter moedas
+// diferentes)
+func (p *Pedido) Total() map[dinheiro.Moeda]dinheiro.Dinheiro {
+	total := make(map[dinheiro.Moeda]dinheiro.Dinheiro)
+	for _, l := range p.Linhas {
+		soma := total[l.CustoUnitario.Moeda]
+		total[l.CustoUnitario.Moeda] = dinheiro.Novo(soma.Centavos+l.CustoUnitario.Vezes(l.Quantidade).Centavos, l.CustoUnitario.Moeda)
+	}
+	return total
+}
+
+// invalido marca erros de validação para o handler responder 400
+func invalido(formato string, args ...any) error {
+	return fmt.Errorf("%w: "+formato, append([]any{ErrPedidoInvalido}, args...)...)
+}
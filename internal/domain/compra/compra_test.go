@@ -0,0 +1,101 @@
+package compra
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var agora = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func pedidoEnviado(t *testing.T) Pedido {
+	t.Helper()
+	p, err := NovoPedido(3, "", []Linha{
+		{ItemID: 10, Quantidade: 5, CustoUnitario: dinheiro.Novo(1000, dinheiro.BRL)},
+		{ItemID: 11, Quantidade: 2, CustoUnitario: dinheiro.Novo(2500, dinheiro.BRL)},
+	}, 1, agora)
+	require.NoError(t, err)
+	p.Linhas[0].ID, p.Linhas[1].ID = 100, 101
+	require.NoError(t, p.Enviar(agora))
+	return p
+}
+
+func TestNovoPedido(t *testing.T) {
+	t.Run("valido", func(t *testing.T) {
+		p, err := NovoPedido(3, "entrega na doca 2", []Linha{{ItemID: 10, Quantidade: 5, CustoUnitario: dinheiro.Novo(1000, dinheiro.BRL)}}, 1, agora)
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusRascunho, p.Status)
+		assert.Equal(t, dinheiro.Novo(5000, dinheiro.BRL), p.Total()[dinheiro.BRL])
+	})
+
+	t.Run("sem linhas", func(t *testing.T) {
+		_, err := NovoPedido(3, "", nil, 1, agora)
+		assert.Error(t, err)
+	})
+
+	t.Run("item repetido", func(t *testing.T) {
+		linha := Linha{ItemID: 10, Quantidade: 1, CustoUnitario: dinheiro.Novo(1000, dinheiro.BRL)}
+		_, err := NovoPedido(3, "", []Linha{linha, linha}, 1, agora)
+		assert.ErrorContains(t, err, "repetido")
+	})
+
+	t.Run("custo zero", func(t *testing.T) {
+		_, err := NovoPedido(3, "", []Linha{{ItemID: 10, Quantidade: 1}}, 1, agora)
+		assert.ErrorContains(t, err, "custo")
+	})
+}
+
+func TestReceber(t *testing.T) {
+	t.Run("parcial e depois total", func(t *testing.T) {
+		p := pedidoEnviado(t)
+
+		recebimentos, err := p.Receber(map[int]int{10: 3}, agora)
+		require.NoError(t, err)
+		assert.Equal(t, StatusParcialmenteRecebido, p.Status)
+		assert.Equal(t, []Recebimento{{LinhaID: 100, ItemID: 10, Quantidade: 3, RecebidoEm: agora}}, recebimentos)
+		assert.Nil(t, p.RecebidoEm)
+
+		_, err = p.Receber(map[int]int{10: 2, 11: 2}, agora)
+		require.NoError(t, err)
+		assert.Equal(t, StatusRecebido, p.Status)
+		assert.NotNil(t, p.RecebidoEm)
+	})
+
+	t.Run("mais que o pendente", func(t *testing.T) {
+		p := pedidoEnviado(t)
+
+		_, err := p.Receber(map[int]int{10: 3, 11: 3}, agora)
+		assert.ErrorContains(t, err, "pendente 2")
+		assert.Equal(t, 0, p.Linhas[0].Recebida) // Nada muda se uma linha falha
+		assert.Equal(t, StatusEnviado, p.Status)
+	})
+
+	t.Run("item fora do pedido", func(t *testing.T) {
+		p := pedidoEnviado(t)
+
+		_, err := p.Receber(map[int]int{99: 1}, agora)
+		assert.ErrorIs(t, err, ErrPedidoInvalido)
+		assert.ErrorContains(t, err, "não está no pedido")
+	})
+
+	t.Run("rascunho nao recebe", func(t *testing.T) {
+		p, _ := NovoPedido(3, "", []Linha{{ItemID: 10, Quantidade: 5, CustoUnitario: dinheiro.Novo(1000, dinheiro.BRL)}}, 1, agora)
+
+		_, err := p.Receber(map[int]int{10: 1}, agora)
+		assert.ErrorIs(t, err, ErrTransicaoInvalida)
+	})
+}
+
+func TestTransicoes(t *testing.T) {
+	p := pedidoEnviado(t)
+
+	assert.ErrorIs(t, p.Enviar(agora), ErrTransicaoInvalida)
+	assert.ErrorIs(t, p.AlterarRascunho("", p.Linhas, agora), ErrTransicaoInvalida)
+
+	_, err := p.Receber(map[int]int{10: 1}, agora)
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.Cancelar(agora), ErrTransicaoInvalida) // Já recebeu alguma coisa
+}
@@ -0,0 +1,130 @@
+package deposito
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrDepositoNaoEncontrado = errors.New("depósito não encontrado")
+	ErrCodigoDuplicado       = errors.New("já existe um depósito com esse código")
+	ErrEstoqueInsuficiente   = errors.New("estoque insuficiente no depósito")
+)
+
+// Deposito é um local físico de estoque. O depósito Padrao recebe as
+// mudanças feitas só no total (PUT /itens/:id, lote, importação).
+type Deposito struct {
+	ID       int
+	Codigo   string
+	Nome     string
+	Padrao   bool
+	CriadoEm time.Time
+}
+
+var codigoValido = regexp.MustCompile(`^[A-Z0-9_-]{1,20}$`)
+
+// NovoDeposito valida o código (letras, números, - e _, até 20) e o nome
+func NovoDeposito(codigo, nome string) (Deposito, error) {
+	codigo = strings.ToUpper(strings.TrimSpace(codigo))
+	nome = strings.TrimSpace(nome)
+
+	if !codigoValido.MatchString(codigo) {
+		return Deposito{}, errors.New("código deve ter até 20 letras, números, '-' ou '_'")
+	}
+	if nome == "" || len(nome) > 100 {
+		return Deposito{}, errors.New("nome é obrigatório e deve ter no máximo 100 caracteres")
+	}
+	return Deposito{Codigo: codigo, Nome: nome}, nil
+}
+
+// Saldo é a quantidade de um item num depósito
+type Saldo struct {
+	Deposito   Deposito
+	Quantidade int
+}
+
+// Total soma os saldos: é o Estoque do item
+func Total(saldos []Saldo) int {
+	total := 0
+	for _, s := range saldos {
+		total += s.Quantidade
+	}
+	return total
+}
+
+// AjustarTotal leva os saldos do item ao novo total, para quem só conhece o
+// total (PUT /itens/:id, lote, importação, vendas, reservas). O que entra
+// vai para o depósito padrão; o que sai tira primeiro do padrão e o resto
+// dos outros depósitos, na ordem dos saldos. Devolve só os saldos que
+// mudaram.
+func AjustarTotal(saldos []Saldo, padraoID, total int) ([]Saldo, error) {
+	if total < 0 {
+		return nil, errors.New("Estoque não pode ser negativo")
+	}
+	diferenca := total - Total(saldos)
+	if diferenca == 0 {
+		return nil, nil
+	}
+
+	padrao := Saldo{Deposito: Deposito{ID: padraoID, Padrao: true}} // Sem saldo ainda
+	outros := make([]Saldo, 0, len(saldos))
+	for _, s := range saldos {
+		if s.Deposito.ID == padraoID {
+			padrao = s
+		} else {
+			outros = append(outros, s)
+		}
+	}
+	if diferenca > 0 {
+		padrao.Quantidade += diferenca
+		return []Saldo{padrao}, nil
+	}
+
+	falta := -diferenca
+	var alterados []Saldo
+	for _, s := range append([]Saldo{padrao}, outros...) {
+		tirar := min(s.Quantidade, falta)
+		if tirar == 0 {
+			continue
+		}
+		s.Quantidade -= tirar
+		falta -= tirar
+		alterados = append(alterados, s)
+	}
+	return alterados, nil
+}
+
+// Transferencia move estoque entre depósitos sem mudar o total do item
+type Transferencia struct {
+	ID         int
+	ItemID     int
+	Origem     int
+	Destino    int
+	Quantidade int
+	UsuarioID  int
+	CriadoEm   time.Time
+}
+
+func (t Transferencia) Validar() error {
+	if t.Origem <= 0 || t.Destino <= 0 {
+		return errors.New("origem e destino são obrigatórios")
+	}
+	if t.Origem == t.Destino {
+		return errors.New("origem e destino devem ser depósitos diferentes")
+	}
+	if t.Quantidade <= 0 {
+		return errors.New("quantidade deve ser maior que zero")
+	}
+	return nil
+}
+
+// Posicao é o estoque do item quebrado por depósito, com as últimas
+// transferências
+type Posicao struct {
+	ItemID         int
+	Total          int
+	Saldos         []Saldo
+	Transferencias []Transferencia
+}
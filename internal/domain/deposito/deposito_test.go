@@ -0,0 +1,72 @@
+package deposito
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNovoDeposito(t *testing.T) {
+	d, err := NovoDeposito(" sp-01 ", " Galpão São Paulo ")
+	require.NoError(t, err)
+	assert.Equal(t, "SP-01", d.Codigo)
+	assert.Equal(t, "Galpão São Paulo", d.Nome)
+
+	_, err = NovoDeposito("sp 01", "Galpão")
+	assert.Error(t, err)
+
+	_, err = NovoDeposito("SP", "")
+	assert.Error(t, err)
+}
+
+func TestTotal(t *testing.T) {
+	assert.Equal(t, 0, Total(nil))
+	assert.Equal(t, 12, Total([]Saldo{{Quantidade: 5}, {Quantidade: 0}, {Quantidade: 7}}))
+}
+
+func TestTransferencia_Validar(t *testing.T) {
+	assert.NoError(t, Transferencia{Origem: 1, Destino: 2, Quantidade: 3}.Validar())
+	assert.EqualError(t, Transferencia{Origem: 1, Destino: 1, Quantidade: 3}.Validar(), "origem e destino devem ser depósitos diferentes")
+	assert.EqualError(t, Transferencia{Origem: 1, Destino: 2}.Validar(), "quantidade deve ser maior que zero")
+	assert.Error(t, Transferencia{Destino: 2, Quantidade: 1}.Validar())
+}
+
+func TestAjustarTotal(t *testing.T) {
+	saldo := func(depositoID, quantidade int) Saldo {
+		return Saldo{Deposito: Deposito{ID: depositoID, Padrao: depositoID == 1}, Quantidade: quantidade}
+	}
+
+	t.Run("entrada vai para o padrao", func(t *testing.T) {
+		alterados, err := AjustarTotal([]Saldo{saldo(2, 5)}, 1, 8)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Saldo{saldo(1, 3)}, alterados)
+	})
+
+	t.Run("baixa com estoque so em outro deposito", func(t *testing.T) {
+		// Venda de 2 com as 5 unidades no depósito 2 e nada no padrão
+		alterados, err := AjustarTotal([]Saldo{saldo(2, 5)}, 1, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Saldo{saldo(2, 3)}, alterados)
+	})
+
+	t.Run("baixa esvazia o padrao antes dos outros", func(t *testing.T) {
+		alterados, err := AjustarTotal([]Saldo{saldo(1, 2), saldo(2, 3), saldo(3, 4)}, 1, 4)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Saldo{saldo(1, 0), saldo(2, 0)}, alterados) // O depósito 3 fica como está
+	})
+
+	t.Run("sem diferenca", func(t *testing.T) {
+		alterados, err := AjustarTotal([]Saldo{saldo(1, 2), saldo(2, 3)}, 1, 5)
+
+		require.NoError(t, err)
+		assert.Empty(t, alterados)
+	})
+
+	t.Run("total negativo", func(t *testing.T) {
+		_, err := AjustarTotal([]Saldo{saldo(2, 3)}, 1, -1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,125 @@
+package dinheiro
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Moeda é o código ISO 4217 (BRL, USD, EUR)
+type Moeda string
+
+const (
+	BRL Moeda = "BRL"
+	USD Moeda = "USD"
+	EUR Moeda = "EUR"
+
+	MoedaPadrao = BRL
+)
+
+// casasDecimais: todas as moedas aceitas têm centavos. Moedas sem
+// subdivisão (JPY) ou com 3 casas (KWD) precisariam do expoente da ISO 4217
+// por moeda.
+const casasDecimais = 2
+
+var fatorCentavos = big.NewInt(100)
+
+var moedasAceitas = map[Moeda]bool{BRL: true, USD: true, EUR: true}
+
+var ErrMoedaNaoSuportada = errors.New("moeda não suportada (use BRL, USD ou EUR)")
+
+func (m Moeda) Valida() bool {
+	return moedasAceitas[m]
+}
+
+// ParseMoeda aceita o código em qualquer caixa ("usd" → USD)
+func ParseMoeda(texto string) (Moeda, error) {
+	moeda := Moeda(strings.ToUpper(strings.TrimSpace(texto)))
+	if !moeda.Valida() {
+		return "", ErrMoedaNaoSuportada
+	}
+	return moeda, nil
+}
+
+// Dinheiro guarda o valor em centavos (inteiro) para que somas e
+// comparações sejam exatas, ao contrário de float64 (0.1 + 0.2 ≠ 0.3)
+type Dinheiro struct {
+	Centavos int64
+	Moeda    Moeda
+}
+
+func Novo(centavos int64, moeda Moeda) Dinheiro {
+	return Dinheiro{Centavos: centavos, Moeda: moeda}
+}
+
+// Parse lê um decimal ("1234.56", "10", "-0.5") sem passar por float.
+// Casas além dos centavos são arredondadas meio para cima, longe do zero
+// (10.005 → 10.01; -10.005 → -10.01), o arredondamento comercial.
+func Parse(texto string, moeda Moeda) (Dinheiro, error) {
+	texto = strings.TrimSpace(texto)
+	valor, ok := new(big.Rat).SetString(texto)
+	if texto == "" || strings.Contains(texto, "/") || !ok {
+		return Dinheiro{}, fmt.Errorf("valor inválido: %q", texto)
+	}
+
+	centavos, err := arredondar(valor.Mul(valor, new(big.Rat).SetInt(fatorCentavos)))
+	if err != nil {
+		return Dinheiro{}, fmt.Errorf("valor inválido: %q", texto)
+	}
+	return Novo(centavos, moeda), nil
+}
+
+// Multiplicar aplica um fator exato (câmbio, desconto) com o mesmo
+// arredondamento do Parse
+func (d Dinheiro) Multiplicar(fator *big.Rat) (Dinheiro, error) {
+	resultado := new(big.Rat).Mul(new(big.Rat).SetInt64(d.Centavos), fator)
+	centavos, err := arredondar(resultado)
+	if err != nil {
+		return Dinheiro{}, err
+	}
+	return Novo(centavos, d.Moeda), nil
+}
+
+// Vezes multiplica por uma quantidade (ex.: preço × estoque)
+func (d Dinheiro) Vezes(quantidade int) Dinheiro {
+	return Novo(d.Centavos*int64(quantidade), d.Moeda)
+}
+
+func (d Dinheiro) Positivo() bool {
+	return d.Centavos > 0
+}
+
+// String devolve o decimal exato com duas casas ("1234.50"), sem a moeda
+func (d Dinheiro) String() string {
+	sinal := ""
+	centavos := d.Centavos
+	if centavos < 0 {
+		sinal = "-"
+		centavos = -centavos
+	}
+	return fmt.Sprintf("%s%d.%0*d", sinal, centavos/100, casasDecimais, centavos%100)
+}
+
+// Float64 só para métricas e planilhas; contas usam Centavos
+func (d Dinheiro) Float64() float64 {
+	v, _ := strconv.ParseFloat(d.String(), 64)
+	return v
+}
+
+// arredondar: meio para cima, longe do zero
+func arredondar(valor *big.Rat) (int64, error) {
+	numerador := new(big.Int).Abs(valor.Num())
+	quociente, resto := new(big.Int).QuoRem(numerador, valor.Denom(), new(big.Int))
+	if resto.Lsh(resto, 1).Cmp(valor.Denom()) >= 0 {
+		quociente.Add(quociente, big.NewInt(1))
+	}
+	if valor.Sign() < 0 {
+		quociente.Neg(quociente)
+	}
+	if !quociente.IsInt64() {
+		return 0, errors.New("valor fora do limite")
+	}
+	return quociente.Int64(), nil
+}
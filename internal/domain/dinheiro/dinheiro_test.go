@@ -0,0 +1,75 @@
+package dinheiro
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	casos := map[string]int64{
+		"1234.56": 123456,
+		"10":      1000,
+		"0.1":     10,
+		"10.005":  1001, // meio para cima
+		"10.0049": 1000,
+		"-10.005": -1001, // longe do zero
+		" 7.5 ":   750,
+		"1e2":     10000,
+	}
+	for texto, centavos := range casos {
+		d, err := Parse(texto, BRL)
+		assert.NoError(t, err, texto)
+		assert.Equal(t, Novo(centavos, BRL), d, texto)
+	}
+}
+
+func TestParse_Invalido(t *testing.T) {
+	for _, texto := range []string{"", "abc", "1/3", "1,50", "99999999999999999999"} {
+		_, err := Parse(texto, BRL)
+		assert.Error(t, err, texto)
+	}
+}
+
+func TestSomaExata(t *testing.T) {
+	//ARRANGE - em float64, 0.1 + 0.2 = 0.30000000000000004
+	a, _ := Parse("0.1", BRL)
+	b, _ := Parse("0.2", BRL)
+
+	//ACT
+	soma := Novo(a.Centavos+b.Centavos, BRL)
+
+	//ASSERT
+	assert.Equal(t, "0.30", soma.String())
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "1234.50", Novo(123450, BRL).String())
+	assert.Equal(t, "0.05", Novo(5, BRL).String())
+	assert.Equal(t, "-0.05", Novo(-5, BRL).String())
+	assert.Equal(t, 1234.5, Novo(123450, BRL).Float64())
+}
+
+func TestMultiplicar(t *testing.T) {
+	//ARRANGE
+	preco := Novo(1000, BRL) // 10.00
+
+	//ACT
+	terco, err := preco.Multiplicar(big.NewRat(1, 3))
+	meio, _ := Novo(1, BRL).Multiplicar(big.NewRat(1, 2))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, int64(333), terco.Centavos)
+	assert.Equal(t, int64(1), meio.Centavos) // 0.005 → 0.01
+	assert.Equal(t, Novo(3000, BRL), preco.Vezes(3))
+}
+
+func TestParseMoeda(t *testing.T) {
+	moeda, err := ParseMoeda(" usd ")
+	assert.NoError(t, err)
+	assert.Equal(t, USD, moeda)
+
+	_, err = ParseMoeda("JPY")
+	assert.ErrorIs(t, err, ErrMoedaNaoSuportada)
+}
@@ -0,0 +1,76 @@
+package fornecedor
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+var (
+	ErrFornecedorNaoEncontrado = errors.New("fornecedor não encontrado")
+	ErrVinculoNaoEncontrado    = errors.New("item não é fornecido por esse fornecedor")
+)
+
+type Fornecedor struct {
+	ID           int
+	Nome         string
+	Documento    string // CNPJ/CPF ou registro estrangeiro, como veio
+	Email        string
+	Telefone     string
+	CriadoEm     time.Time
+	AtualizadoEm time.Time
+}
+
+// Normalizar tira espaços das pontas e deixa o e-mail em minúsculas
+func (f *Fornecedor) Normalizar() {
+	f.Nome = strings.TrimSpace(f.Nome)
+	f.Documento = strings.TrimSpace(f.Documento)
+	f.Email = strings.ToLower(strings.TrimSpace(f.Email))
+	f.Telefone = strings.TrimSpace(f.Telefone)
+}
+
+func (f *Fornecedor) Validar() error {
+	if f.Nome == "" || len(f.Nome) > 100 {
+		return errors.New("nome é obrigatório e deve ter no máximo 100 caracteres")
+	}
+	if len(f.Documento) > 30 {
+		return errors.New("documento deve ter no máximo 30 caracteres")
+	}
+	if f.Email != "" {
+		if _, err := mail.ParseAddress(f.Email); err != nil || len(f.Email) > 255 {
+			return errors.New("email inválido")
+		}
+	}
+	if len(f.Telefone) > 30 {
+		return errors.New("telefone deve ter no máximo 30 caracteres")
+	}
+	return nil
+}
+
+// ItemFornecedor liga um item a um fornecedor: o código do item no catálogo
+// do fornecedor (SKU) e quanto ele cobra por unidade. Um item pode ter
+// vários fornecedores.
+type ItemFornecedor struct {
+	ItemID         int
+	FornecedorID   int
+	FornecedorNome string // Só leitura
+	SKU            string
+	Custo          dinheiro.Dinheiro
+	AtualizadoEm   time.Time
+}
+
+func (v *ItemFornecedor) Validar() error {
+	v.SKU = strings.TrimSpace(v.SKU)
+	if len(v.SKU) > 50 {
+		return errors.New("sku deve ter no máximo 50 caracteres")
+	}
+	if !v.Custo.Positivo() {
+		return errors.New("custo deve ser maior que zero")
+	}
+	if !v.Custo.Moeda.Valida() {
+		return dinheiro.ErrMoedaNaoSuportada
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package fornecedor
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFornecedor_Validar(t *testing.T) {
+	f := Fornecedor{Nome: "  Distribuidora Sul ", Email: " Compras@Sul.com.br "}
+	f.Normalizar()
+
+	assert.NoError(t, f.Validar())
+	assert.Equal(t, "Distribuidora Sul", f.Nome)
+	assert.Equal(t, "compras@sul.com.br", f.Email)
+
+	assert.Error(t, (&Fornecedor{}).Validar())
+	assert.EqualError(t, (&Fornecedor{Nome: "X", Email: "sem-arroba"}).Validar(), "email inválido")
+}
+
+func TestItemFornecedor_Validar(t *testing.T) {
+	assert.NoError(t, (&ItemFornecedor{SKU: "AB-1", Custo: dinheiro.Novo(990, dinheiro.USD)}).Validar())
+	assert.EqualError(t, (&ItemFornecedor{SKU: "AB-1"}).Validar(), "custo deve ser maior que zero")
+	assert.ErrorIs(t, (&ItemFornecedor{Custo: dinheiro.Novo(990, "JPY")}).Validar(), dinheiro.ErrMoedaNaoSuportada)
+}
@@ -0,0 +1,34 @@
+package idempotencia
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Registro guarda a primeira resposta dada a uma Idempotency-Key. Enquanto
+// a requisição original ainda está sendo processada, Concluido é false.
+type Registro struct {
+	Chave       string // Hash de cliente + Idempotency-Key
+	Fingerprint string // Hash de método + rota + corpo da requisição
+	Concluido   bool
+	StatusCode  int
+	ContentType string
+	Corpo       []byte
+	CriadoEm    time.Time
+	ExpiraEm    time.Time
+}
+
+func (r Registro) Expirado(agora time.Time) bool {
+	return !agora.Before(r.ExpiraEm)
+}
+
+// Hash junta as partes num SHA-256 hexadecimal (tamanho fixo para o banco)
+func Hash(partes ...[]byte) string {
+	h := sha256.New()
+	for _, p := range partes {
+		h.Write(p)
+		h.Write([]byte{0}) // separador: ("ab","c") ≠ ("a","bc")
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,28 @@
+package idempotencia
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRegistro_Expirado(t *testing.T) {
+	//ARRANGE
+	agora := time.Now()
+	registro := Registro{ExpiraEm: agora}
+
+	//ASSERT
+	assert.False(t, registro.Expirado(agora.Add(-time.Second)))
+	assert.True(t, registro.Expirado(agora))
+}
+
+func TestHash_SeparaAsPartes(t *testing.T) {
+	//ACT
+	h1 := Hash([]byte("ab"), []byte("c"))
+	h2 := Hash([]byte("a"), []byte("bc"))
+
+	//ASSERT
+	assert.Len(t, h1, 64)
+	assert.NotEqual(t, h1, h2)
+	assert.Equal(t, h1, Hash([]byte("ab"), []byte("c")))
+}
@@ -0,0 +1,120 @@
+package imagem
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	TamanhoMaximo = 5 << 20 // 5 MB por arquivo
+	MaxPorItem    = 10
+)
+
+var (
+	ErrImagemNaoEncontrada = errors.New("imagem não encontrada")
+	ErrTipoNaoSuportado    = errors.New("tipo de arquivo não suportado: envie JPEG, PNG, GIF ou WebP")
+	ErrImagemGrande        = fmt.Errorf("imagem maior que %d MB", TamanhoMaximo>>20)
+	ErrImagemInvalida      = errors.New("imagem inválida")
+	ErrLimiteImagens       = fmt.Errorf("o item pode ter no máximo %d imagens", MaxPorItem)
+	ErrOrdemInvalida       = errors.New("a nova ordem deve listar cada imagem do item uma vez")
+)
+
+// Imagem é um arquivo do item guardado no BlobStore, com a miniatura ao
+// lado. Ordem começa em 1; o item tem uma principal enquanto tiver imagens.
+type Imagem struct {
+	ID             int
+	ItemID         int
+	Chave          string // Caminho no BlobStore
+	ChaveMiniatura string
+	ContentType    string
+	Tamanho        int64 // Bytes do original
+	Largura        int
+	Altura         int
+	Ordem          int
+	Principal      bool
+	CriadoPor      *int
+	CriadoEm       time.Time
+
+	// Preenchidas pelo service a partir das chaves
+	URL          string
+	URLMiniatura string
+}
+
+// Acrescentar coloca as novas imagens no fim da ordem. Se o item ainda não
+// tinha imagens, a primeira nova vira a principal.
+func Acrescentar(existentes, novas []Imagem) ([]Imagem, error) {
+	if len(existentes)+len(novas) > MaxPorItem {
+		return nil, ErrLimiteImagens
+	}
+
+	for i := range novas {
+		novas[i].Ordem = len(existentes) + i + 1
+		novas[i].Principal = len(existentes) == 0 && i == 0
+	}
+	return novas, nil
+}
+
+// Reordenar aplica a ordem dos ids (o primeiro fica com Ordem 1)
+func Reordenar(imagens []Imagem, ids []int) error {
+	if len(ids) != len(imagens) {
+		return ErrOrdemInvalida
+	}
+
+	posicao := make(map[int]int, len(ids))
+	for i, id := range ids {
+		if _, repetido := posicao[id]; repetido {
+			return ErrOrdemInvalida
+		}
+		posicao[id] = i + 1
+	}
+	for _, img := range imagens {
+		if _, ok := posicao[img.ID]; !ok {
+			return ErrOrdemInvalida
+		}
+	}
+
+	for i := range imagens {
+		imagens[i].Ordem = posicao[imagens[i].ID]
+	}
+	return nil
+}
+
+// DefinirPrincipal marca a imagem como principal e desmarca as outras
+func DefinirPrincipal(imagens []Imagem, id int) error {
+	encontrada := false
+	for i := range imagens {
+		imagens[i].Principal = imagens[i].ID == id
+		encontrada = encontrada || imagens[i].Principal
+	}
+	if !encontrada {
+		return ErrImagemNaoEncontrada
+	}
+	return nil
+}
+
+// Retirar tira a imagem da lista (que deve vir na ordem), fecha o buraco
+// na ordem e, se ela era a principal, passa o posto para a primeira que
+// sobrou. Devolve a removida e as que ficaram.
+func Retirar(imagens []Imagem, id int) (Imagem, []Imagem, error) {
+	var removida *Imagem
+	restantes := make([]Imagem, 0, len(imagens))
+	for i := range imagens {
+		if imagens[i].ID == id {
+			removida = &imagens[i]
+			continue
+		}
+		restantes = append(restantes, imagens[i])
+	}
+	if removida == nil {
+		return Imagem{}, nil, ErrImagemNaoEncontrada
+	}
+
+	for i := range restantes {
+		restantes[i].Ordem = i + 1
+	}
+	if removida.Principal && len(restantes) > 0 {
+		restantes[0].Principal = true
+	}
+	return *removida, restantes, nil
+}
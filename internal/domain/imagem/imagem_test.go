@@ -0,0 +1,76 @@
+package imagem
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func tresImagens() []Imagem {
+	return []Imagem{
+		{ID: 1, Ordem: 1, Principal: true},
+		{ID: 2, Ordem: 2},
+		{ID: 3, Ordem: 3},
+	}
+}
+
+func TestAcrescentar_PrimeiraViraPrincipal(t *testing.T) {
+	novas, err := Acrescentar(nil, []Imagem{{}, {}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, novas[0].Ordem)
+	assert.True(t, novas[0].Principal)
+	assert.Equal(t, 2, novas[1].Ordem)
+	assert.False(t, novas[1].Principal)
+}
+
+func TestAcrescentar_VaiParaOFim(t *testing.T) {
+	novas, err := Acrescentar(tresImagens(), []Imagem{{}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, novas[0].Ordem)
+	assert.False(t, novas[0].Principal)
+}
+
+func TestAcrescentar_Limite(t *testing.T) {
+	_, err := Acrescentar(make([]Imagem, MaxPorItem-1), []Imagem{{}, {}})
+
+	assert.ErrorIs(t, err, ErrLimiteImagens)
+}
+
+func TestReordenar(t *testing.T) {
+	imagens := tresImagens()
+
+	assert.NoError(t, Reordenar(imagens, []int{3, 1, 2}))
+	assert.Equal(t, 2, imagens[0].Ordem)
+	assert.Equal(t, 3, imagens[1].Ordem)
+	assert.Equal(t, 1, imagens[2].Ordem)
+
+	assert.ErrorIs(t, Reordenar(tresImagens(), []int{3, 1}), ErrOrdemInvalida)
+	assert.ErrorIs(t, Reordenar(tresImagens(), []int{3, 3, 1}), ErrOrdemInvalida)
+	assert.ErrorIs(t, Reordenar(tresImagens(), []int{3, 1, 9}), ErrOrdemInvalida)
+}
+
+func TestDefinirPrincipal(t *testing.T) {
+	imagens := tresImagens()
+
+	assert.NoError(t, DefinirPrincipal(imagens, 2))
+	assert.False(t, imagens[0].Principal)
+	assert.True(t, imagens[1].Principal)
+
+	assert.ErrorIs(t, DefinirPrincipal(tresImagens(), 9), ErrImagemNaoEncontrada)
+}
+
+func TestRetirar_PrincipalPassaParaAProxima(t *testing.T) {
+	removida, restantes, err := Retirar(tresImagens(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removida.ID)
+	assert.Len(t, restantes, 2)
+	assert.Equal(t, 2, restantes[0].ID)
+	assert.Equal(t, 1, restantes[0].Ordem)
+	assert.True(t, restantes[0].Principal)
+	assert.Equal(t, 2, restantes[1].Ordem)
+
+	_, _, err = Retirar(tresImagens(), 9)
+	assert.ErrorIs(t, err, ErrImagemNaoEncontrada)
+}
@@ -0,0 +1,119 @@
+package imagem
+
+import (
+	"bytes"
+	"fmt"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // Registra o decoder de WebP
+	"image"
+	_ "image/gif" // Registra o decoder de GIF
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	LadoMiniatura = 320        // Maior lado da miniatura, em pixels
+	MaxPixels     = 40_000_000 // Barra imagens que explodiriam a memória ao decodificar
+)
+
+// tiposAceitos: content-type detectado → extensão da chave
+var tiposAceitos = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Processada é o resultado de Processar: o que foi detectado no arquivo e a
+// miniatura já codificada
+type Processada struct {
+	ContentType string
+	Extensao    string
+	Largura     int
+	Altura      int
+
+	Miniatura            []byte
+	ContentTypeMiniatura string
+	ExtensaoMiniatura    string
+}
+
+// DetectarTipo olha os primeiros bytes do arquivo; o Content-Type e o nome
+// enviados pelo cliente não são confiáveis
+func DetectarTipo(dados []byte) (string, error) {
+	tipo := http.DetectContentType(dados)
+	if _, ok := tiposAceitos[tipo]; !ok {
+		return "", fmt.Errorf("%w (recebido %s)", ErrTipoNaoSuportado, tipo)
+	}
+	return tipo, nil
+}
+
+// Processar confere tipo, tamanho e dimensões e gera a miniatura. JPEG
+// continua JPEG; os demais viram PNG para manter a transparência.
+func Processar(dados []byte) (Processada, error) {
+	if len(dados) > TamanhoMaximo {
+		return Processada{}, ErrImagemGrande
+	}
+	tipo, err := DetectarTipo(dados)
+	if err != nil {
+		return Processada{}, err
+	}
+
+	// 🛡️ Dimensões pelo cabeçalho antes de decodificar tudo
+	config, _, err := image.DecodeConfig(bytes.NewReader(dados))
+	if err != nil {
+		return Processada{}, fmt.Errorf("%w: %v", ErrImagemInvalida, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxPixels {
+		return Processada{}, fmt.Errorf("%w: %dx%d pixels", ErrImagemInvalida, config.Width, config.Height)
+	}
+
+	original, _, err := image.Decode(bytes.NewReader(dados))
+	if err != nil {
+		return Processada{}, fmt.Errorf("%w: %v", ErrImagemInvalida, err)
+	}
+
+	p := Processada{
+		ContentType: tipo,
+		Extensao:    tiposAceitos[tipo],
+		Largura:     config.Width,
+		Altura:      config.Height,
+	}
+
+	var miniatura bytes.Buffer
+	reduzida := Reduzir(original, LadoMiniatura)
+	if tipo == "image/jpeg" {
+		err = jpeg.Encode(&miniatura, reduzida, &jpeg.Options{Quality: 80})
+		p.ContentTypeMiniatura, p.ExtensaoMiniatura = "image/jpeg", ".jpg"
+	} else {
+		err = png.Encode(&miniatura, reduzida)
+		p.ContentTypeMiniatura, p.ExtensaoMiniatura = "image/png", ".png"
+	}
+	if err != nil {
+		return Processada{}, fmt.Errorf("erro ao gerar miniatura: %w", err)
+	}
+	p.Miniatura = miniatura.Bytes()
+	return p, nil
+}
+
+// Reduzir encolhe a imagem para caber em lado×lado mantendo a proporção.
+// Imagem menor que isso não é ampliada.
+func Reduzir(img image.Image, lado int) image.Image {
+	b := img.Bounds()
+	largura, altura := b.Dx(), b.Dy()
+	if largura <= lado && altura <= lado {
+		return img
+	}
+
+	if largura >= altura {
+		altura = max(1, altura*lado/largura)
+		largura = lado
+	} else {
+		largura = max(1, largura*lado/altura)
+		altura = lado
+	}
+
+	destino := image.NewRGBA(image.Rect(0, 0, largura, altura))
+	draw.CatmullRom.Scale(destino, destino.Bounds(), img, b, draw.Src, nil)
+	return destino
+}
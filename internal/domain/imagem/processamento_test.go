@@ -0,0 +1,73 @@
+package imagem
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func gerarPNG(t *testing.T, largura, altura int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, largura, altura))
+	for x := 0; x < largura; x++ {
+		img.Set(x, 0, color.NRGBA{R: 200, A: 128})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestProcessar_PNGGeraMiniaturaPNG(t *testing.T) {
+	p, err := Processar(gerarPNG(t, 1000, 500))
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", p.ContentType)
+	assert.Equal(t, ".png", p.Extensao)
+	assert.Equal(t, 1000, p.Largura)
+	assert.Equal(t, 500, p.Altura)
+	assert.Equal(t, "image/png", p.ContentTypeMiniatura)
+
+	miniatura, err := png.Decode(bytes.NewReader(p.Miniatura))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 320, 160), miniatura.Bounds())
+}
+
+func TestProcessar_JPEGContinuaJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 400, 800)), nil))
+
+	p, err := Processar(buf.Bytes())
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", p.ContentType)
+	assert.Equal(t, ".jpg", p.ExtensaoMiniatura)
+	miniatura, err := jpeg.Decode(bytes.NewReader(p.Miniatura))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 160, 320), miniatura.Bounds())
+}
+
+func TestProcessar_ImagemPequenaNaoAmplia(t *testing.T) {
+	p, err := Processar(gerarPNG(t, 50, 40))
+
+	require.NoError(t, err)
+	miniatura, err := png.Decode(bytes.NewReader(p.Miniatura))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 50, 40), miniatura.Bounds())
+}
+
+func TestProcessar_Recusa(t *testing.T) {
+	_, err := Processar([]byte("%PDF-1.4 não é imagem"))
+	assert.ErrorIs(t, err, ErrTipoNaoSuportado)
+
+	// Cabeçalho de PNG com o resto corrompido
+	corrompido := gerarPNG(t, 10, 10)[:20]
+	_, err = Processar(corrompido)
+	assert.ErrorIs(t, err, ErrImagemInvalida)
+
+	_, err = Processar(make([]byte, TamanhoMaximo+1))
+	assert.ErrorIs(t, err, ErrImagemGrande)
+}
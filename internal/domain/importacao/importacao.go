@@ -0,0 +1,98 @@
+package importacao
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"time"
+)
+
+type Formato string
+
+const (
+	FormatoCSV    Formato = "csv"
+	FormatoNDJSON Formato = "ndjson"
+)
+
+// Linha é uma linha do arquivo já interpretada. Se não deu para ler a
+// linha (preço não numérico, JSON quebrado...), Erro vem preenchido e a
+// importação só registra a falha no relatório.
+type Linha struct {
+	Numero    int
+	Code      string // Só usado no modo upsert
+	Nome      string
+	Descricao string
+	Preco     dinheiro.Dinheiro
+	Estoque   int
+	Erro      string
+}
+
+type Opcoes struct {
+	DryRun    bool // Valida tudo sem gravar nada
+	Upsert    bool // Linhas com code de um item existente atualizam o item
+	UsuarioID int
+	Admin     bool // Admin pode atualizar itens de qualquer usuário
+}
+
+type Acao string
+
+const (
+	AcaoCriado     Acao = "criado"
+	AcaoAtualizado Acao = "atualizado"
+	AcaoErro       Acao = "erro"
+)
+
+type ResultadoLinha struct {
+	Linha  int
+	Acao   Acao
+	ItemID int
+	Code   string
+	Erro   string
+}
+
+// Relatorio resume a importação linha a linha. Em DryRun as ações dizem o
+// que teria acontecido.
+type Relatorio struct {
+	DryRun      bool
+	Total       int
+	Criados     int
+	Atualizados int
+	Erros       int
+	Linhas      []ResultadoLinha
+}
+
+func (r *Relatorio) Registrar(resultado ResultadoLinha) {
+	r.Total++
+	switch resultado.Acao {
+	case AcaoCriado:
+		r.Criados++
+	case AcaoAtualizado:
+		r.Atualizados++
+	case AcaoErro:
+		r.Erros++
+	}
+	r.Linhas = append(r.Linhas, resultado)
+}
+
+type StatusJob string
+
+const (
+	StatusPendente    StatusJob = "pendente"
+	StatusProcessando StatusJob = "processando"
+	StatusConcluido   StatusJob = "concluido"
+	StatusFalhou      StatusJob = "falhou"
+)
+
+var ErrJobNaoEncontrado = errors.New("importação não encontrada")
+
+// Job é uma importação grande rodando em segundo plano
+type Job struct {
+	ID           string
+	Status       StatusJob
+	Total        int
+	Processadas  int
+	Relatorio    *Relatorio // Preenchido ao terminar (inclusive se interrompido)
+	Erro         string
+	CriadoPor    int
+	CriadoEm     time.Time
+	AtualizadoEm time.Time
+}
@@ -0,0 +1,195 @@
+package importacao
+
+import (
+	"bufio"
+	"bytes"
+	"desafio-itens-app/internal/domain/dinheiro"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Ler interpreta o arquivo inteiro. Só devolve erro quando o arquivo todo é
+// inválido (ex.: CSV sem a coluna nome); problemas de uma linha vão em Linha.Erro.
+func Ler(formato Formato, r io.Reader) ([]Linha, error) {
+	switch formato {
+	case FormatoCSV:
+		return LerCSV(r)
+	case FormatoNDJSON:
+		return LerNDJSON(r)
+	default:
+		return nil, fmt.Errorf("formato não suportado: %q (use csv ou ndjson)", formato)
+	}
+}
+
+// LerCSV espera um cabeçalho com as colunas nome, preco e estoque (e,
+// opcionalmente, code, descricao e moeda) em qualquer ordem. Aceita "," ou ";"
+// como separador e preço no formato brasileiro (1.234,56).
+func LerCSV(r io.Reader) ([]Linha, error) {
+	br := bufio.NewReader(r)
+	primeira, err := br.Peek(4096)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, err
+	}
+
+	leitor := csv.NewReader(br)
+	leitor.Comma = detectarSeparador(primeira)
+	leitor.FieldsPerRecord = -1 // Linhas com colunas faltando viram erro da linha
+	leitor.TrimLeadingSpace = true
+
+	cabecalho, err := leitor.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("arquivo vazio")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cabeçalho inválido: %w", err)
+	}
+
+	colunas := make(map[string]int, len(cabecalho))
+	for i, nome := range cabecalho {
+		nome = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(nome, "\ufeff"))) // BOM do Excel
+		colunas[nome] = i
+	}
+	for _, obrigatoria := range []string{"nome", "preco", "estoque"} {
+		if _, ok := colunas[obrigatoria]; !ok {
+			return nil, fmt.Errorf("coluna obrigatória ausente no cabeçalho: %s", obrigatoria)
+		}
+	}
+
+	var linhas []Linha
+	numero := 1
+	for {
+		registro, err := leitor.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		numero++
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				linhas = append(linhas, Linha{Numero: numero, Erro: parseErr.Err.Error()})
+				continue
+			}
+			return nil, err
+		}
+
+		campo := func(nome string) string {
+			i, ok := colunas[nome]
+			if !ok || i >= len(registro) {
+				return ""
+			}
+			return strings.TrimSpace(registro[i])
+		}
+
+		linha := Linha{
+			Numero:    numero,
+			Code:      campo("code"),
+			Nome:      campo("nome"),
+			Descricao: campo("descricao"),
+		}
+		if linha.Preco, err = lerPreco(campo("preco"), campo("moeda")); err != nil {
+			linha.Erro = err.Error()
+		} else if linha.Estoque, err = lerEstoque(campo("estoque")); err != nil {
+			linha.Erro = err.Error()
+		}
+		linhas = append(linhas, linha)
+	}
+
+	return linhas, nil
+}
+
+type linhaJSON struct {
+	Code      string      `json:"code"`
+	Nome      string      `json:"nome"`
+	Descricao string      `json:"descricao"`
+	Preco     json.Number `json:"preco"`
+	Moeda     string      `json:"moeda"`
+	Estoque   int         `json:"estoque"`
+}
+
+// LerNDJSON lê um objeto JSON por linha, com os mesmos campos do POST /v1/itens
+// (mais code, para o modo upsert). Linhas em branco são ignoradas.
+func LerNDJSON(r io.Reader) ([]Linha, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var linhas []Linha
+	numero := 0
+	for scanner.Scan() {
+		numero++
+		texto := bytes.TrimSpace(scanner.Bytes())
+		if len(texto) == 0 {
+			continue
+		}
+
+		var l linhaJSON
+		decoder := json.NewDecoder(bytes.NewReader(texto))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&l); err != nil {
+			linhas = append(linhas, Linha{Numero: numero, Erro: fmt.Sprintf("JSON inválido: %v", err)})
+			continue
+		}
+
+		linha := Linha{
+			Numero:    numero,
+			Code:      strings.TrimSpace(l.Code),
+			Nome:      strings.TrimSpace(l.Nome),
+			Descricao: strings.TrimSpace(l.Descricao),
+			Estoque:   l.Estoque,
+		}
+		preco, err := lerPreco(l.Preco.String(), l.Moeda)
+		if err != nil {
+			linha.Erro = err.Error()
+		}
+		linha.Preco = preco
+		linhas = append(linhas, linha)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	return linhas, nil
+}
+
+// detectarSeparador usa ";" quando o cabeçalho tem ";" e não tem "," (Excel pt-BR)
+func detectarSeparador(inicio []byte) rune {
+	cabecalho, _, _ := bytes.Cut(inicio, []byte("\n"))
+	if bytes.Contains(cabecalho, []byte(";")) && !bytes.Contains(cabecalho, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// lerPreco aceita "1234.56", "1234,56" e "1.234,56". Sem moeda, o preço
+// é em reais.
+func lerPreco(texto, moedaTexto string) (dinheiro.Dinheiro, error) {
+	moeda := dinheiro.MoedaPadrao
+	if moedaTexto != "" {
+		var err error
+		if moeda, err = dinheiro.ParseMoeda(moedaTexto); err != nil {
+			return dinheiro.Dinheiro{}, err
+		}
+	}
+
+	if strings.Contains(texto, ",") {
+		texto = strings.ReplaceAll(texto, ".", "")
+		texto = strings.ReplaceAll(texto, ",", ".")
+	}
+	preco, err := dinheiro.Parse(texto, moeda)
+	if err != nil {
+		return dinheiro.Dinheiro{}, fmt.Errorf("preço inválido: %q", texto)
+	}
+	return preco, nil
+}
+
+func lerEstoque(texto string) (int, error) {
+	estoque, err := strconv.Atoi(texto)
+	if err != nil {
+		return 0, fmt.Errorf("estoque inválido: %q", texto)
+	}
+	return estoque, nil
+}
@@ -0,0 +1,110 @@
+package importacao
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestLerCSV_Sucesso(t *testing.T) {
+	//ARRANGE
+	arquivo := "nome,descricao,preco,estoque\nMouse,Sem fio,99.90,10\nTeclado,,150,0\n"
+
+	//ACT
+	linhas, err := LerCSV(strings.NewReader(arquivo))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, []Linha{
+		{Numero: 2, Nome: "Mouse", Descricao: "Sem fio", Preco: dinheiro.Novo(9990, dinheiro.BRL), Estoque: 10},
+		{Numero: 3, Nome: "Teclado", Preco: dinheiro.Novo(15000, dinheiro.BRL), Estoque: 0},
+	}, linhas)
+}
+
+func TestLerCSV_FormatoBrasileiro(t *testing.T) {
+	//ARRANGE - Excel pt-BR: ";" como separador, vírgula decimal e BOM
+	arquivo := "\ufeffCode;Nome;Preco;Estoque\nMO12345678;Monitor;1.234,56;3\n"
+
+	//ACT
+	linhas, err := LerCSV(strings.NewReader(arquivo))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, linhas, 1)
+	assert.Equal(t, "MO12345678", linhas[0].Code)
+	assert.Equal(t, dinheiro.Novo(123456, dinheiro.BRL), linhas[0].Preco)
+	assert.Equal(t, 3, linhas[0].Estoque)
+}
+
+func TestLerCSV_ErroNaLinhaNaoInterrompe(t *testing.T) {
+	//ARRANGE
+	arquivo := "nome,preco,estoque\nMouse,abc,10\nTeclado,10,1\n"
+
+	//ACT
+	linhas, err := LerCSV(strings.NewReader(arquivo))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, linhas, 2)
+	assert.Equal(t, `preço inválido: "abc"`, linhas[0].Erro)
+	assert.Empty(t, linhas[1].Erro)
+}
+
+func TestLerCSV_SemColunaObrigatoria(t *testing.T) {
+	//ACT
+	_, err := LerCSV(strings.NewReader("nome,preco\nMouse,10\n"))
+
+	//ASSERT
+	assert.Error(t, err)
+	assert.Equal(t, "coluna obrigatória ausente no cabeçalho: estoque", err.Error())
+}
+
+func TestLerNDJSON(t *testing.T) {
+	//ARRANGE
+	arquivo := `{"nome":"Mouse","preco":99.9,"estoque":10}
+
+{"nome":"Teclado","preco":"caro"}
+{"code":"TE00000001","nome":"Teclado","preco":150,"estoque":2,"cor":"preto"}
+`
+
+	//ACT
+	linhas, err := LerNDJSON(strings.NewReader(arquivo))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, linhas, 3)
+	assert.Equal(t, Linha{Numero: 1, Nome: "Mouse", Preco: dinheiro.Novo(9990, dinheiro.BRL), Estoque: 10}, linhas[0])
+	assert.Equal(t, 3, linhas[1].Numero)
+	assert.Contains(t, linhas[1].Erro, "JSON inválido")
+	assert.Contains(t, linhas[2].Erro, "cor") // campo desconhecido
+}
+
+func TestRelatorio_Registrar(t *testing.T) {
+	//ARRANGE
+	var relatorio Relatorio
+
+	//ACT
+	relatorio.Registrar(ResultadoLinha{Linha: 2, Acao: AcaoCriado})
+	relatorio.Registrar(ResultadoLinha{Linha: 3, Acao: AcaoAtualizado})
+	relatorio.Registrar(ResultadoLinha{Linha: 4, Acao: AcaoErro, Erro: "Nome é obrigatório"})
+
+	//ASSERT
+	assert.Equal(t, 3, relatorio.Total)
+	assert.Equal(t, 1, relatorio.Criados)
+	assert.Equal(t, 1, relatorio.Atualizados)
+	assert.Equal(t, 1, relatorio.Erros)
+}
+
+func TestLerCSV_ColunaMoeda(t *testing.T) {
+	//ARRANGE
+	arquivo := "nome,preco,moeda,estoque\nMouse,10.005,usd,1\nTeclado,10,JPY,1\n"
+
+	//ACT
+	linhas, err := LerCSV(strings.NewReader(arquivo))
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, dinheiro.Novo(1001, dinheiro.USD), linhas[0].Preco)
+	assert.Equal(t, dinheiro.ErrMoedaNaoSuportada.Error(), linhas[1].Erro)
+}
@@ -0,0 +1,101 @@
+package item
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrEANInvalido: não tem 13 dígitos ou o dígito verificador não bate
+	ErrEANInvalido = errors.New("EAN-13 inválido")
+	// ErrEANDuplicado: outro item já usa o EAN
+	ErrEANDuplicado = errors.New("já existe um item com esse EAN")
+)
+
+// prefixoEANInterno: a faixa 20–29 do GS1 é de circulação restrita, livre
+// para códigos da própria loja
+const prefixoEANInterno = "2"
+
+// DigitoEAN13 calcula o dígito verificador dos 12 primeiros dígitos: da
+// direita para a esquerda, pesos 3 e 1 alternados
+func DigitoEAN13(doze string) (byte, error) {
+	if len(doze) != 12 || !soDigitos(doze) {
+		return 0, fmt.Errorf("%w: informe 12 dígitos para calcular o verificador", ErrEANInvalido)
+	}
+
+	soma := 0
+	for i := 0; i < 12; i++ {
+		d := int(doze[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		soma += d
+	}
+	return byte('0' + (10-soma%10)%10), nil
+}
+
+// ValidarEAN13 confere tamanho, dígitos e verificador
+func ValidarEAN13(ean string) error {
+	if len(ean) != 13 || !soDigitos(ean) {
+		return fmt.Errorf("%w: %q deve ter 13 dígitos", ErrEANInvalido, ean)
+	}
+	digito, _ := DigitoEAN13(ean[:12])
+	if ean[12] != digito {
+		return fmt.Errorf("%w: o dígito verificador de %q deveria ser %c", ErrEANInvalido, ean, digito)
+	}
+	return nil
+}
+
+// ValidarEAN confere o EAN cadastrado no item. A faixa 2 fica de fora:
+// é dos códigos internos, e um EAN cadastrado nela poderia repetir o de
+// outro item.
+func (i *Item) ValidarEAN() error {
+	if i.EAN == nil {
+		return nil
+	}
+	if err := ValidarEAN13(*i.EAN); err != nil {
+		return err
+	}
+	if strings.HasPrefix(*i.EAN, prefixoEANInterno) {
+		return fmt.Errorf("%w: EANs começados em %s são de uso interno", ErrEANInvalido, prefixoEANInterno)
+	}
+	return nil
+}
+
+// NormalizarEAN tira espaços e hífens ("789 1234-567895" → "7891234567895");
+// vazio continua vazio (item sem EAN)
+func NormalizarEAN(ean string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(ean))
+}
+
+// EANInterno deriva um EAN-13 de circulação restrita do id do item:
+// 2 + id com 11 dígitos + verificador. Não colide com EANs de fabricante.
+func EANInterno(id int) (string, error) {
+	if id <= 0 || id > 99_999_999_999 {
+		return "", fmt.Errorf("%w: id %d fora da faixa do EAN interno", ErrEANInvalido, id)
+	}
+	doze := fmt.Sprintf("%s%011d", prefixoEANInterno, id)
+	digito, err := DigitoEAN13(doze)
+	if err != nil {
+		return "", err
+	}
+	return doze + string(digito), nil
+}
+
+// CodigoEAN é o EAN usado nas etiquetas: o cadastrado ou, sem ele, o interno
+func (i *Item) CodigoEAN() (string, error) {
+	if i.EAN != nil {
+		return *i.EAN, nil
+	}
+	return EANInterno(i.ID)
+}
+
+func soDigitos(s string) bool {
+	for _, char := range s {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
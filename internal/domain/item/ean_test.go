@@ -0,0 +1,66 @@
+package item
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDigitoEAN13(t *testing.T) {
+	casos := map[string]byte{
+		"789100031550": '7', // 7891000315507
+		"400638133393": '1', // 4006381333931
+		"590123412345": '7', // 5901234123457
+		"000000000000": '0',
+	}
+
+	for doze, esperado := range casos {
+		//ACT
+		digito, err := DigitoEAN13(doze)
+
+		//ASSERT
+		assert.NoError(t, err, doze)
+		assert.Equal(t, string(esperado), string(digito), doze)
+	}
+}
+
+func TestValidarEAN13(t *testing.T) {
+	assert.NoError(t, ValidarEAN13("4006381333931"))
+	assert.ErrorIs(t, ValidarEAN13("4006381333932"), ErrEANInvalido) // Verificador errado
+	assert.ErrorIs(t, ValidarEAN13("400638133393"), ErrEANInvalido)  // 12 dígitos
+	assert.ErrorIs(t, ValidarEAN13("40063813339A1"), ErrEANInvalido)
+}
+
+func TestEANInterno(t *testing.T) {
+	//ACT
+	ean, err := EANInterno(42)
+
+	//ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, "2000000000428", ean)
+	assert.NoError(t, ValidarEAN13(ean))
+
+	_, err = EANInterno(0)
+	assert.ErrorIs(t, err, ErrEANInvalido)
+}
+
+func TestCodigoEAN_CadastradoOuInterno(t *testing.T) {
+	cadastrado := "7891000315507"
+	comEAN := Item{ID: 42, EAN: &cadastrado}
+	semEAN := Item{ID: 42}
+
+	ean, _ := comEAN.CodigoEAN()
+	assert.Equal(t, cadastrado, ean)
+	ean, _ = semEAN.CodigoEAN()
+	assert.Equal(t, "2000000000428", ean)
+}
+
+func TestValidarEAN_RecusaFaixaInterna(t *testing.T) {
+	interno := "2000000000428"
+	item := Item{EAN: &interno}
+
+	assert.ErrorIs(t, item.ValidarEAN(), ErrEANInvalido)
+}
+
+func TestNormalizarEAN(t *testing.T) {
+	assert.Equal(t, "7891000315507", NormalizarEAN(" 789 1000-315507 "))
+}
@@ -1,7 +1,9 @@
 package item
 
 import (
+	"desafio-itens-app/internal/domain/dinheiro"
 	"errors"
+	"strings"
 	"time"
 )
 
@@ -12,35 +14,164 @@ const (
 	StatusInativo Status = "inactive"
 )
 
+// ErrCodeDuplicado indica que já existe um item com o mesmo código.
+var ErrCodeDuplicado = errors.New("já existe um item com esse código")
+
+// ErrItemNaoEncontrado indica que não há item com o id pedido.
+var ErrItemNaoEncontrado = errors.New("Item não encontrado")
+
+// ErrEdicaoNaoPermitida: só admin ou quem criou o item pode alterá-lo (ver
+// PodeSerEditadoPor)
+var ErrEdicaoNaoPermitida = errors.New("Você só pode editar itens que criou")
+
+// ErrItemInvalido marca o item que as regras de negócio recusam: repetir a
+// gravação não adianta. Ver Invalido.
+var ErrItemInvalido = errors.New("item inválido")
+
+// Invalido marca err como ErrItemInvalido sem mudar a mensagem que o
+// cliente vê
+func Invalido(err error) error {
+	return erroValidacao{err}
+}
+
+type erroValidacao struct{ error }
+
+func (e erroValidacao) Is(alvo error) bool { return alvo == ErrItemInvalido }
+func (e erroValidacao) Unwrap() error      { return e.error }
+
 type Item struct {
 	ID        int
 	Code      string
+	EAN       *string // EAN-13 do fabricante (opcional, ver ean.go)
 	Nome      string
 	Descricao string
-	Preco     float64
-	Estoque   int
+	Categoria string
+	Tags      []string // Sempre normalizadas (ver NormalizarTags)
+	Preco     dinheiro.Dinheiro
+	Estoque   int // Total somado de todos os depósitos
 	Status    Status
+
+	// Reposição: com PontoReposicao > 0, estoque nesse nível ou abaixo
+	// dispara alerta (ver AtualizarAlertaEstoque)
+	PontoReposicao      int
+	QuantidadeReposicao int  // Sugestão de compra
+	EstoqueEmAlerta     bool // Último estado avisado; evita alerta repetido
+
+	// Variantes (ver variante.go): o produto define os eixos e cada
+	// variante é um item com ProdutoID e um valor por eixo
+	ProdutoID    *int
+	Eixos        []Eixo            // Só no produto
+	Opcoes       map[string]string // Só na variante: eixo → valor
+	PrecoProprio bool              // Variante com preço próprio; senão segue o produto
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	CreatedBy *int
 	UpdateBy  *int
 }
 
+// ResumoEstoque é a fotografia do catálogo usada nos indicadores
+type ResumoEstoque struct {
+	PorStatus map[Status]int
+	// Σ Preco × Estoque por moeda (não dá para somar reais com dólares)
+	ValorPorMoeda map[dinheiro.Moeda]dinheiro.Dinheiro
+}
+
+// AtualizarStatus aplica a regra de status: sem estoque em nenhum
+// depósito = inativo
+func (i *Item) AtualizarStatus() {
+	if i.Estoque == 0 {
+		i.Status = StatusInativo
+	} else {
+		i.Status = StatusAtivo
+	}
+}
+
+// PodeSerEditadoPor diz se o usuário pode alterar o item: admin altera
+// qualquer item, os demais só os que criaram.
+func (i *Item) PodeSerEditadoPor(userID int, admin bool) bool {
+	if admin {
+		return true
+	}
+	return i.CreatedBy != nil && *i.CreatedBy == userID
+}
+
 func (i *Item) IsValid() error {
 	if i.Nome == "" {
 		return errors.New("Nome é obrigatório")
 	}
-	if i.Preco <= 0 {
+	if !i.Preco.Positivo() {
 		return errors.New("Preço deve ser maior que zero")
 	}
+	if !i.Preco.Moeda.Valida() {
+		return dinheiro.ErrMoedaNaoSuportada
+	}
 
 	if i.Estoque < 0 {
 		return errors.New("Estoque não pode ser negativo")
 	}
 
+	if err := i.ValidarClassificacao(); err != nil {
+		return err
+	}
+
+	if err := i.ValidarEAN(); err != nil {
+		return err
+	}
+
+	if err := i.ValidarReposicao(); err != nil {
+		return err
+	}
+
 	if i.Status != StatusAtivo && i.Status != StatusInativo {
 		return errors.New("status deve ser 'active' ou 'inative'")
 	}
 
 	return nil
 }
+
+// MaxTags limita as tags de um item
+const MaxTags = 20
+
+// ValidarClassificacao confere categoria e tags (usadas pelas promoções)
+func (i *Item) ValidarClassificacao() error {
+	if len(i.Categoria) > 50 {
+		return errors.New("Categoria deve ter no máximo 50 caracteres")
+	}
+	if len(i.Tags) > MaxTags {
+		return errors.New("O item pode ter no máximo 20 tags")
+	}
+	for _, tag := range i.Tags {
+		if len(tag) > 50 {
+			return errors.New("Tags devem ter no máximo 50 caracteres")
+		}
+	}
+	return nil
+}
+
+// NormalizarTags deixa as tags em minúsculas, sem espaços nas pontas, sem
+// vazias e sem repetidas, na ordem em que vieram
+func NormalizarTags(tags []string) []string {
+	normalizadas := make([]string, 0, len(tags))
+	vistas := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || vistas[tag] {
+			continue
+		}
+		vistas[tag] = true
+		normalizadas = append(normalizadas, tag)
+	}
+	return normalizadas
+}
+
+// TemTag diz se o item tem a tag (sem diferenciar maiúsculas)
+func (i *Item) TemTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, t := range i.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
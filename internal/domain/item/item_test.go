@@ -1,6 +1,7 @@
 package item
 
 import (
+	"desafio-itens-app/internal/domain/dinheiro"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -9,7 +10,7 @@ func TestItem_IsValid_Sucess(t *testing.T) {
 	//ARRANGE - Prepara Dados
 	item := Item{
 		Nome:    "itemtest",
-		Preco:   99.99,
+		Preco:   dinheiro.Novo(9999, dinheiro.BRL),
 		Estoque: 10.00,
 		Status:  StatusAtivo,
 	}
@@ -25,7 +26,7 @@ func TestItem_IsValid_NomeVazio(t *testing.T) {
 	//ARRANGE - Prepara Dados
 	item := Item{
 		Nome:    "",
-		Preco:   99.99,
+		Preco:   dinheiro.Novo(9999, dinheiro.BRL),
 		Estoque: 10.00,
 		Status:  StatusAtivo,
 	}
@@ -42,7 +43,7 @@ func TestItem_IsValid_PrecoInvalido(t *testing.T) {
 	//ARRANGE
 	item := Item{
 		Nome:    "Produto Teste",
-		Preco:   0,
+		Preco:   dinheiro.Novo(0, dinheiro.BRL),
 		Estoque: 10,
 		Status:  StatusAtivo,
 	}
@@ -55,11 +56,27 @@ func TestItem_IsValid_PrecoInvalido(t *testing.T) {
 	assert.Equal(t, "Preço deve ser maior que zero", err.Error())
 }
 
+func TestItem_IsValid_MoedaNaoSuportada(t *testing.T) {
+	//ARRANGE
+	item := Item{
+		Nome:    "Produto Teste",
+		Preco:   dinheiro.Novo(1000, "JPY"),
+		Estoque: 10,
+		Status:  StatusAtivo,
+	}
+
+	//ACT
+	err := item.IsValid()
+
+	//ASSERT
+	assert.ErrorIs(t, err, dinheiro.ErrMoedaNaoSuportada)
+}
+
 func TestItem_IsValid_StatusInvalido(t *testing.T) {
 	//ARRANGE
 	item := Item{
 		Nome:    "Produto Teste",
-		Preco:   100,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: 10,
 		Status:  "Outro Status",
 	}
@@ -76,7 +93,7 @@ func TestItem_IsValid_EstoqueInvalido(t *testing.T) {
 	//ARRANGE
 	item := Item{
 		Nome:    "Produto Teste",
-		Preco:   100.00,
+		Preco:   dinheiro.Novo(10000, dinheiro.BRL),
 		Estoque: -10,
 		Status:  StatusAtivo,
 	}
@@ -88,3 +105,69 @@ func TestItem_IsValid_EstoqueInvalido(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "Estoque não pode ser negativo", err.Error())
 }
+
+func TestItem_AtualizarStatus(t *testing.T) {
+	//ARRANGE
+	semEstoque := Item{Estoque: 0, Status: StatusAtivo}
+	comEstoque := Item{Estoque: 5, Status: StatusInativo}
+
+	//ACT
+	semEstoque.AtualizarStatus()
+	comEstoque.AtualizarStatus()
+
+	//ASSERT
+	assert.Equal(t, StatusInativo, semEstoque.Status)
+	assert.Equal(t, StatusAtivo, comEstoque.Status)
+}
+
+func TestItem_PodeSerEditadoPor(t *testing.T) {
+	//ARRANGE
+	criador := 7
+	item := Item{CreatedBy: &criador}
+	semCriador := Item{}
+
+	//ASSERT
+	assert.True(t, item.PodeSerEditadoPor(7, false))
+	assert.False(t, item.PodeSerEditadoPor(8, false))
+	assert.True(t, item.PodeSerEditadoPor(8, true))
+	assert.False(t, semCriador.PodeSerEditadoPor(7, false))
+}
+
+func TestNormalizarTags(t *testing.T) {
+	//ACT
+	tags := NormalizarTags([]string{" Gamer ", "periféricos", "", "GAMER", "usb"})
+
+	//ASSERT
+	assert.Equal(t, []string{"gamer", "periféricos", "usb"}, tags)
+}
+
+func TestItem_IsValid_MuitasTags(t *testing.T) {
+	//ARRANGE
+	item := Item{
+		Nome:    "itemtest",
+		Preco:   dinheiro.Novo(9999, dinheiro.BRL),
+		Estoque: 1,
+		Status:  StatusAtivo,
+		Tags:    make([]string, MaxTags+1),
+	}
+
+	//ACT
+	err := item.IsValid()
+
+	//ASSERT
+	assert.EqualError(t, err, "O item pode ter no máximo 20 tags")
+}
+
+func TestInvalido_MantemMensagemECausa(t *testing.T) {
+	//ARRANGE
+	ean := "123"
+	item := Item{EAN: &ean}
+
+	//ACT
+	err := Invalido(item.ValidarEAN())
+
+	//ASSERT
+	assert.ErrorIs(t, err, ErrItemInvalido)
+	assert.ErrorIs(t, err, ErrEANInvalido)
+	assert.Equal(t, item.ValidarEAN().Error(), err.Error())
+}
@@ -0,0 +1,68 @@
+package item
+
+import (
+	"errors"
+	"time"
+)
+
+type TipoAlerta string
+
+const (
+	AlertaEstoqueBaixo       TipoAlerta = "estoque_baixo"
+	AlertaEstoqueNormalizado TipoAlerta = "estoque_normalizado" // Voltou acima do ponto
+)
+
+// AlertaEstoque é o evento enviado quando o estoque cruza o ponto de
+// reposição, em qualquer direção
+type AlertaEstoque struct {
+	Tipo                TipoAlerta
+	ItemID              int
+	Code                string
+	Nome                string
+	Estoque             int
+	PontoReposicao      int
+	QuantidadeReposicao int
+	Em                  time.Time
+}
+
+// ValidarReposicao: ponto e quantidade não podem ser negativos
+func (i *Item) ValidarReposicao() error {
+	if i.PontoReposicao < 0 {
+		return errors.New("Ponto de reposição não pode ser negativo")
+	}
+	if i.QuantidadeReposicao < 0 {
+		return errors.New("Quantidade de reposição não pode ser negativa")
+	}
+	return nil
+}
+
+// EstoqueBaixo: o item tem ponto de reposição e o estoque chegou nele
+func (i *Item) EstoqueBaixo() bool {
+	return i.PontoReposicao > 0 && i.Estoque <= i.PontoReposicao
+}
+
+// AtualizarAlertaEstoque guarda em EstoqueEmAlerta se o estoque está baixo e
+// devolve o alerta quando isso mudou (o estoque cruzou o ponto). Como o
+// estado é gravado com o item, cada cruzamento avisa uma vez só.
+func (i *Item) AtualizarAlertaEstoque(agora time.Time) (AlertaEstoque, bool) {
+	baixo := i.EstoqueBaixo()
+	if baixo == i.EstoqueEmAlerta {
+		return AlertaEstoque{}, false
+	}
+	i.EstoqueEmAlerta = baixo
+
+	tipo := AlertaEstoqueNormalizado
+	if baixo {
+		tipo = AlertaEstoqueBaixo
+	}
+	return AlertaEstoque{
+		Tipo:                tipo,
+		ItemID:              i.ID,
+		Code:                i.Code,
+		Nome:                i.Nome,
+		Estoque:             i.Estoque,
+		PontoReposicao:      i.PontoReposicao,
+		QuantidadeReposicao: i.QuantidadeReposicao,
+		Em:                  agora.UTC(),
+	}, true
+}
@@ -0,0 +1,46 @@
+package item
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestItem_EstoqueBaixo(t *testing.T) {
+	assert.True(t, (&Item{Estoque: 5, PontoReposicao: 5}).EstoqueBaixo())
+	assert.False(t, (&Item{Estoque: 6, PontoReposicao: 5}).EstoqueBaixo())
+	assert.False(t, (&Item{Estoque: 0}).EstoqueBaixo()) // Sem ponto de reposição não há alerta
+}
+
+func TestItem_AtualizarAlertaEstoque(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	item := Item{ID: 7, Nome: "Mouse", Estoque: 10, PontoReposicao: 3, QuantidadeReposicao: 20}
+
+	_, mudou := item.AtualizarAlertaEstoque(agora)
+	assert.False(t, mudou)
+
+	// Cruzou para baixo: um alerta só, mesmo que continue caindo
+	item.Estoque = 3
+	alerta, mudou := item.AtualizarAlertaEstoque(agora)
+	assert.True(t, mudou)
+	assert.Equal(t, AlertaEstoqueBaixo, alerta.Tipo)
+	assert.Equal(t, 20, alerta.QuantidadeReposicao)
+	assert.True(t, item.EstoqueEmAlerta)
+
+	item.Estoque = 1
+	_, mudou = item.AtualizarAlertaEstoque(agora)
+	assert.False(t, mudou)
+
+	// Reposto
+	item.Estoque = 25
+	alerta, mudou = item.AtualizarAlertaEstoque(agora)
+	assert.True(t, mudou)
+	assert.Equal(t, AlertaEstoqueNormalizado, alerta.Tipo)
+	assert.False(t, item.EstoqueEmAlerta)
+}
+
+func TestItem_ValidarReposicao(t *testing.T) {
+	assert.Error(t, (&Item{PontoReposicao: -1}).ValidarReposicao())
+	assert.Error(t, (&Item{QuantidadeReposicao: -1}).ValidarReposicao())
+	assert.NoError(t, (&Item{PontoReposicao: 5, QuantidadeReposicao: 10}).ValidarReposicao())
+}
@@ -0,0 +1,221 @@
+package item
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Limites de eixos: 3 eixos de 20 valores já passam de qualquer grade real
+const (
+	MaxEixos       = 3
+	MaxValoresEixo = 20
+	MaxVariantes   = 100 // Por produto
+)
+
+var (
+	// ErrProdutoInvalido: o item não pode ter (ou deixar de ter) variantes
+	ErrProdutoInvalido = errors.New("produto inválido para variantes")
+	// ErrVarianteInvalida: opções que não batem com os eixos do produto
+	ErrVarianteInvalida = errors.New("variante inválida")
+	// ErrVarianteDuplicada: já existe irmã com a mesma combinação
+	ErrVarianteDuplicada = errors.New("já existe uma variante com essas opções")
+)
+
+// Eixo é uma dimensão de variação do produto, como "Tamanho" ou "Cor"
+type Eixo struct {
+	Nome    string
+	Valores []string
+}
+
+// Produto agrupa o item de topo com as suas variantes (vazio para item
+// sem variação)
+type Produto struct {
+	Item      Item
+	Variantes []Item
+}
+
+// EhVariante diz se o item pertence a um produto
+func (i *Item) EhVariante() bool {
+	return i.ProdutoID != nil
+}
+
+// TemEixos diz se o item é um produto com variações
+func (i *Item) TemEixos() bool {
+	return len(i.Eixos) > 0
+}
+
+// NormalizarEixos tira espaços das pontas, valores vazios e valores
+// repetidos (sem diferenciar maiúsculas), mantendo a ordem
+func NormalizarEixos(eixos []Eixo) []Eixo {
+	normalizados := make([]Eixo, 0, len(eixos))
+	for _, eixo := range eixos {
+		valores := make([]string, 0, len(eixo.Valores))
+		vistos := make(map[string]bool, len(eixo.Valores))
+		for _, valor := range eixo.Valores {
+			valor = strings.TrimSpace(valor)
+			if valor == "" || vistos[strings.ToLower(valor)] {
+				continue
+			}
+			vistos[strings.ToLower(valor)] = true
+			valores = append(valores, valor)
+		}
+		normalizados = append(normalizados, Eixo{Nome: strings.TrimSpace(eixo.Nome), Valores: valores})
+	}
+	return normalizados
+}
+
+// ValidarEixos confere nomes únicos e ao menos um valor por eixo. Lista
+// vazia é válida: o item deixa de ser produto.
+func ValidarEixos(eixos []Eixo) error {
+	if len(eixos) > MaxEixos {
+		return fmt.Errorf("%w: no máximo %d eixos", ErrProdutoInvalido, MaxEixos)
+	}
+
+	nomes := make(map[string]bool, len(eixos))
+	for _, eixo := range eixos {
+		if eixo.Nome == "" || len(eixo.Nome) > 30 {
+			return fmt.Errorf("%w: nome do eixo deve ter de 1 a 30 caracteres", ErrProdutoInvalido)
+		}
+		if nomes[strings.ToLower(eixo.Nome)] {
+			return fmt.Errorf("%w: eixo %q repetido", ErrProdutoInvalido, eixo.Nome)
+		}
+		nomes[strings.ToLower(eixo.Nome)] = true
+
+		if len(eixo.Valores) == 0 || len(eixo.Valores) > MaxValoresEixo {
+			return fmt.Errorf("%w: o eixo %q deve ter de 1 a %d valores", ErrProdutoInvalido, eixo.Nome, MaxValoresEixo)
+		}
+		for _, valor := range eixo.Valores {
+			if len(valor) > 30 {
+				return fmt.Errorf("%w: valores devem ter no máximo 30 caracteres", ErrProdutoInvalido)
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizarOpcoes confere as opções de uma variante contra os eixos do
+// produto: um valor permitido para cada eixo e nada além. Devolve as opções
+// com nome e valor escritos como no produto ("cor": "azul" → "Cor": "Azul").
+func (p *Item) NormalizarOpcoes(opcoes map[string]string) (map[string]string, error) {
+	if !p.TemEixos() {
+		return nil, fmt.Errorf("%w: o item %d não tem eixos de variação", ErrProdutoInvalido, p.ID)
+	}
+
+	informadas := make(map[string]string, len(opcoes))
+	for nome, valor := range opcoes {
+		informadas[strings.ToLower(strings.TrimSpace(nome))] = strings.TrimSpace(valor)
+	}
+
+	normalizadas := make(map[string]string, len(p.Eixos))
+	for _, eixo := range p.Eixos {
+		valor, ok := informadas[strings.ToLower(eixo.Nome)]
+		if !ok || valor == "" {
+			return nil, fmt.Errorf("%w: informe o valor de %q", ErrVarianteInvalida, eixo.Nome)
+		}
+		permitido := ""
+		for _, v := range eixo.Valores {
+			if strings.EqualFold(v, valor) {
+				permitido = v
+				break
+			}
+		}
+		if permitido == "" {
+			return nil, fmt.Errorf("%w: %q não é um valor de %q", ErrVarianteInvalida, valor, eixo.Nome)
+		}
+		normalizadas[eixo.Nome] = permitido
+		delete(informadas, strings.ToLower(eixo.Nome))
+	}
+
+	for nome := range informadas {
+		return nil, fmt.Errorf("%w: o produto não tem o eixo %q", ErrVarianteInvalida, nome)
+	}
+	return normalizadas, nil
+}
+
+// ChaveOpcoes identifica a combinação sem depender de ordem nem de
+// maiúsculas; irmãs não podem repetir a chave
+func ChaveOpcoes(opcoes map[string]string) string {
+	partes := make([]string, 0, len(opcoes))
+	for nome, valor := range opcoes {
+		partes = append(partes, strings.ToLower(nome)+"="+strings.ToLower(valor))
+	}
+	sort.Strings(partes)
+	return strings.Join(partes, "|")
+}
+
+// Combinacoes lista todas as combinações dos eixos, na ordem dos eixos e
+// dos valores
+func (p *Item) Combinacoes() []map[string]string {
+	combinacoes := []map[string]string{{}}
+	for _, eixo := range p.Eixos {
+		proximas := make([]map[string]string, 0, len(combinacoes)*len(eixo.Valores))
+		for _, base := range combinacoes {
+			for _, valor := range eixo.Valores {
+				opcoes := make(map[string]string, len(base)+1)
+				for k, v := range base {
+					opcoes[k] = v
+				}
+				opcoes[eixo.Nome] = valor
+				proximas = append(proximas, opcoes)
+			}
+		}
+		combinacoes = proximas
+	}
+	return combinacoes
+}
+
+// NovaVariante monta a variante a partir do produto: mesmo texto,
+// classificação e preço, nome com os valores ("Camiseta Azul M") e estoque
+// próprio. O código vem de CodigoVariante.
+func (p *Item) NovaVariante(opcoes map[string]string, estoque int) Item {
+	valores := make([]string, 0, len(p.Eixos))
+	for _, eixo := range p.Eixos {
+		valores = append(valores, opcoes[eixo.Nome])
+	}
+
+	produtoID := p.ID
+	return Item{
+		Nome:      strings.TrimSpace(p.Nome + " " + strings.Join(valores, " ")),
+		Descricao: p.Descricao,
+		Categoria: p.Categoria,
+		Tags:      append([]string(nil), p.Tags...),
+		Preco:     p.Preco,
+		Estoque:   estoque,
+		ProdutoID: &produtoID,
+		Opcoes:    opcoes,
+	}
+}
+
+// CodigoVariante deriva o código da variante do código do produto, com até
+// 3 letras de cada valor: CA12345678 + {Cor: Azul, Tamanho: M} →
+// CA12345678-AZU-M
+func (p *Item) CodigoVariante(opcoes map[string]string) string {
+	var codigo strings.Builder
+	codigo.WriteString(p.Code)
+	for _, eixo := range p.Eixos {
+		codigo.WriteByte('-')
+		n := 0
+		for _, char := range opcoes[eixo.Nome] {
+			if unicode.IsLetter(char) || unicode.IsDigit(char) {
+				codigo.WriteRune(unicode.ToUpper(char))
+				if n++; n == 3 {
+					break
+				}
+			}
+		}
+	}
+	return codigo.String()
+}
+
+// DefinirPreco troca o preço; numa variante, preço diferente do atual
+// passa a ser próprio e deixa de seguir o produto
+func (i *Item) DefinirPreco(preco dinheiro.Dinheiro) {
+	if i.EhVariante() && preco != i.Preco {
+		i.PrecoProprio = true
+	}
+	i.Preco = preco
+}
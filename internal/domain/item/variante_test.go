@@ -0,0 +1,103 @@
+package item
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func camiseta() Item {
+	return Item{
+		ID:    3,
+		Code:  "CA12345678",
+		Nome:  "Camiseta",
+		Tags:  []string{"verao"},
+		Preco: dinheiro.Novo(4990, dinheiro.BRL),
+		Eixos: []Eixo{
+			{Nome: "Tamanho", Valores: []string{"P", "M", "G"}},
+			{Nome: "Cor", Valores: []string{"Azul", "Preto"}},
+		},
+	}
+}
+
+func TestNormalizarEixos(t *testing.T) {
+	eixos := NormalizarEixos([]Eixo{{Nome: " Cor ", Valores: []string{"Azul", " azul", "", "Preto "}}})
+
+	assert.Equal(t, []Eixo{{Nome: "Cor", Valores: []string{"Azul", "Preto"}}}, eixos)
+}
+
+func TestValidarEixos(t *testing.T) {
+	assert.NoError(t, ValidarEixos(nil)) // Deixa de ser produto
+	assert.NoError(t, ValidarEixos(camiseta().Eixos))
+	assert.ErrorIs(t, ValidarEixos([]Eixo{{Nome: "Cor"}}), ErrProdutoInvalido)
+	assert.ErrorIs(t, ValidarEixos([]Eixo{{Nome: "Cor", Valores: []string{"Azul"}}, {Nome: "cor", Valores: []string{"Azul"}}}), ErrProdutoInvalido)
+	assert.ErrorIs(t, ValidarEixos([]Eixo{{Nome: "", Valores: []string{"Azul"}}}), ErrProdutoInvalido)
+}
+
+func TestNormalizarOpcoes(t *testing.T) {
+	produto := camiseta()
+
+	opcoes, err := produto.NormalizarOpcoes(map[string]string{"cor": "azul", " TAMANHO": "m"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"Cor": "Azul", "Tamanho": "M"}, opcoes)
+
+	_, err = produto.NormalizarOpcoes(map[string]string{"Cor": "Azul"})
+	assert.ErrorIs(t, err, ErrVarianteInvalida) // Falta tamanho
+
+	_, err = produto.NormalizarOpcoes(map[string]string{"Cor": "Verde", "Tamanho": "M"})
+	assert.ErrorIs(t, err, ErrVarianteInvalida)
+
+	_, err = produto.NormalizarOpcoes(map[string]string{"Cor": "Azul", "Tamanho": "M", "Manga": "Longa"})
+	assert.ErrorIs(t, err, ErrVarianteInvalida)
+
+	semEixos := Item{ID: 4}
+	_, err = semEixos.NormalizarOpcoes(map[string]string{"Cor": "Azul"})
+	assert.ErrorIs(t, err, ErrProdutoInvalido)
+}
+
+func TestChaveOpcoes_IgnoraOrdemEMaiusculas(t *testing.T) {
+	assert.Equal(t,
+		ChaveOpcoes(map[string]string{"Cor": "Azul", "Tamanho": "M"}),
+		ChaveOpcoes(map[string]string{"tamanho": "m", "cor": "AZUL"}))
+}
+
+func TestCombinacoes(t *testing.T) {
+	produto := camiseta()
+
+	combinacoes := produto.Combinacoes()
+
+	assert.Len(t, combinacoes, 6)
+	assert.Equal(t, map[string]string{"Tamanho": "P", "Cor": "Azul"}, combinacoes[0])
+	assert.Equal(t, map[string]string{"Tamanho": "G", "Cor": "Preto"}, combinacoes[5])
+}
+
+func TestNovaVariante(t *testing.T) {
+	produto := camiseta()
+	opcoes := map[string]string{"Tamanho": "M", "Cor": "Azul"}
+
+	variante := produto.NovaVariante(opcoes, 4)
+
+	assert.Equal(t, "Camiseta M Azul", variante.Nome)
+	assert.Equal(t, 3, *variante.ProdutoID)
+	assert.Equal(t, produto.Preco, variante.Preco)
+	assert.Equal(t, []string{"verao"}, variante.Tags)
+	assert.Equal(t, 4, variante.Estoque)
+	assert.False(t, variante.PrecoProprio)
+	assert.Equal(t, "CA12345678-M-AZU", produto.CodigoVariante(opcoes))
+}
+
+func TestDefinirPreco_VarianteFicaComPrecoProprio(t *testing.T) {
+	produto := camiseta()
+	variante := produto.NovaVariante(map[string]string{"Tamanho": "G", "Cor": "Preto"}, 0)
+
+	variante.DefinirPreco(produto.Preco) // Mesmo preço: continua seguindo
+	assert.False(t, variante.PrecoProprio)
+
+	variante.DefinirPreco(dinheiro.Novo(5490, dinheiro.BRL))
+	assert.True(t, variante.PrecoProprio)
+
+	// Item comum não tem o que seguir
+	avulso := Item{Preco: dinheiro.Novo(100, dinheiro.BRL)}
+	avulso.DefinirPreco(dinheiro.Novo(200, dinheiro.BRL))
+	assert.False(t, avulso.PrecoProprio)
+}
@@ -0,0 +1,159 @@
+package lote
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"errors"
+	"strings"
+)
+
+// MaxItens limita quantos itens uma operação em lote pode tocar
+const MaxItens = 1000
+
+type Modo string
+
+const (
+	// ModoTudoOuNada roda tudo numa transação: qualquer falha desfaz o lote
+	ModoTudoOuNada Modo = "tudo_ou_nada"
+	// ModoMelhorEsforco aplica o que der e reporta as falhas item a item
+	ModoMelhorEsforco Modo = "melhor_esforco"
+)
+
+func (m Modo) Valido() bool {
+	return m == ModoTudoOuNada || m == ModoMelhorEsforco
+}
+
+// Selecao escolhe os itens do lote: IDs explícitos ou o mesmo filtro do
+// GET /itens (status e busca). Um dos dois é obrigatório.
+type Selecao struct {
+	IDs    []int
+	Status *entity.Status
+	Busca  string
+}
+
+func (s Selecao) PorFiltro() bool {
+	return len(s.IDs) == 0
+}
+
+func (s Selecao) Validar() error {
+	if len(s.IDs) > 0 && (s.Status != nil || strings.TrimSpace(s.Busca) != "") {
+		return errors.New("informe ids ou filtro, não os dois")
+	}
+	if len(s.IDs) == 0 && s.Status == nil && strings.TrimSpace(s.Busca) == "" {
+		return errors.New("informe ids ou um filtro (status ou busca)")
+	}
+	if len(s.IDs) > MaxItens {
+		return ErrMuitosItens
+	}
+	for _, id := range s.IDs {
+		if id <= 0 {
+			return errors.New("ids devem ser maiores que zero")
+		}
+	}
+	return nil
+}
+
+var ErrMuitosItens = errors.New("o lote pode ter no máximo 1000 itens")
+
+// Alteracao são as mudanças parciais aplicadas a cada item (campos nil
+// ficam como estão), as mesmas do PUT /itens/:id
+type Alteracao struct {
+	Preco     *dinheiro.Dinheiro // Moeda vazia: o valor está na moeda de cada item
+	Estoque   *int
+	Descricao *string
+	Categoria *string
+	Tags      *[]string // Substitui todas as tags
+}
+
+// Validar rejeita valores que o PUT ignoraria em silêncio: num lote é
+// melhor falhar antes de tocar qualquer item
+func (a Alteracao) Validar() error {
+	if a.Preco == nil && a.Estoque == nil && a.Descricao == nil && a.Categoria == nil && a.Tags == nil {
+		return errors.New("nenhuma alteração informada")
+	}
+	if a.Preco != nil && !a.Preco.Positivo() {
+		return errors.New("Preço deve ser maior que zero")
+	}
+	if a.Estoque != nil && *a.Estoque < 0 {
+		return errors.New("Estoque não pode ser negativo")
+	}
+	return nil
+}
+
+func (a Alteracao) AplicarEm(item *entity.Item) {
+	if a.Descricao != nil {
+		item.Descricao = strings.TrimSpace(*a.Descricao)
+	}
+	if a.Categoria != nil {
+		item.Categoria = strings.TrimSpace(*a.Categoria)
+	}
+	if a.Tags != nil {
+		item.Tags = entity.NormalizarTags(*a.Tags)
+	}
+	if a.Preco != nil {
+		preco := *a.Preco
+		if preco.Moeda == "" {
+			preco.Moeda = item.Preco.Moeda
+		}
+		item.DefinirPreco(preco)
+	}
+	if a.Estoque != nil {
+		item.Estoque = *a.Estoque
+	}
+	item.AtualizarStatus()
+}
+
+type Opcoes struct {
+	Modo      Modo
+	UsuarioID int
+	Admin     bool // Admin altera itens de qualquer usuário
+}
+
+type Acao string
+
+const (
+	AcaoAtualizado Acao = "atualizado"
+	AcaoRemovido   Acao = "removido"
+	AcaoErro       Acao = "erro"
+	// AcaoDesfeito: o item passou, mas outro falhou e o lote tudo_ou_nada
+	// foi desfeito
+	AcaoDesfeito Acao = "desfeito"
+)
+
+type ResultadoItem struct {
+	ItemID int
+	Acao   Acao
+	Erro   string
+}
+
+// Relatorio traz o resultado item a item. Aplicado diz se alguma mudança
+// ficou gravada.
+type Relatorio struct {
+	Modo     Modo
+	Aplicado bool
+	Total    int
+	Sucessos int
+	Erros    int
+	Itens    []ResultadoItem
+}
+
+func (r *Relatorio) Registrar(resultado ResultadoItem) {
+	r.Total++
+	if resultado.Acao == AcaoErro {
+		r.Erros++
+	} else {
+		r.Sucessos++
+	}
+	r.Itens = append(r.Itens, resultado)
+}
+
+// Desfazer marca como desfeitos os itens que tinham passado
+func (r *Relatorio) Desfazer() {
+	r.Aplicado = false
+	for i := range r.Itens {
+		if r.Itens[i].Acao != AcaoErro {
+			r.Itens[i].Acao = AcaoDesfeito
+		}
+	}
+	r.Sucessos = 0
+}
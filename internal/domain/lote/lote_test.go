@@ -0,0 +1,61 @@
+package lote
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSelecao_Validar(t *testing.T) {
+	ativo := entity.StatusAtivo
+
+	assert.NoError(t, Selecao{IDs: []int{1, 2}}.Validar())
+	assert.NoError(t, Selecao{Status: &ativo}.Validar())
+	assert.EqualError(t, Selecao{}.Validar(), "informe ids ou um filtro (status ou busca)")
+	assert.EqualError(t, Selecao{IDs: []int{1}, Busca: "mouse"}.Validar(), "informe ids ou filtro, não os dois")
+	assert.EqualError(t, Selecao{IDs: []int{0}}.Validar(), "ids devem ser maiores que zero")
+	assert.ErrorIs(t, Selecao{IDs: make([]int, MaxItens+1)}.Validar(), ErrMuitosItens)
+}
+
+func TestAlteracao_Validar(t *testing.T) {
+	zero := dinheiro.Novo(0, dinheiro.BRL)
+	negativo := -1
+
+	assert.EqualError(t, Alteracao{}.Validar(), "nenhuma alteração informada")
+	assert.EqualError(t, Alteracao{Preco: &zero}.Validar(), "Preço deve ser maior que zero")
+	assert.EqualError(t, Alteracao{Estoque: &negativo}.Validar(), "Estoque não pode ser negativo")
+}
+
+func TestAlteracao_AplicarEm(t *testing.T) {
+	//ARRANGE
+	estoque := 0
+	descricao := "  nova  "
+	item := entity.Item{Preco: dinheiro.Novo(1000, dinheiro.USD), Estoque: 5, Descricao: "antiga", Status: entity.StatusAtivo}
+
+	//ACT
+	Alteracao{Estoque: &estoque, Descricao: &descricao}.AplicarEm(&item)
+
+	//ASSERT
+	assert.Equal(t, dinheiro.Novo(1000, dinheiro.USD), item.Preco)
+	assert.Equal(t, 0, item.Estoque)
+	assert.Equal(t, "nova", item.Descricao)
+	assert.Equal(t, entity.StatusInativo, item.Status)
+}
+
+func TestRelatorio_Desfazer(t *testing.T) {
+	//ARRANGE
+	relatorio := Relatorio{Modo: ModoTudoOuNada, Aplicado: true}
+	relatorio.Registrar(ResultadoItem{ItemID: 1, Acao: AcaoAtualizado})
+	relatorio.Registrar(ResultadoItem{ItemID: 2, Acao: AcaoErro, Erro: "Item não encontrado"})
+
+	//ACT
+	relatorio.Desfazer()
+
+	//ASSERT
+	assert.False(t, relatorio.Aplicado)
+	assert.Equal(t, 0, relatorio.Sucessos)
+	assert.Equal(t, 1, relatorio.Erros)
+	assert.Equal(t, AcaoDesfeito, relatorio.Itens[0].Acao)
+	assert.Equal(t, AcaoErro, relatorio.Itens[1].Acao)
+}
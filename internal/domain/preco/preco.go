@@ -0,0 +1,88 @@
+package preco
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"time"
+)
+
+// Alteracao é uma linha do histórico de preços de um item. Anterior é nil
+// no registro de criação do item.
+type Alteracao struct {
+	ID          int
+	ItemID      int
+	Anterior    *dinheiro.Dinheiro
+	Preco       dinheiro.Dinheiro
+	AlteradoEm  time.Time
+	AlteradoPor *int
+}
+
+type StatusAgendamento string
+
+const (
+	StatusPendente  StatusAgendamento = "pendente"
+	StatusAplicado  StatusAgendamento = "aplicado"
+	StatusCancelado StatusAgendamento = "cancelado"
+	// StatusFalhou: o item sumiu ou o preço ficou inválido até a data
+	StatusFalhou StatusAgendamento = "falhou"
+)
+
+var (
+	ErrAgendamentoNaoEncontrado = errors.New("agendamento de preço não encontrado")
+	ErrAgendamentoNoPassado     = errors.New("vigente_a_partir_de deve ser uma data futura")
+)
+
+// Agendamento é um preço que passa a valer em VigenteAPartirDe. O
+// agendador aplica o preço em nome de CriadoPor.
+type Agendamento struct {
+	ID               int
+	ItemID           int
+	Preco            dinheiro.Dinheiro
+	VigenteAPartirDe time.Time
+	Status           StatusAgendamento
+	Erro             string
+	CriadoPor        int
+	CriadoEm         time.Time
+	AplicadoEm       *time.Time
+}
+
+// NovoAgendamento valida o preço e a data (depois de agora)
+func NovoAgendamento(itemID int, preco dinheiro.Dinheiro, vigenteAPartirDe, agora time.Time, criadoPor int) (Agendamento, error) {
+	if itemID <= 0 {
+		return Agendamento{}, errors.New("O id deve ser maior que zero")
+	}
+	if !preco.Positivo() {
+		return Agendamento{}, errors.New("Preço deve ser maior que zero")
+	}
+	if !preco.Moeda.Valida() {
+		return Agendamento{}, dinheiro.ErrMoedaNaoSuportada
+	}
+	if !vigenteAPartirDe.After(agora) {
+		return Agendamento{}, ErrAgendamentoNoPassado
+	}
+
+	return Agendamento{
+		ItemID:           itemID,
+		Preco:            preco,
+		VigenteAPartirDe: vigenteAPartirDe.UTC(),
+		Status:           StatusPendente,
+		CriadoPor:        criadoPor,
+		CriadoEm:         agora.UTC(),
+	}, nil
+}
+
+// Aplicar marca o agendamento como aplicado em agora
+func (a *Agendamento) Aplicar(agora time.Time) {
+	agora = agora.UTC()
+	a.Status = StatusAplicado
+	a.AplicadoEm = &agora
+	a.Erro = ""
+}
+
+// Falhar encerra o agendamento sem aplicar (não é tentado de novo)
+func (a *Agendamento) Falhar(agora time.Time, err error) {
+	agora = agora.UTC()
+	a.Status = StatusFalhou
+	a.AplicadoEm = &agora
+	a.Erro = err.Error()
+}
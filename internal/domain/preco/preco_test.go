@@ -0,0 +1,55 @@
+package preco
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNovoAgendamento(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("valido", func(t *testing.T) {
+		a, err := NovoAgendamento(7, dinheiro.Novo(1990, dinheiro.BRL), agora.Add(time.Hour), agora, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, a.ItemID)
+		assert.Equal(t, StatusPendente, a.Status)
+		assert.Equal(t, 3, a.CriadoPor)
+		assert.Equal(t, agora, a.CriadoEm)
+		assert.Nil(t, a.AplicadoEm)
+	})
+
+	t.Run("data no passado", func(t *testing.T) {
+		_, err := NovoAgendamento(7, dinheiro.Novo(1990, dinheiro.BRL), agora, agora, 3)
+		assert.ErrorIs(t, err, ErrAgendamentoNoPassado)
+	})
+
+	t.Run("preco zero", func(t *testing.T) {
+		_, err := NovoAgendamento(7, dinheiro.Novo(0, dinheiro.BRL), agora.Add(time.Hour), agora, 3)
+		assert.EqualError(t, err, "Preço deve ser maior que zero")
+	})
+
+	t.Run("moeda invalida", func(t *testing.T) {
+		_, err := NovoAgendamento(7, dinheiro.Novo(100, "JPY"), agora.Add(time.Hour), agora, 3)
+		assert.ErrorIs(t, err, dinheiro.ErrMoedaNaoSuportada)
+	})
+}
+
+func TestAgendamento_AplicarFalhar(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	a := Agendamento{Status: StatusPendente}
+	a.Aplicar(agora)
+	assert.Equal(t, StatusAplicado, a.Status)
+	require.NotNil(t, a.AplicadoEm)
+	assert.Equal(t, agora, *a.AplicadoEm)
+
+	b := Agendamento{Status: StatusPendente}
+	b.Falhar(agora, errors.New("item não encontrado"))
+	assert.Equal(t, StatusFalhou, b.Status)
+	assert.Equal(t, "item não encontrado", b.Erro)
+}
@@ -0,0 +1,225 @@
+package promocao
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Tipo string
+
+const (
+	TipoPercentual Tipo = "percentual"
+	// TipoValorFixo só vale para itens na mesma moeda do desconto
+	TipoValorFixo Tipo = "valor_fixo"
+)
+
+// Alvo diz a que itens a promoção se aplica
+type Alvo string
+
+const (
+	AlvoItem      Alvo = "item"
+	AlvoCategoria Alvo = "categoria"
+	AlvoTag       Alvo = "tag"
+)
+
+var ErrPromocaoNaoEncontrada = errors.New("promoção não encontrada")
+
+// Promocao é um desconto com início e fim. Entre as que valem para um item,
+// a de maior Prioridade sempre entra; se ela for Cumulativa, as demais
+// cumulativas entram em seguida (ver Calcular).
+type Promocao struct {
+	ID           int
+	Nome         string
+	Tipo         Tipo
+	Percentual   int64             // Centésimos de ponto: 1550 = 15,5%
+	Desconto     dinheiro.Dinheiro // Só TipoValorFixo
+	Alvo         Alvo
+	ItemID       int    // Só AlvoItem
+	Categoria    string // Só AlvoCategoria
+	Tag          string // Só AlvoTag
+	Inicio       time.Time
+	Fim          time.Time
+	Prioridade   int
+	Cumulativa   bool
+	Ativa        bool
+	CriadoPor    int
+	CriadoEm     time.Time
+	AtualizadoEm time.Time
+}
+
+// percentualMaximo: 100% em centésimos de ponto
+const percentualMaximo = 10000
+
+// ParsePercentual lê "15" ou "15.5" (até duas casas) em centésimos de ponto
+func ParsePercentual(texto string) (int64, error) {
+	texto = strings.TrimSpace(texto)
+	valor, ok := new(big.Rat).SetString(texto)
+	if texto == "" || strings.Contains(texto, "/") || !ok {
+		return 0, fmt.Errorf("percentual inválido: %q", texto)
+	}
+
+	valor.Mul(valor, big.NewRat(100, 1))
+	if !valor.IsInt() || !valor.Num().IsInt64() {
+		return 0, fmt.Errorf("percentual aceita no máximo duas casas: %q", texto)
+	}
+	return valor.Num().Int64(), nil
+}
+
+// FormatarPercentual devolve o percentual como decimal ("15.5")
+func FormatarPercentual(centesimos int64) string {
+	texto := big.NewRat(centesimos, 100).FloatString(2)
+	texto = strings.TrimRight(texto, "0")
+	return strings.TrimSuffix(texto, ".")
+}
+
+// Normalizar tira espaços do nome e deixa categoria e tag como as do item
+func (p *Promocao) Normalizar() {
+	p.Nome = strings.TrimSpace(p.Nome)
+	p.Categoria = strings.TrimSpace(p.Categoria)
+	p.Tag = strings.ToLower(strings.TrimSpace(p.Tag))
+	p.Inicio = p.Inicio.UTC()
+	p.Fim = p.Fim.UTC()
+}
+
+func (p Promocao) Validar() error {
+	if p.Nome == "" {
+		return errors.New("Nome é obrigatório")
+	}
+	if len(p.Nome) > 100 {
+		return errors.New("Nome deve ter no máximo 100 caracteres")
+	}
+
+	switch p.Tipo {
+	case TipoPercentual:
+		if p.Percentual <= 0 || p.Percentual > percentualMaximo {
+			return errors.New("percentual deve ser maior que 0 e no máximo 100")
+		}
+	case TipoValorFixo:
+		if !p.Desconto.Positivo() {
+			return errors.New("desconto deve ser maior que zero")
+		}
+		if !p.Desconto.Moeda.Valida() {
+			return dinheiro.ErrMoedaNaoSuportada
+		}
+	default:
+		return errors.New("tipo deve ser 'percentual' ou 'valor_fixo'")
+	}
+
+	switch p.Alvo {
+	case AlvoItem:
+		if p.ItemID <= 0 {
+			return errors.New("item_id é obrigatório para alvo 'item'")
+		}
+	case AlvoCategoria:
+		if p.Categoria == "" {
+			return errors.New("categoria é obrigatória para alvo 'categoria'")
+		}
+	case AlvoTag:
+		if p.Tag == "" {
+			return errors.New("tag é obrigatória para alvo 'tag'")
+		}
+	default:
+		return errors.New("alvo deve ser 'item', 'categoria' ou 'tag'")
+	}
+
+	if p.Inicio.IsZero() || p.Fim.IsZero() {
+		return errors.New("inicio e fim são obrigatórios")
+	}
+	if !p.Fim.After(p.Inicio) {
+		return errors.New("fim deve ser depois do início")
+	}
+	return nil
+}
+
+// Vigente: ativa e com agora em [Inicio, Fim)
+func (p Promocao) Vigente(agora time.Time) bool {
+	return p.Ativa && !agora.Before(p.Inicio) && agora.Before(p.Fim)
+}
+
+// AplicaA diz se o item está no alvo da promoção
+func (p Promocao) AplicaA(item entity.Item) bool {
+	if p.Tipo == TipoValorFixo && p.Desconto.Moeda != item.Preco.Moeda {
+		return false
+	}
+
+	switch p.Alvo {
+	case AlvoItem:
+		return p.ItemID == item.ID
+	case AlvoCategoria:
+		return item.Categoria != "" && strings.EqualFold(p.Categoria, item.Categoria)
+	case AlvoTag:
+		return item.TemTag(p.Tag)
+	}
+	return false
+}
+
+// desconto calcula quanto a promoção tira do preço (nunca mais que o preço)
+func (p Promocao) desconto(preco dinheiro.Dinheiro) dinheiro.Dinheiro {
+	desconto := p.Desconto
+	if p.Tipo == TipoPercentual {
+		// Multiplicar só falha por overflow, impossível com fator ≤ 1
+		desconto, _ = preco.Multiplicar(big.NewRat(p.Percentual, percentualMaximo))
+	}
+	if desconto.Centavos > preco.Centavos {
+		desconto.Centavos = preco.Centavos
+	}
+	desconto.Moeda = preco.Moeda
+	return desconto
+}
+
+type Aplicada struct {
+	PromocaoID int
+	Nome       string
+	Desconto   dinheiro.Dinheiro
+}
+
+type Resultado struct {
+	PrecoEfetivo dinheiro.Dinheiro
+	Aplicadas    []Aplicada
+}
+
+// Calcular aplica as promoções vigentes ao preço do item:
+//
+//  1. Ficam só as vigentes em agora que têm o item no alvo
+//  2. Ordena por prioridade (maior primeiro) e, no empate, pelo ID
+//  3. A primeira sempre entra. Se ela não for cumulativa, para aí; se for,
+//     as outras cumulativas entram na ordem, cada uma sobre o preço já
+//     descontado. Não cumulativas abaixo da primeira são ignoradas
+//
+// O preço efetivo nunca fica negativo.
+func Calcular(item entity.Item, promocoes []Promocao, agora time.Time) Resultado {
+	resultado := Resultado{PrecoEfetivo: item.Preco}
+
+	candidatas := make([]Promocao, 0, len(promocoes))
+	for _, p := range promocoes {
+		if p.Vigente(agora) && p.AplicaA(item) {
+			candidatas = append(candidatas, p)
+		}
+	}
+	sort.SliceStable(candidatas, func(i, j int) bool {
+		if candidatas[i].Prioridade != candidatas[j].Prioridade {
+			return candidatas[i].Prioridade > candidatas[j].Prioridade
+		}
+		return candidatas[i].ID < candidatas[j].ID
+	})
+
+	for i, p := range candidatas {
+		if i > 0 && (!candidatas[0].Cumulativa || !p.Cumulativa) {
+			continue
+		}
+		if resultado.PrecoEfetivo.Centavos == 0 {
+			break
+		}
+
+		desconto := p.desconto(resultado.PrecoEfetivo)
+		resultado.PrecoEfetivo.Centavos -= desconto.Centavos
+		resultado.Aplicadas = append(resultado.Aplicadas, Aplicada{PromocaoID: p.ID, Nome: p.Nome, Desconto: desconto})
+	}
+	return resultado
+}
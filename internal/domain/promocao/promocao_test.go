@@ -0,0 +1,147 @@
+package promocao
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	agora  = time.Date(2025, 11, 28, 12, 0, 0, 0, time.UTC)
+	inicio = agora.Add(-24 * time.Hour)
+	fim    = agora.Add(24 * time.Hour)
+)
+
+func mouse() entity.Item {
+	return entity.Item{
+		ID:        1,
+		Nome:      "Mouse",
+		Categoria: "Periféricos",
+		Tags:      []string{"gamer", "usb"},
+		Preco:     dinheiro.Novo(10000, dinheiro.BRL),
+	}
+}
+
+func percentual(id int, centesimos int64, alvo Alvo, prioridade int, cumulativa bool) Promocao {
+	p := Promocao{ID: id, Nome: "Promo", Tipo: TipoPercentual, Percentual: centesimos, Alvo: alvo,
+		Inicio: inicio, Fim: fim, Prioridade: prioridade, Cumulativa: cumulativa, Ativa: true}
+	switch alvo {
+	case AlvoItem:
+		p.ItemID = 1
+	case AlvoCategoria:
+		p.Categoria = "periféricos"
+	case AlvoTag:
+		p.Tag = "gamer"
+	}
+	return p
+}
+
+func TestParsePercentual(t *testing.T) {
+	valor, err := ParsePercentual("15.5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1550), valor)
+	assert.Equal(t, "15.5", FormatarPercentual(valor))
+	assert.Equal(t, "10", FormatarPercentual(1000))
+
+	_, err = ParsePercentual("10.125")
+	assert.Error(t, err)
+	_, err = ParsePercentual("abc")
+	assert.Error(t, err)
+}
+
+func TestValidar(t *testing.T) {
+	valida := percentual(0, 1000, AlvoCategoria, 0, false)
+	assert.NoError(t, valida.Validar())
+
+	semAlvo := valida
+	semAlvo.Categoria = ""
+	assert.EqualError(t, semAlvo.Validar(), "categoria é obrigatória para alvo 'categoria'")
+
+	acimaDe100 := valida
+	acimaDe100.Percentual = 10001
+	assert.Error(t, acimaDe100.Validar())
+
+	fimAntes := valida
+	fimAntes.Fim = fimAntes.Inicio
+	assert.EqualError(t, fimAntes.Validar(), "fim deve ser depois do início")
+
+	fixoSemMoeda := valida
+	fixoSemMoeda.Tipo = TipoValorFixo
+	fixoSemMoeda.Desconto = dinheiro.Novo(500, "")
+	assert.ErrorIs(t, fixoSemMoeda.Validar(), dinheiro.ErrMoedaNaoSuportada)
+}
+
+func TestCalcular_SemPromocao(t *testing.T) {
+	resultado := Calcular(mouse(), nil, agora)
+
+	assert.Equal(t, dinheiro.Novo(10000, dinheiro.BRL), resultado.PrecoEfetivo)
+	assert.Empty(t, resultado.Aplicadas)
+}
+
+func TestCalcular_AlvosECasamento(t *testing.T) {
+	outroItem := percentual(1, 1000, AlvoItem, 0, false)
+	outroItem.ItemID = 2
+	outraTag := percentual(2, 1000, AlvoTag, 0, false)
+	outraTag.Tag = "bluetooth"
+	encerrada := percentual(3, 1000, AlvoItem, 0, false)
+	encerrada.Fim = agora // Fim é exclusivo
+	inativa := percentual(4, 1000, AlvoItem, 0, false)
+	inativa.Ativa = false
+	emDolar := Promocao{ID: 5, Nome: "USD", Tipo: TipoValorFixo, Desconto: dinheiro.Novo(100, dinheiro.USD),
+		Alvo: AlvoItem, ItemID: 1, Inicio: inicio, Fim: fim, Ativa: true}
+
+	resultado := Calcular(mouse(), []Promocao{outroItem, outraTag, encerrada, inativa, emDolar}, agora)
+
+	assert.Empty(t, resultado.Aplicadas)
+	assert.Equal(t, int64(10000), resultado.PrecoEfetivo.Centavos)
+}
+
+func TestCalcular_MaiorPrioridadeNaoCumulativaVence(t *testing.T) {
+	categoria := percentual(1, 1000, AlvoCategoria, 1, true)
+	tag := percentual(2, 2000, AlvoTag, 5, false)
+
+	resultado := Calcular(mouse(), []Promocao{categoria, tag}, agora)
+
+	require.Len(t, resultado.Aplicadas, 1)
+	assert.Equal(t, 2, resultado.Aplicadas[0].PromocaoID)
+	assert.Equal(t, int64(8000), resultado.PrecoEfetivo.Centavos)
+}
+
+func TestCalcular_CumulativasEmSequencia(t *testing.T) {
+	item := percentual(1, 1000, AlvoItem, 10, true) // 100,00 → 90,00
+	fixo := Promocao{ID: 2, Nome: "Menos 5", Tipo: TipoValorFixo, Desconto: dinheiro.Novo(500, dinheiro.BRL),
+		Alvo: AlvoTag, Tag: "usb", Inicio: inicio, Fim: fim, Prioridade: 5, Cumulativa: true, Ativa: true} // 90,00 → 85,00
+	naoCumulativa := percentual(3, 5000, AlvoCategoria, 1, false) // Ignorada: abaixo de uma cumulativa
+
+	resultado := Calcular(mouse(), []Promocao{naoCumulativa, fixo, item}, agora)
+
+	require.Len(t, resultado.Aplicadas, 2)
+	assert.Equal(t, dinheiro.Novo(1000, dinheiro.BRL), resultado.Aplicadas[0].Desconto)
+	assert.Equal(t, dinheiro.Novo(500, dinheiro.BRL), resultado.Aplicadas[1].Desconto)
+	assert.Equal(t, dinheiro.Novo(8500, dinheiro.BRL), resultado.PrecoEfetivo)
+}
+
+func TestCalcular_NuncaNegativo(t *testing.T) {
+	fixo := Promocao{ID: 1, Nome: "Brinde", Tipo: TipoValorFixo, Desconto: dinheiro.Novo(50000, dinheiro.BRL),
+		Alvo: AlvoItem, ItemID: 1, Inicio: inicio, Fim: fim, Ativa: true, Cumulativa: true}
+	extra := percentual(2, 1000, AlvoItem, 0, true)
+
+	resultado := Calcular(mouse(), []Promocao{fixo, extra}, agora)
+
+	assert.Equal(t, int64(0), resultado.PrecoEfetivo.Centavos)
+	require.Len(t, resultado.Aplicadas, 1)
+	assert.Equal(t, int64(10000), resultado.Aplicadas[0].Desconto.Centavos)
+}
+
+func TestCalcular_ArredondaPercentual(t *testing.T) {
+	item := mouse()
+	item.Preco = dinheiro.Novo(999, dinheiro.BRL) // 9,99 × 15% = 1,4985 → 1,50
+
+	resultado := Calcular(item, []Promocao{percentual(1, 1500, AlvoItem, 0, false)}, agora)
+
+	assert.Equal(t, int64(150), resultado.Aplicadas[0].Desconto.Centavos)
+	assert.Equal(t, int64(849), resultado.PrecoEfetivo.Centavos)
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// Politica define um token bucket: cabem Limite requisições de uma vez
+// (rajada) e o balde se reabastece aos poucos, enchendo por completo a
+// cada Janela. Ex.: Limite 60, Janela 1m → 1 requisição por segundo.
+type Politica struct {
+	Nome   string // Compõe a chave do balde: grupos diferentes não dividem cota
+	Limite int
+	Janela time.Duration
+}
+
+// Balde é o estado guardado por chave (usuário, API key ou IP)
+type Balde struct {
+	Tokens       float64
+	AtualizadoEm time.Time
+}
+
+// Resultado traz o que vai nos headers RateLimit-* / Retry-After
+type Resultado struct {
+	Permitido  bool
+	Limite     int
+	Restante   int
+	Reset      time.Duration // Até o balde encher de novo
+	RetryAfter time.Duration // Até caber a próxima requisição (só se negado)
+}
+
+// taxa devolve quantos tokens entram por segundo
+func (p Politica) taxa() float64 {
+	return float64(p.Limite) / p.Janela.Seconds()
+}
+
+// Cheio é o balde de quem ainda não tem histórico
+func (p Politica) Cheio(agora time.Time) Balde {
+	return Balde{Tokens: float64(p.Limite), AtualizadoEm: agora}
+}
+
+// Consumir reabastece o balde pelo tempo decorrido e tenta tirar um token.
+// Não tem efeito colateral: quem chama grava o Balde devolvido (os stores
+// garantem a atomicidade entre ler e gravar).
+func (p Politica) Consumir(b Balde, agora time.Time) (Balde, Resultado) {
+	if decorrido := agora.Sub(b.AtualizadoEm); decorrido > 0 {
+		b.Tokens = math.Min(float64(p.Limite), b.Tokens+decorrido.Seconds()*p.taxa())
+	}
+	b.AtualizadoEm = agora
+
+	r := Resultado{Limite: p.Limite}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		r.Permitido = true
+	} else {
+		r.RetryAfter = p.tempoPara(1 - b.Tokens)
+	}
+
+	r.Restante = int(math.Floor(b.Tokens))
+	r.Reset = p.tempoPara(float64(p.Limite) - b.Tokens)
+	return b, r
+}
+
+func (p Politica) tempoPara(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(tokens / p.taxa() * float64(time.Second)))
+}
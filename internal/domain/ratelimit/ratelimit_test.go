@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var politicaTeste = Politica{Nome: "teste", Limite: 3, Janela: 3 * time.Second}
+
+func TestPolitica_Consumir_PermiteRajadaAteOLimite(t *testing.T) {
+	//ARRANGE
+	agora := time.Now()
+	balde := politicaTeste.Cheio(agora)
+
+	//ACT
+	var r Resultado
+	for i := 0; i < 3; i++ {
+		balde, r = politicaTeste.Consumir(balde, agora)
+		assert.True(t, r.Permitido)
+	}
+
+	//ASSERT
+	assert.Equal(t, 0, r.Restante)
+	assert.Equal(t, 3*time.Second, r.Reset)
+}
+
+func TestPolitica_Consumir_NegaQuandoVazio(t *testing.T) {
+	//ARRANGE
+	agora := time.Now()
+	balde := Balde{Tokens: 0, AtualizadoEm: agora}
+
+	//ACT
+	_, r := politicaTeste.Consumir(balde, agora)
+
+	//ASSERT
+	assert.False(t, r.Permitido)
+	assert.Equal(t, 0, r.Restante)
+	assert.Equal(t, time.Second, r.RetryAfter)
+}
+
+func TestPolitica_Consumir_ReabastecePeloTempo(t *testing.T) {
+	//ARRANGE
+	agora := time.Now()
+	balde := Balde{Tokens: 0, AtualizadoEm: agora}
+
+	//ACT
+	balde, r := politicaTeste.Consumir(balde, agora.Add(1500*time.Millisecond))
+
+	//ASSERT
+	assert.True(t, r.Permitido)
+	assert.InDelta(t, 0.5, balde.Tokens, 0.0001)
+	assert.Equal(t, 0, r.Restante)
+}
+
+func TestPolitica_Consumir_NaoPassaDoLimite(t *testing.T) {
+	//ARRANGE
+	agora := time.Now()
+	balde := Balde{Tokens: 1, AtualizadoEm: agora.Add(-time.Hour)}
+
+	//ACT
+	balde, r := politicaTeste.Consumir(balde, agora)
+
+	//ASSERT
+	assert.True(t, r.Permitido)
+	assert.Equal(t, 2.0, balde.Tokens)
+	assert.Equal(t, 2, r.Restante)
+}
+
+func TestPolitica_Consumir_RelogioVoltando(t *testing.T) {
+	//ARRANGE
+	agora := time.Now()
+	balde := Balde{Tokens: 1, AtualizadoEm: agora}
+
+	//ACT
+	balde, r := politicaTeste.Consumir(balde, agora.Add(-time.Minute))
+
+	//ASSERT
+	assert.True(t, r.Permitido)
+	assert.Equal(t, 0.0, balde.Tokens)
+}
@@ -0,0 +1,123 @@
+package reserva
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusAtiva      Status = "ativa"
+	StatusConfirmada Status = "confirmada" // O estoque saiu do item
+	StatusCancelada  Status = "cancelada"
+	StatusExpirada   Status = "expirada"
+)
+
+const (
+	TTLPadrao = 15 * time.Minute
+	TTLMinimo = time.Minute
+	TTLMaximo = 24 * time.Hour
+)
+
+var (
+	ErrReservaNaoEncontrada = errors.New("reserva não encontrada")
+	ErrEstoqueIndisponivel  = errors.New("estoque disponível insuficiente")
+	ErrReservaEncerrada     = errors.New("reserva já foi confirmada, cancelada ou expirou")
+)
+
+// Reserva segura Quantidade do estoque do item até ExpiraEm. Enquanto está
+// ativa e no prazo, a quantidade não aparece como disponível.
+type Reserva struct {
+	ID          int
+	ItemID      int
+	Quantidade  int
+	Status      Status
+	UsuarioID   int
+	ExpiraEm    time.Time
+	CriadoEm    time.Time
+	ConcluidaEm *time.Time
+}
+
+// NovaReserva valida a quantidade e o TTL (zero usa o TTLPadrao)
+func NovaReserva(itemID, quantidade int, ttl time.Duration, usuarioID int, agora time.Time) (Reserva, error) {
+	if itemID <= 0 {
+		return Reserva{}, errors.New("O id deve ser maior que zero")
+	}
+	if quantidade <= 0 {
+		return Reserva{}, errors.New("quantidade deve ser maior que zero")
+	}
+	if ttl == 0 {
+		ttl = TTLPadrao
+	}
+	if ttl < TTLMinimo || ttl > TTLMaximo {
+		return Reserva{}, errors.New("ttl deve ficar entre 1 minuto e 24 horas")
+	}
+
+	agora = agora.UTC()
+	return Reserva{
+		ItemID:     itemID,
+		Quantidade: quantidade,
+		Status:     StatusAtiva,
+		UsuarioID:  usuarioID,
+		ExpiraEm:   agora.Add(ttl),
+		CriadoEm:   agora,
+	}, nil
+}
+
+// Vigente: ativa e dentro do prazo, ou seja, segurando estoque
+func (r Reserva) Vigente(agora time.Time) bool {
+	return r.Status == StatusAtiva && agora.Before(r.ExpiraEm)
+}
+
+// PodeSerAlteradaPor: só quem reservou ou admin confirma/cancela
+func (r Reserva) PodeSerAlteradaPor(userID int, admin bool) bool {
+	return admin || r.UsuarioID == userID
+}
+
+// Confirmar encerra a reserva consumindo o estoque. Fora do prazo a
+// reserva não segura mais nada e não pode ser confirmada.
+func (r *Reserva) Confirmar(agora time.Time) error {
+	if !r.Vigente(agora) {
+		return ErrReservaEncerrada
+	}
+	r.concluir(StatusConfirmada, agora)
+	return nil
+}
+
+// Dividir separa quantidade da reserva numa reserva nova, sem ID, com o
+// mesmo dono e prazo; a original fica com o resto. Serve para consumir só
+// parte de uma reserva.
+func (r *Reserva) Dividir(quantidade int) (Reserva, error) {
+	if quantidade <= 0 || quantidade >= r.Quantidade {
+		return Reserva{}, fmt.Errorf("quantidade deve ficar entre 1 e %d", r.Quantidade-1)
+	}
+
+	parte := *r
+	parte.ID = 0
+	parte.Quantidade = quantidade
+	r.Quantidade -= quantidade
+	return parte, nil
+}
+
+// Cancelar devolve a quantidade ao disponível
+func (r *Reserva) Cancelar(agora time.Time) error {
+	if r.Status != StatusAtiva {
+		return ErrReservaEncerrada
+	}
+	r.concluir(StatusCancelada, agora)
+	return nil
+}
+
+func (r *Reserva) concluir(status Status, agora time.Time) {
+	agora = agora.UTC()
+	r.Status = status
+	r.ConcluidaEm = &agora
+}
+
+// Disponivel é o estoque que ainda pode ser reservado ou vendido. Nunca é
+// negativo: o estoque pode ser ajustado para baixo com reservas em aberto.
+func Disponivel(estoque, reservado int) int {
+	return max(estoque-reservado, 0)
+}
@@ -0,0 +1,101 @@
+package reserva
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNovaReserva(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("ttl padrao", func(t *testing.T) {
+		r, err := NovaReserva(7, 2, 0, 3, agora)
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusAtiva, r.Status)
+		assert.Equal(t, agora.Add(TTLPadrao), r.ExpiraEm)
+		assert.Equal(t, 3, r.UsuarioID)
+	})
+
+	t.Run("quantidade zero", func(t *testing.T) {
+		_, err := NovaReserva(7, 0, time.Hour, 3, agora)
+		assert.EqualError(t, err, "quantidade deve ser maior que zero")
+	})
+
+	t.Run("ttl fora dos limites", func(t *testing.T) {
+		_, err := NovaReserva(7, 1, time.Second, 3, agora)
+		assert.Error(t, err)
+
+		_, err = NovaReserva(7, 1, 25*time.Hour, 3, agora)
+		assert.Error(t, err)
+	})
+}
+
+func TestConfirmar(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("no prazo", func(t *testing.T) {
+		r, _ := NovaReserva(7, 2, time.Minute, 3, agora)
+
+		require.NoError(t, r.Confirmar(agora.Add(30*time.Second)))
+		assert.Equal(t, StatusConfirmada, r.Status)
+		assert.NotNil(t, r.ConcluidaEm)
+	})
+
+	t.Run("vencida", func(t *testing.T) {
+		r, _ := NovaReserva(7, 2, time.Minute, 3, agora)
+
+		assert.ErrorIs(t, r.Confirmar(agora.Add(time.Minute)), ErrReservaEncerrada)
+		assert.Equal(t, StatusAtiva, r.Status)
+	})
+
+	t.Run("cancelada", func(t *testing.T) {
+		r, _ := NovaReserva(7, 2, time.Minute, 3, agora)
+		require.NoError(t, r.Cancelar(agora))
+
+		assert.ErrorIs(t, r.Confirmar(agora), ErrReservaEncerrada)
+	})
+}
+
+func TestCancelar_SoReservaAtiva(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	r, _ := NovaReserva(7, 2, time.Minute, 3, agora)
+	require.NoError(t, r.Confirmar(agora))
+
+	assert.ErrorIs(t, r.Cancelar(agora), ErrReservaEncerrada)
+	assert.Equal(t, StatusConfirmada, r.Status)
+}
+
+func TestDisponivel(t *testing.T) {
+	assert.Equal(t, 3, Disponivel(10, 7))
+	assert.Equal(t, 0, Disponivel(5, 7)) // Estoque ajustado abaixo do reservado
+}
+
+func TestDividir(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("parte da reserva", func(t *testing.T) {
+		r, _ := NovaReserva(7, 5, time.Minute, 3, agora)
+		r.ID = 9
+
+		parte, err := r.Dividir(2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, r.Quantidade)
+		assert.Equal(t, 2, parte.Quantidade)
+		assert.Zero(t, parte.ID)
+		assert.Equal(t, r.ExpiraEm, parte.ExpiraEm)
+		assert.Equal(t, 3, parte.UsuarioID)
+	})
+
+	t.Run("reserva inteira", func(t *testing.T) {
+		r, _ := NovaReserva(7, 5, time.Minute, 3, agora)
+
+		_, err := r.Dividir(5)
+
+		assert.Error(t, err)
+		assert.Equal(t, 5, r.Quantidade)
+	})
+}
@@ -12,6 +12,12 @@ const (
 	RoleUser  Role = "user"
 )
 
+// Erros de conflito: o valor já pertence a outro usuário.
+var (
+	ErrUsernameEmUso = errors.New("username já está em uso")
+	ErrEmailEmUso    = errors.New("email já está em uso")
+)
+
 type User struct {
 	ID        int
 	Username  string
@@ -0,0 +1,159 @@
+package venda
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusPendente   Status = "pendente"
+	StatusConfirmado Status = "confirmado"
+	StatusCancelado  Status = "cancelado"
+)
+
+// MaxLinhas limita o tamanho de um pedido
+const MaxLinhas = 100
+
+var (
+	ErrPedidoNaoEncontrado = errors.New("pedido não encontrado")
+	ErrPedidoInvalido      = errors.New("pedido inválido")
+	ErrTransicaoInvalida   = errors.New("operação não permitida no status atual do pedido")
+	ErrItemIndisponivel    = errors.New("item indisponível para venda")
+	ErrEstoqueInsuficiente = errors.New("estoque insuficiente")
+)
+
+// Pedido é uma venda. Fluxo: pendente → confirmado (baixa o estoque) →
+// cancelado (devolve). Pendente também pode ser cancelado, sem mexer no
+// estoque.
+type Pedido struct {
+	ID           int
+	UsuarioID    int
+	Status       Status
+	Linhas       []Linha
+	CriadoEm     time.Time
+	AtualizadoEm time.Time
+	ConfirmadoEm *time.Time
+	CanceladoEm  *time.Time
+}
+
+// Linha guarda nome e preço do item no momento do pedido; mudanças
+// posteriores no item não alteram o pedido
+type Linha struct {
+	ID            int
+	ItemID        int
+	Code          string
+	Nome          string
+	Quantidade    int
+	PrecoUnitario dinheiro.Dinheiro
+}
+
+// NovoPedido monta um pedido pendente já validado
+func NovoPedido(usuarioID int, linhas []Linha, agora time.Time) (Pedido, error) {
+	if err := validarLinhas(linhas); err != nil {
+		return Pedido{}, err
+	}
+
+	agora = agora.UTC()
+	return Pedido{
+		UsuarioID:    usuarioID,
+		Status:       StatusPendente,
+		Linhas:       linhas,
+		CriadoEm:     agora,
+		AtualizadoEm: agora,
+	}, nil
+}
+
+func validarLinhas(linhas []Linha) error {
+	if len(linhas) == 0 {
+		return fmt.Errorf("%w: o pedido precisa de pelo menos uma linha", ErrPedidoInvalido)
+	}
+	if len(linhas) > MaxLinhas {
+		return fmt.Errorf("%w: no máximo %d linhas por pedido", ErrPedidoInvalido, MaxLinhas)
+	}
+
+	vistos := make(map[int]bool, len(linhas))
+	for i, l := range linhas {
+		if l.ItemID <= 0 {
+			return fmt.Errorf("%w: linha %d: item é obrigatório", ErrPedidoInvalido, i+1)
+		}
+		if vistos[l.ItemID] {
+			return fmt.Errorf("%w: linha %d: item %d repetido no pedido", ErrPedidoInvalido, i+1, l.ItemID)
+		}
+		vistos[l.ItemID] = true
+		if l.Quantidade <= 0 {
+			return fmt.Errorf("%w: linha %d: quantidade deve ser maior que zero", ErrPedidoInvalido, i+1)
+		}
+		if !l.PrecoUnitario.Moeda.Valida() {
+			return fmt.Errorf("%w: linha %d: %v", ErrPedidoInvalido, i+1, dinheiro.ErrMoedaNaoSuportada)
+		}
+	}
+	return nil
+}
+
+// VerificarItem confere se o item pode ser vendido na quantidade pedida.
+// disponivel é o estoque já descontadas as reservas.
+func VerificarItem(item entity.Item, quantidade, disponivel int) error {
+	if item.Status != entity.StatusAtivo {
+		return fmt.Errorf("%w: item %d não está ativo", ErrItemIndisponivel, item.ID)
+	}
+	if disponivel < quantidade {
+		return fmt.Errorf("%w: item %d tem %d disponível, pedido %d", ErrEstoqueInsuficiente, item.ID, disponivel, quantidade)
+	}
+	return nil
+}
+
+// PodeSerVistoPor: o dono do pedido ou admin
+func (p *Pedido) PodeSerVistoPor(usuarioID int, admin bool) bool {
+	return admin || p.UsuarioID == usuarioID
+}
+
+func (p *Pedido) Confirmar(agora time.Time) error {
+	if p.Status != StatusPendente {
+		return fmt.Errorf("%w: só pedido pendente pode ser confirmado (status %s)", ErrTransicaoInvalida, p.Status)
+	}
+	agora = agora.UTC()
+	p.Status = StatusConfirmado
+	p.ConfirmadoEm = &agora
+	p.AtualizadoEm = agora
+	return nil
+}
+
+// Cancelar devolve true quando o pedido estava confirmado, ou seja, quando
+// o estoque precisa voltar
+func (p *Pedido) Cancelar(agora time.Time) (bool, error) {
+	if p.Status == StatusCancelado {
+		return false, fmt.Errorf("%w: pedido já cancelado", ErrTransicaoInvalida)
+	}
+	devolver := p.Status == StatusConfirmado
+	agora = agora.UTC()
+	p.Status = StatusCancelado
+	p.CanceladoEm = &agora
+	p.AtualizadoEm = agora
+	return devolver, nil
+}
+
+// ItemIDs em ordem crescente: é a ordem de travar as linhas dos itens
+func (p *Pedido) ItemIDs() []int {
+	ids := make([]int, 0, len(p.Linhas))
+	for _, l := range p.Linhas {
+		ids = append(ids, l.ItemID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Total soma quantidade × preço por moeda
+func (p *Pedido) Total() map[dinheiro.Moeda]dinheiro.Dinheiro {
+	total := make(map[dinheiro.Moeda]dinheiro.Dinheiro)
+	for _, l := range p.Linhas {
+		soma := total[l.PrecoUnitario.Moeda]
+		total[l.PrecoUnitario.Moeda] = dinheiro.Novo(soma.Centavos+l.PrecoUnitario.Vezes(l.Quantidade).Centavos, l.PrecoUnitario.Moeda)
+	}
+	return total
+}
@@ -0,0 +1,74 @@
+package venda
+
+import (
+	"desafio-itens-app/internal/domain/dinheiro"
+	entity "desafio-itens-app/internal/domain/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var agora = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNovoPedido(t *testing.T) {
+	t.Run("valido", func(t *testing.T) {
+		p, err := NovoPedido(7, []Linha{
+			{ItemID: 5, Quantidade: 2, PrecoUnitario: dinheiro.Novo(1990, dinheiro.BRL)},
+			{ItemID: 3, Quantidade: 1, PrecoUnitario: dinheiro.Novo(500, dinheiro.USD)},
+		}, agora)
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusPendente, p.Status)
+		assert.Equal(t, []int{3, 5}, p.ItemIDs())
+		assert.Equal(t, dinheiro.Novo(3980, dinheiro.BRL), p.Total()[dinheiro.BRL])
+		assert.Equal(t, dinheiro.Novo(500, dinheiro.USD), p.Total()[dinheiro.USD])
+	})
+
+	t.Run("item repetido", func(t *testing.T) {
+		linha := Linha{ItemID: 5, Quantidade: 1, PrecoUnitario: dinheiro.Novo(1990, dinheiro.BRL)}
+		_, err := NovoPedido(7, []Linha{linha, linha}, agora)
+		assert.ErrorIs(t, err, ErrPedidoInvalido)
+	})
+
+	t.Run("sem linhas", func(t *testing.T) {
+		_, err := NovoPedido(7, nil, agora)
+		assert.ErrorIs(t, err, ErrPedidoInvalido)
+	})
+}
+
+func TestVerificarItem(t *testing.T) {
+	ativo := entity.Item{ID: 5, Status: entity.StatusAtivo, Estoque: 3}
+
+	assert.NoError(t, VerificarItem(ativo, 3, 3))
+	assert.ErrorIs(t, VerificarItem(ativo, 3, 2), ErrEstoqueInsuficiente)
+	assert.ErrorIs(t, VerificarItem(entity.Item{ID: 5, Status: entity.StatusInativo}, 1, 1), ErrItemIndisponivel)
+}
+
+func TestTransicoes(t *testing.T) {
+	t.Run("cancelar confirmado devolve estoque", func(t *testing.T) {
+		p := Pedido{Status: StatusPendente}
+		require.NoError(t, p.Confirmar(agora))
+
+		devolver, err := p.Cancelar(agora)
+		require.NoError(t, err)
+		assert.True(t, devolver)
+		assert.Equal(t, StatusCancelado, p.Status)
+	})
+
+	t.Run("cancelar pendente nao devolve", func(t *testing.T) {
+		p := Pedido{Status: StatusPendente}
+
+		devolver, err := p.Cancelar(agora)
+		require.NoError(t, err)
+		assert.False(t, devolver)
+	})
+
+	t.Run("cancelado nao muda", func(t *testing.T) {
+		p := Pedido{Status: StatusCancelado}
+
+		assert.ErrorIs(t, p.Confirmar(agora), ErrTransicaoInvalida)
+		_, err := p.Cancelar(agora)
+		assert.ErrorIs(t, err, ErrTransicaoInvalida)
+	})
+}
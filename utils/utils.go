@@ -1,11 +1,13 @@
 package utils
 
 import (
+	"context"     // Cancelamento do Retry
 	"crypto/rand" // Gera números seguros
 	"errors"      // Cria erros
 	"fmt"         // Formatar strings
 	"math/big"    // Números grandes
 	"strings"     // Manipular texto
+	"time"        // Esperas do Retry
 	"unicode"     // Classificar caracteres
 )
 
@@ -41,3 +43,35 @@ func GenerateItemCode(nome string) (string, error) {
 
 	return prefixo + codigo.String(), nil // Junta prefixo + números
 }
+
+// Retry executa fn até dar certo ou acabarem as tentativas. A espera começa
+// em esperaInicial e dobra a cada falha, até no máximo 30s.
+func Retry(ctx context.Context, tentativas int, esperaInicial time.Duration, fn func() error) error {
+	if tentativas < 1 {
+		tentativas = 1
+	}
+
+	espera := esperaInicial
+	var err error
+	for i := 1; i <= tentativas; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if i == tentativas {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(espera):
+		}
+
+		espera *= 2
+		if espera > 30*time.Second {
+			espera = 30 * time.Second
+		}
+	}
+
+	return fmt.Errorf("falhou após %d tentativas: %w", tentativas, err)
+}